@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+)
+
+type attributeSchemaController struct {
+	usecase interfaces.AttributeSchemaUseCase
+}
+
+func NewAttributeSchemaController(usecase interfaces.AttributeSchemaUseCase) interfaces.AttributeSchemaController {
+	return &attributeSchemaController{
+		usecase: usecase,
+	}
+}
+
+func (ac *attributeSchemaController) GetAttributeSchema(c *gin.Context) {
+	schema, errResp := ac.usecase.GetAttributeSchema(c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, schema)
+}
+
+func (ac *attributeSchemaController) SaveAttributeSchema(c *gin.Context) {
+	var req dtos.AttributeSchemaRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	schema, errResp := ac.usecase.SaveAttributeSchema(req, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, schema)
+}
+
+func (ac *attributeSchemaController) DeleteAttributeSchema(c *gin.Context) {
+	errResp := ac.usecase.DeleteAttributeSchema(c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Attribute schema deleted successfully"})
+}
@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+)
+
+type avatarController struct {
+	usecase interfaces.AvatarUseCase
+}
+
+func NewAvatarController(usecase interfaces.AvatarUseCase) interfaces.AvatarController {
+	return &avatarController{
+		usecase: usecase,
+	}
+}
+
+func (ac *avatarController) GetAvatar(c *gin.Context) {
+	content, contentType, errResp := ac.usecase.GetAvatar(c.Param("id"), c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+	defer content.Close()
+
+	c.Header("Cache-Control", "private, max-age=300")
+	c.DataFromReader(http.StatusOK, -1, contentType, content, nil)
+}
+
+// UploadAvatar accepts the image as the "file" field of a multipart form or as
+// the raw request body.
+func (ac *avatarController) UploadAvatar(c *gin.Context) {
+	var content io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		header, err := c.FormFile("file")
+		if err != nil {
+			c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+			return
+		}
+
+		file, err := header.Open()
+		if err != nil {
+			c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "Failed to read the uploaded file"})
+			return
+		}
+		defer file.Close()
+		content = file
+	}
+
+	user, errResp := ac.usecase.UploadAvatar(c.Param("id"), content, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, user)
+}
+
+func (ac *avatarController) DeleteAvatar(c *gin.Context) {
+	if errResp := ac.usecase.DeleteAvatar(c.Param("id"), c); errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Avatar deleted"})
+}
@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+)
+
+func (uc *userController) GetEmailAliases(c *gin.Context) {
+	aliases, err := uc.usecase.GetEmailAliases(c.Param("id"), c)
+
+	if err != nil {
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	if len(aliases) == 0 {
+		c.IndentedJSON(http.StatusOK, []string{})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, aliases)
+}
+
+func (uc *userController) AddEmailAlias(c *gin.Context) {
+	var req dtos.EmailAliasCreateRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	alias, err := uc.usecase.AddEmailAlias(c.Param("id"), req, c)
+
+	if err != nil {
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusCreated, alias)
+}
+
+func (uc *userController) SendEmailAliasVerification(c *gin.Context) {
+	if err := uc.usecase.SendEmailAliasVerification(c.Param("id"), c.Param("aliasId"), c); err != nil {
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusAccepted, gin.H{"message": "Verification email sent"})
+}
+
+func (uc *userController) DeleteEmailAlias(c *gin.Context) {
+	if err := uc.usecase.DeleteEmailAlias(c.Param("id"), c.Param("aliasId"), c); err != nil {
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Email alias deleted"})
+}
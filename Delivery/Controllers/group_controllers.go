@@ -49,7 +49,7 @@ func (gc *groupController) GetGroupById(c *gin.Context) {
 func (gc *groupController) GetGroupUsers(c *gin.Context) {
 	id := c.Param("id")
 
-	users, errResp := gc.usecase.GetGroupUsers(id, c)
+	users, errResp := gc.usecase.GetGroupUsers(id, membershipFilter(c), c)
 
 	if errResp != nil {
 		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
@@ -113,3 +113,220 @@ func (gc *groupController) DeleteGroup(c *gin.Context) {
 
 	c.IndentedJSON(http.StatusOK, gin.H{})
 }
+
+func (gc *groupController) RestoreGroup(c *gin.Context) {
+	id := c.Param("id")
+
+	group, errResp := gc.usecase.RestoreGroup(id, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, group)
+}
+
+func (gc *groupController) GetGroupOwners(c *gin.Context) {
+	id := c.Param("id")
+
+	owners, errResp := gc.usecase.GetGroupOwners(id, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, owners)
+}
+
+func (gc *groupController) AddGroupOwner(c *gin.Context) {
+	var req dtos.GroupOwnerRequest
+	id := c.Param("id")
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	owners, errResp := gc.usecase.AddGroupOwner(id, req, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, owners)
+}
+
+func (gc *groupController) RemoveGroupOwner(c *gin.Context) {
+	id := c.Param("id")
+	userId := c.Param("userId")
+
+	errResp := gc.usecase.RemoveGroupOwner(id, userId, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Group owner removed successfully"})
+}
+
+// AddGroupMember takes an optional validity period as its body.
+func (gc *groupController) GetGroupRoles(c *gin.Context) {
+	id := c.Param("id")
+
+	roles, errResp := gc.usecase.GetGroupRoles(id, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, roles)
+}
+
+func (gc *groupController) AddGroupRole(c *gin.Context) {
+	var req dtos.GroupRoleRequest
+	id := c.Param("id")
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	roles, errResp := gc.usecase.AddGroupRole(id, req, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, roles)
+}
+
+func (gc *groupController) RemoveGroupRole(c *gin.Context) {
+	id := c.Param("id")
+	roleId := c.Param("roleId")
+
+	errResp := gc.usecase.RemoveGroupRole(id, roleId, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Role removed from group successfully"})
+}
+
+func (gc *groupController) AddGroupMember(c *gin.Context) {
+	var req dtos.GroupMemberRequest
+	id := c.Param("id")
+	userId := c.Param("userId")
+
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.IndentedJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	errResp := gc.usecase.AddGroupMember(id, userId, req, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "User added to the group successfully"})
+}
+
+func (gc *groupController) RemoveGroupMember(c *gin.Context) {
+	id := c.Param("id")
+	userId := c.Param("userId")
+
+	errResp := gc.usecase.RemoveGroupMember(id, userId, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "User removed from the group successfully"})
+}
+
+func (gc *groupController) PreviewGroupRule(c *gin.Context) {
+	var req dtos.GroupRulePreviewRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	preview, errResp := gc.usecase.PreviewGroupRule(req, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, preview)
+}
+
+func (gc *groupController) SetGroupMembers(c *gin.Context) {
+	var req dtos.GroupMembersRequest
+	id := c.Param("id")
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	diff, errResp := gc.usecase.SetGroupMembers(id, req, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, diff)
+}
+
+func (gc *groupController) AddGroupMembers(c *gin.Context) {
+	var req dtos.GroupMembersRequest
+	id := c.Param("id")
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	diff, errResp := gc.usecase.AddGroupMembers(id, req, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, diff)
+}
+
+func (gc *groupController) RemoveGroupMembers(c *gin.Context) {
+	var req dtos.GroupMembersRequest
+	id := c.Param("id")
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	diff, errResp := gc.usecase.RemoveGroupMembers(id, req, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, diff)
+}
@@ -0,0 +1,31 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+)
+
+type healthController struct {
+	tenants interfaces.TenantRegistry
+}
+
+func NewHealthController(tenants interfaces.TenantRegistry) interfaces.HealthController {
+	return &healthController{
+		tenants: tenants,
+	}
+}
+
+// Startup answers 503 until every tenant has finished initializing and at least
+// one of them is ready, so it can back a Cloud Run startup probe.
+func (hc *healthController) Startup(c *gin.Context) {
+	progress := hc.tenants.StartupProgress()
+
+	status := http.StatusOK
+	if !progress.Done || progress.Ready == 0 {
+		status = http.StatusServiceUnavailable
+	}
+
+	c.IndentedJSON(status, progress)
+}
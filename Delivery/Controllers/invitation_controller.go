@@ -0,0 +1,95 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+)
+
+type invitationController struct {
+	usecase interfaces.InvitationUseCase
+}
+
+func NewInvitationController(usecase interfaces.InvitationUseCase) interfaces.InvitationController {
+	return &invitationController{
+		usecase: usecase,
+	}
+}
+
+func (ic *invitationController) GetInvitations(c *gin.Context) {
+	invitations, errResp := ic.usecase.GetInvitations(c.Query("status"), c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	if len(invitations) == 0 {
+		c.IndentedJSON(http.StatusOK, []string{})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, invitations)
+}
+
+func (ic *invitationController) CreateInvitation(c *gin.Context) {
+	var req dtos.InvitationCreateRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	invitation, errResp := ic.usecase.CreateInvitation(req, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusCreated, invitation)
+}
+
+func (ic *invitationController) ResendInvitation(c *gin.Context) {
+	id := c.Param("id")
+
+	invitation, errResp := ic.usecase.ResendInvitation(id, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, invitation)
+}
+
+func (ic *invitationController) RevokeInvitation(c *gin.Context) {
+	id := c.Param("id")
+
+	if errResp := ic.usecase.RevokeInvitation(id, c); errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Invitation revoked successfully"})
+}
+
+func (ic *invitationController) AcceptInvitation(c *gin.Context) {
+	var req dtos.InvitationAcceptRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	user, errResp := ic.usecase.AcceptInvitation(req, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, user)
+}
@@ -0,0 +1,15 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+)
+
+// membershipFilter reads the membership query parameters shared by the
+// group, role and user membership listings.
+func membershipFilter(c *gin.Context) dtos.MembershipFilter {
+	return dtos.MembershipFilter{
+		Effective:  c.Query("effective") == "true",
+		Transitive: c.Query("transitive") == "true",
+	}
+}
@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+)
+
+type passwordController struct {
+	usecase interfaces.PasswordUseCase
+}
+
+func NewPasswordController(usecase interfaces.PasswordUseCase) interfaces.PasswordController {
+	return &passwordController{
+		usecase: usecase,
+	}
+}
+
+func (pc *passwordController) GetPasswordPolicy(c *gin.Context) {
+	policy, errResp := pc.usecase.GetPasswordPolicy(c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, policy)
+}
+
+func (pc *passwordController) SavePasswordPolicy(c *gin.Context) {
+	var req dtos.PasswordPolicyRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	policy, errResp := pc.usecase.SavePasswordPolicy(req, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, policy)
+}
+
+func (pc *passwordController) ForgotPassword(c *gin.Context) {
+	var req dtos.ForgotPasswordRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	if errResp := pc.usecase.ForgotPassword(req, c); errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusAccepted, gin.H{"message": "If the address belongs to an account, a reset link has been sent"})
+}
+
+func (pc *passwordController) ResetPassword(c *gin.Context) {
+	var req dtos.ResetPasswordRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	if errResp := pc.usecase.ResetPassword(req, c); errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Password has been reset"})
+}
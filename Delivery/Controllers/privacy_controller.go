@@ -0,0 +1,41 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+)
+
+type privacyController struct {
+	usecase interfaces.PrivacyUseCase
+}
+
+func NewPrivacyController(usecase interfaces.PrivacyUseCase) interfaces.PrivacyController {
+	return &privacyController{
+		usecase: usecase,
+	}
+}
+
+func (pc *privacyController) ExportUser(c *gin.Context) {
+	id := c.Param("id")
+
+	export, errResp := pc.usecase.ExportUser(id, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="user-`+id+`.json"`)
+	c.IndentedJSON(http.StatusOK, export)
+}
+
+func (pc *privacyController) EraseUser(c *gin.Context) {
+	if errResp := pc.usecase.EraseUser(c.Param("id"), c); errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "User erased successfully"})
+}
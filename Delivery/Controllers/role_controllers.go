@@ -100,7 +100,7 @@ func (rc *roleController) DeleteRole(c *gin.Context) {
 func (rc *roleController) GetRoleUsers(c *gin.Context) {
 	id := c.Param("id")
 
-	users, errResp := rc.roleUsecase.GetRoleUsers(id, c)
+	users, errResp := rc.roleUsecase.GetRoleUsers(id, membershipFilter(c), c)
 
 	if errResp != nil {
 		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
@@ -114,3 +114,16 @@ func (rc *roleController) GetRoleUsers(c *gin.Context) {
 
 	c.IndentedJSON(http.StatusOK, users)
 }
+
+func (rc *roleController) RestoreRole(c *gin.Context) {
+	id := c.Param("id")
+
+	role, errResp := rc.roleUsecase.RestoreRole(id, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, role)
+}
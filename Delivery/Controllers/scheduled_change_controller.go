@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+)
+
+type scheduledChangeController struct {
+	usecase interfaces.ScheduledChangeUseCase
+}
+
+func NewScheduledChangeController(usecase interfaces.ScheduledChangeUseCase) interfaces.ScheduledChangeController {
+	return &scheduledChangeController{
+		usecase: usecase,
+	}
+}
+
+func (sc *scheduledChangeController) GetScheduledChanges(c *gin.Context) {
+	filter := dtos.ScheduledChangeFilter{
+		Status:   c.Query("status"),
+		TargetID: c.Query("target_id"),
+	}
+
+	changes, errResp := sc.usecase.GetScheduledChanges(filter, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	if len(changes) == 0 {
+		c.IndentedJSON(http.StatusOK, []string{})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, changes)
+}
+
+func (sc *scheduledChangeController) GetScheduledChangeById(c *gin.Context) {
+	id := c.Param("id")
+
+	change, errResp := sc.usecase.GetScheduledChangeById(id, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, change)
+}
+
+func (sc *scheduledChangeController) CreateScheduledChange(c *gin.Context) {
+	var req dtos.ScheduledChangeRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	change, errResp := sc.usecase.CreateScheduledChange(req, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusCreated, change)
+}
+
+func (sc *scheduledChangeController) CancelScheduledChange(c *gin.Context) {
+	id := c.Param("id")
+
+	change, errResp := sc.usecase.CancelScheduledChange(id, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, change)
+}
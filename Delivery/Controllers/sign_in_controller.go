@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+)
+
+// maxSignInLimit caps how many sign-ins one request can list.
+const maxSignInLimit = 500
+
+type signInController struct {
+	usecase interfaces.SignInUseCase
+}
+
+func NewSignInController(usecase interfaces.SignInUseCase) interfaces.SignInController {
+	return &signInController{
+		usecase: usecase,
+	}
+}
+
+func (sc *signInController) SignIn(c *gin.Context) {
+	var req dtos.SignInRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+	req.IPAddress = c.ClientIP()
+	req.UserAgent = c.Request.UserAgent()
+
+	result, errResp := sc.usecase.SignIn(req, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, result)
+}
+
+func (sc *signInController) GetUserSignIns(c *gin.Context) {
+	limit := 50
+	if limitParam := c.Query("limit"); limitParam != "" {
+		value, err := strconv.Atoi(limitParam)
+		if err != nil || value <= 0 || value > maxSignInLimit {
+			c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and " + strconv.Itoa(maxSignInLimit)})
+			return
+		}
+		limit = value
+	}
+
+	signIns, errResp := sc.usecase.GetUserSignIns(c.Param("id"), limit, c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	if len(signIns) == 0 {
+		c.IndentedJSON(http.StatusOK, []string{})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, signIns)
+}
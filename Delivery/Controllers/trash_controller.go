@@ -0,0 +1,34 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+)
+
+type trashController struct {
+	usecase interfaces.TrashUseCase
+}
+
+func NewTrashController(usecase interfaces.TrashUseCase) interfaces.TrashController {
+	return &trashController{
+		usecase: usecase,
+	}
+}
+
+func (tc *trashController) GetTrash(c *gin.Context) {
+	entries, errResp := tc.usecase.GetTrash(c.Query("type"), c)
+
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
+	}
+
+	if len(entries) == 0 {
+		c.IndentedJSON(http.StatusOK, []string{})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, entries)
+}
@@ -1,8 +1,12 @@
 package controllers
 
 import (
+	"encoding/csv"
+	"io"
+	"log"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator"
@@ -22,14 +26,35 @@ func NewUserController(usecase interfaces.UserUseCase) interfaces.UserController
 }
 
 func (uc *userController) isSearch(srarchField dtos.SearchFields) bool {
-	return srarchField.Name != "" || srarchField.OrderBy != "" || srarchField.Limit != 10
+	return srarchField.Name != "" || srarchField.OrderBy != "" || srarchField.Limit != 10 || len(srarchField.Attributes) > 0 || srarchField.Profile != dtos.UserProfile{} || srarchField.InactiveDays > 0
+}
+
+// attributeFilters collects query parameters of the form attr.<key>=<value>.
+func (uc *userController) attributeFilters(c *gin.Context) map[string]string {
+	filters := make(map[string]string)
+	for param, values := range c.Request.URL.Query() {
+		if key, found := strings.CutPrefix(param, "attr."); found && key != "" && len(values) > 0 {
+			filters[key] = values[0]
+		}
+	}
+	return filters
 }
 
 func (uc *userController) GetUsers(c *gin.Context) {
 	searchFields := dtos.SearchFields{
-		Name:    c.Query("name"),
-		Limit:   10,
-		OrderBy: c.Query("orderby"),
+		Name:       c.Query("name"),
+		Limit:      10,
+		OrderBy:    c.Query("orderby"),
+		Attributes: uc.attributeFilters(c),
+		Profile: dtos.UserProfile{
+			GivenName:   c.Query("given_name"),
+			FamilyName:  c.Query("family_name"),
+			DisplayName: c.Query("display_name"),
+			PhoneNumber: c.Query("phone_number"),
+			Locale:      c.Query("locale"),
+			Timezone:    c.Query("timezone"),
+			Title:       c.Query("title"),
+		},
 	}
 
 	if limitParam := c.Query("limit"); limitParam != "" {
@@ -46,21 +71,27 @@ func (uc *userController) GetUsers(c *gin.Context) {
 		}
 	}
 
+	if inactiveParam := c.Query("inactive_days"); inactiveParam != "" {
+		days, err := strconv.Atoi(inactiveParam)
+		if err != nil || days <= 0 {
+			c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "inactive_days must be a positive number"})
+			return
+		}
+		searchFields.InactiveDays = days
+	}
+
 	var users []*dtos.UserResponseAll
 	var errResp *models.ErrorResponse
 
-	if !uc.isSearch(searchFields) {
+	if uc.isSearch(searchFields) {
+		users, errResp = uc.usecase.SearchUsers(searchFields, c)
+	} else {
 		users, errResp = uc.usecase.GetAllUsers(c)
-		if users == nil {
-		} else {
-			users, errResp = uc.usecase.SearchUsers(searchFields, c)
-		}
-
-		if errResp != nil {
-			c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
-			return
-		}
+	}
 
+	if errResp != nil {
+		c.IndentedJSON(errResp.Code, gin.H{"error": errResp.Message})
+		return
 	}
 
 	if len(users) == 0 {
@@ -77,6 +108,9 @@ func (uc *userController) GetUserById(c *gin.Context) {
 	user, err := uc.usecase.GetUserById(id, c)
 
 	if err != nil {
+		if err.Code == http.StatusNotFound && uc.redirectMerged(c, id) {
+			return
+		}
 		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
 		return
 	}
@@ -84,11 +118,28 @@ func (uc *userController) GetUserById(c *gin.Context) {
 	c.IndentedJSON(http.StatusOK, user)
 }
 
+// redirectMerged answers with 308 when the user was merged into another one,
+// pointing at the same path under the survivor's UID.
+func (uc *userController) redirectMerged(c *gin.Context, id string) bool {
+	target, err := uc.usecase.GetUserRedirect(id, c)
+	if err != nil {
+		return false
+	}
+
+	location := *c.Request.URL
+	location.Path = strings.Replace(location.Path, "/users/"+id, "/users/"+target, 1)
+	c.Redirect(http.StatusPermanentRedirect, location.RequestURI())
+	return true
+}
+
 func (uc *userController) GetUsersGroup(c *gin.Context) {
 	id := c.Param("id")
-	users, err := uc.usecase.GetUsersGroup(id, c)
+	users, err := uc.usecase.GetUsersGroup(id, membershipFilter(c), c)
 
 	if err != nil {
+		if err.Code == http.StatusNotFound && uc.redirectMerged(c, id) {
+			return
+		}
 		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
 		return
 	}
@@ -205,3 +256,210 @@ func (uc *userController) DeletetUserFromGroup(c *gin.Context) {
 
 	c.IndentedJSON(http.StatusOK, gin.H{"Message": msg})
 }
+
+func (uc *userController) RestoreUser(c *gin.Context) {
+	id := c.Param("id")
+
+	user, err := uc.usecase.RestoreUser(id, c)
+
+	if err != nil {
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, user)
+}
+
+func (uc *userController) changeStatus(c *gin.Context, status models.UserStatus) {
+	id := c.Param("id")
+
+	user, err := uc.usecase.ChangeUserStatus(id, status, c)
+
+	if err != nil {
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, user)
+}
+
+func (uc *userController) ActivateUser(c *gin.Context) {
+	uc.changeStatus(c, models.UserStatusActive)
+}
+
+func (uc *userController) SuspendUser(c *gin.Context) {
+	uc.changeStatus(c, models.UserStatusSuspended)
+}
+
+func (uc *userController) LockUser(c *gin.Context) {
+	uc.changeStatus(c, models.UserStatusLocked)
+}
+
+func (uc *userController) DeprovisionUser(c *gin.Context) {
+	uc.changeStatus(c, models.UserStatusDeprovisioned)
+}
+
+func (uc *userController) SendEmailVerification(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := uc.usecase.SendEmailVerification(id, c); err != nil {
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusAccepted, gin.H{"message": "Verification email sent"})
+}
+
+func (uc *userController) VerifyEmail(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "Verification token is required"})
+		return
+	}
+
+	user, err := uc.usecase.VerifyEmail(token, c)
+
+	if err != nil {
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, user)
+}
+
+// BatchUsers serves POST /users:batch. Gin treats ":" as the start of a path
+// parameter, so the route is registered as /users:method and any other suffix
+// is rejected here.
+func (uc *userController) BatchUsers(c *gin.Context) {
+	if c.Param("method") != ":batch" {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"error": "Not found"})
+		return
+	}
+
+	var req dtos.BatchUsersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	res, err := uc.usecase.BatchUsers(req, c)
+
+	if err != nil {
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	status := http.StatusOK
+	if res.Failed > 0 {
+		status = http.StatusMultiStatus
+	}
+	c.IndentedJSON(status, res)
+}
+
+// ExportUsers streams every user as CSV. The rows are flushed as they are read
+// so the export never has to fit in memory.
+func (uc *userController) ExportUsers(c *gin.Context) {
+	writer := csv.NewWriter(c.Writer)
+	rows := 0
+	writeHeader := func() error {
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Header("Content-Disposition", `attachment; filename="users.csv"`)
+		c.Status(http.StatusOK)
+		return writer.Write([]string{"uid", dtos.UserCSVFieldName, dtos.UserCSVFieldEmail, dtos.UserCSVFieldStatus, dtos.UserCSVFieldRole, dtos.UserCSVFieldGroups})
+	}
+
+	err := uc.usecase.ExportUsers(func(row *dtos.UserExportRow) error {
+		if rows == 0 {
+			if err := writeHeader(); err != nil {
+				return err
+			}
+		}
+
+		rows++
+		if err := writer.Write([]string{row.UID, dtos.EscapeUserCSVCell(row.Name), dtos.EscapeUserCSVCell(row.Email), row.Status, dtos.EscapeUserCSVCell(row.Role), dtos.EscapeUserCSVCell(strings.Join(row.Groups, dtos.UserCSVGroupSeparator))}); err != nil {
+			return err
+		}
+
+		if rows%500 == 0 {
+			writer.Flush()
+			return writer.Error()
+		}
+		return nil
+	}, c)
+
+	if err != nil {
+		if rows == 0 {
+			c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+			return
+		}
+		// The status line is already sent; cutting the stream short is the only
+		// way left to tell the client the export is incomplete.
+		log.Printf("user export aborted after %d rows: %s", rows, err.Message)
+		c.Abort()
+		return
+	}
+
+	if rows == 0 {
+		writeHeader()
+	}
+	writer.Flush()
+}
+
+// ImportUsers reads a CSV upload, either as the "file" field of a multipart
+// form or as the raw request body. Columns are matched to fields by name
+// unless remapped with map.<field>=<column> query parameters.
+func (uc *userController) ImportUsers(c *gin.Context) {
+	req := dtos.UserImportRequest{
+		Mapping: make(map[string]string),
+		DryRun:  c.Query("dry_run") == "true",
+	}
+	for key, values := range c.Request.URL.Query() {
+		if field, ok := strings.CutPrefix(key, "map."); ok && len(values) > 0 {
+			req.Mapping[field] = values[0]
+		}
+	}
+
+	var reader io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		header, err := c.FormFile("file")
+		if err != nil {
+			c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+			return
+		}
+
+		file, err := header.Open()
+		if err != nil {
+			c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "Failed to read the uploaded file"})
+			return
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	res, err := uc.usecase.ImportUsers(reader, req, c)
+
+	if err != nil {
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, res)
+}
+
+func (uc *userController) MergeUsers(c *gin.Context) {
+	var req dtos.UserMergeRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	user, err := uc.usecase.MergeUsers(c.Param("id"), req, c)
+
+	if err != nil {
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, user)
+}
@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (uc *userController) GetReports(c *gin.Context) {
+	id := c.Param("id")
+	reports, err := uc.usecase.GetReports(id, c.Query("all") == "true", c)
+
+	if err != nil {
+		if err.Code == http.StatusNotFound && uc.redirectMerged(c, id) {
+			return
+		}
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	if len(reports) == 0 {
+		c.IndentedJSON(http.StatusOK, []string{})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, reports)
+}
+
+func (uc *userController) GetReport(c *gin.Context) {
+	report, err := uc.usecase.GetReport(c.Param("id"), c.Param("reportId"), c)
+
+	if err != nil {
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, report)
+}
+
+func (uc *userController) GetManagementChain(c *gin.Context) {
+	id := c.Param("id")
+	chain, err := uc.usecase.GetManagementChain(id, c)
+
+	if err != nil {
+		if err.Code == http.StatusNotFound && uc.redirectMerged(c, id) {
+			return
+		}
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	if len(chain) == 0 {
+		c.IndentedJSON(http.StatusOK, []string{})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, chain)
+}
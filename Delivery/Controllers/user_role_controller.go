@@ -0,0 +1,46 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+)
+
+func (uc *userController) GetUserRoles(c *gin.Context) {
+	roles, err := uc.usecase.GetUserRoles(c.Param("id"), c)
+
+	if err != nil {
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, roles)
+}
+
+func (uc *userController) AddUserRole(c *gin.Context) {
+	var req dtos.UserRoleAssignRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"error": "one or more required fields are missing"})
+		return
+	}
+
+	roles, err := uc.usecase.AddUserRole(c.Param("id"), req, c)
+
+	if err != nil {
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, roles)
+}
+
+func (uc *userController) RemoveUserRole(c *gin.Context) {
+	if err := uc.usecase.RemoveUserRole(c.Param("id"), c.Param("roleId"), c); err != nil {
+		c.IndentedJSON(err.Code, gin.H{"error": err.Message})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Role removed from user"})
+}
@@ -2,13 +2,30 @@ package middleware
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
 	"github.com/google-run-code/config"
 )
 
-func DatabaseMiddleware(env *config.Env, jwtService interfaces.JwtService) gin.HandlerFunc {
+// DatabaseMiddleware only accepts tokens that are not bound to a user.
+func DatabaseMiddleware(env *config.Env, jwtService interfaces.JwtService, tenants interfaces.TenantRegistry) gin.HandlerFunc {
+	return authenticate(jwtService, tenants, nil)
+}
+
+// DelegatedMiddleware also accepts tokens bound to a user, for the endpoints
+// such a user may call. The user is stored in the context as the actor, and
+// the endpoint decides what the actor is allowed to do. Each request counts
+// as activity of the user.
+func DelegatedMiddleware(env *config.Env, jwtService interfaces.JwtService, tenants interfaces.TenantRegistry, tracker interfaces.ActivityTracker) gin.HandlerFunc {
+	return authenticate(jwtService, tenants, tracker)
+}
+
+// authenticate only accepts tokens bound to a user when given a tracker to
+// record their activity.
+func authenticate(jwtService interfaces.JwtService, tenants interfaces.TenantRegistry, tracker interfaces.ActivityTracker) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -39,8 +56,22 @@ func DatabaseMiddleware(env *config.Env, jwtService interfaces.JwtService) gin.H
 			return
 		}
 
-		// Store the database name in the context
-		c.Set("dbName", dbName)
+		if claims.UserUID != "" && tracker == nil {
+			c.JSON(http.StatusForbidden, gin.H{"error": "This token cannot be used for this endpoint"})
+			c.Abort()
+			return
+		}
+
+		if !setTenant(c, tenants, dbName) {
+			return
+		}
+		if claims.UserUID != "" {
+			c.Set(models.ActorContextKey, &models.Actor{
+				UserUID:  claims.UserUID,
+				IssuedAt: time.Unix(claims.IssuedAt, 0),
+			})
+			tracker.Touch(c, claims.UserUID, false)
+		}
 		c.Next()
 	}
 }
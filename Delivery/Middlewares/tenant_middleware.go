@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// TenantMiddleware resolves the tenant for public endpoints, such as links sent
+// by email, that are called without an access token. The tenant is taken from
+// the "tenant" query parameter or the X-Tenant header.
+func TenantMiddleware(tenants interfaces.TenantRegistry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("tenant")
+		if name == "" {
+			name = c.GetHeader("X-Tenant")
+		}
+
+		if name == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Tenant is required"})
+			c.Abort()
+			return
+		}
+
+		if !setTenant(c, tenants, name) {
+			return
+		}
+		c.Next()
+	}
+}
+
+// setTenant stores the resolved tenant in the context, or aborts the request
+// when the tenant is unknown or not ready.
+func setTenant(c *gin.Context, tenants interfaces.TenantRegistry, name string) bool {
+	tenant, exists := tenants.ResolveTenant(name)
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Unknown tenant", "code": models.ErrCodeTenantNotFound})
+		c.Abort()
+		return false
+	}
+
+	switch tenant.State {
+	case models.TenantReady:
+	case models.TenantDisabled:
+		c.JSON(http.StatusForbidden, gin.H{"error": "Tenant is disabled", "code": models.ErrCodeTenantDisabled})
+		c.Abort()
+		return false
+	case models.TenantPending, models.TenantInitializing:
+		c.JSON(http.StatusForbidden, gin.H{"error": "Tenant is still initializing", "code": models.ErrCodeTenantInitializing})
+		c.Abort()
+		return false
+	default:
+		c.JSON(http.StatusForbidden, gin.H{"error": "Tenant is unavailable", "code": models.ErrCodeTenantUnavailable})
+		c.Abort()
+		return false
+	}
+
+	c.Set(models.TenantContextKey, tenant)
+	c.Set("dbName", tenant.Name)
+	return true
+}
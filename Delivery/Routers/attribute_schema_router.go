@@ -0,0 +1,21 @@
+package routers
+
+import (
+	"github.com/gin-gonic/gin"
+	controllers "github.com/google-run-code/Delivery/Controllers"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	repository "github.com/google-run-code/Repository"
+	usecases "github.com/google-run-code/Usecases"
+	"github.com/google-run-code/config"
+)
+
+func NewAttributeSchemaRouter(env config.Env, router *gin.RouterGroup, dbConfig *config.PostgresConfig, validator interfaces.AttributeValidator) {
+
+	schemaRepo := repository.NewAttributeSchemaRepository(dbConfig)
+	schemaUseCase := usecases.NewAttributeSchemaUseCase(schemaRepo, validator)
+	schemaHandler := controllers.NewAttributeSchemaController(schemaUseCase)
+
+	router.GET("/schemas/user-attributes", schemaHandler.GetAttributeSchema)
+	router.PUT("/schemas/user-attributes", schemaHandler.SaveAttributeSchema)
+	router.DELETE("/schemas/user-attributes", schemaHandler.DeleteAttributeSchema)
+}
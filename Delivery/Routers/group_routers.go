@@ -8,7 +8,7 @@ import (
 	"github.com/google-run-code/config"
 )
 
-func NewGroupRouter(env config.Env, router *gin.RouterGroup, dbConfig *config.PostgresConfig) {
+func NewGroupRouter(env config.Env, router *gin.RouterGroup, delegatedRouter *gin.RouterGroup, dbConfig *config.PostgresConfig) {
 
 	groupRepo := repository.NewGroupRepository(dbConfig)
 	groupUseCase := usecases.NewGroupUseCase(groupRepo)
@@ -20,5 +20,22 @@ func NewGroupRouter(env config.Env, router *gin.RouterGroup, dbConfig *config.Po
 	router.POST("/groups", groupHandler.CreateGroup)
 	router.PATCH("/groups/:id", groupHandler.UpdateGroup)
 	router.DELETE("/groups/:id", groupHandler.DeleteGroup)
+	router.POST("/groups/:id/restore", groupHandler.RestoreGroup)
+	router.POST("/groups/preview", groupHandler.PreviewGroupRule)
+
+	router.GET("/groups/:id/owners", groupHandler.GetGroupOwners)
+	router.POST("/groups/:id/owners", groupHandler.AddGroupOwner)
+	router.DELETE("/groups/:id/owners/:userId", groupHandler.RemoveGroupOwner)
+
+	router.GET("/groups/:id/roles", groupHandler.GetGroupRoles)
+	router.POST("/groups/:id/roles", groupHandler.AddGroupRole)
+	router.DELETE("/groups/:id/roles/:roleId", groupHandler.RemoveGroupRole)
+
+	// Owners of a group may change its members with a token bound to them
+	delegatedRouter.PUT("/groups/:id/members/:userId", groupHandler.AddGroupMember)
+	delegatedRouter.DELETE("/groups/:id/members/:userId", groupHandler.RemoveGroupMember)
+	delegatedRouter.PUT("/groups/:id/members", groupHandler.SetGroupMembers)
+	delegatedRouter.POST("/groups/:id/members", groupHandler.AddGroupMembers)
+	delegatedRouter.DELETE("/groups/:id/members", groupHandler.RemoveGroupMembers)
 
 }
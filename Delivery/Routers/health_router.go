@@ -0,0 +1,13 @@
+package routers
+
+import (
+	"github.com/gin-gonic/gin"
+	controllers "github.com/google-run-code/Delivery/Controllers"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+)
+
+func NewHealthRouter(router *gin.RouterGroup, tenants interfaces.TenantRegistry) {
+	healthController := controllers.NewHealthController(tenants)
+
+	router.GET("/startup", healthController.Startup)
+}
@@ -0,0 +1,34 @@
+package routers
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	controllers "github.com/google-run-code/Delivery/Controllers"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	infrastructure "github.com/google-run-code/Infrastructure"
+	repository "github.com/google-run-code/Repository"
+	usecases "github.com/google-run-code/Usecases"
+	"github.com/google-run-code/config"
+)
+
+func NewInvitationRouter(env config.Env, router *gin.RouterGroup, tenantRouter *gin.RouterGroup, dbConfig *config.PostgresConfig, attributeValidator interfaces.AttributeValidator, mailSender interfaces.MailSender) {
+
+	invitationRepo := repository.NewInvitationRepository(dbConfig)
+	userRepo := repository.NewUserRepository(dbConfig)
+	roleRepo := repository.NewRoleRepository(dbConfig)
+	groupRepo := repository.NewGroupRepository(dbConfig)
+	schemaRepo := repository.NewAttributeSchemaRepository(dbConfig)
+	emailService := infrastructure.NewEmailService(env, mailSender)
+
+	userUseCase := usecases.NewUserUseCase(userRepo, emailService, roleRepo, groupRepo, schemaRepo, attributeValidator)
+	invitationUseCase := usecases.NewInvitationUseCase(invitationRepo, userUseCase, emailService, time.Duration(env.INVITATION_TTL)*time.Hour)
+	invitationHandler := controllers.NewInvitationController(invitationUseCase)
+
+	router.GET("/invitations", invitationHandler.GetInvitations)
+	router.POST("/invitations", invitationHandler.CreateInvitation)
+	router.POST("/invitations/:id/resend", invitationHandler.ResendInvitation)
+	router.POST("/invitations/:id/revoke", invitationHandler.RevokeInvitation)
+
+	tenantRouter.POST("/invitations/accept", invitationHandler.AcceptInvitation)
+}
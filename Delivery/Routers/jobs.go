@@ -0,0 +1,44 @@
+package routers
+
+import (
+	"time"
+
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	infrastructure "github.com/google-run-code/Infrastructure"
+	repository "github.com/google-run-code/Repository"
+	usecases "github.com/google-run-code/Usecases"
+	"github.com/google-run-code/config"
+)
+
+// startJobs schedules the background work that runs for every tenant.
+func startJobs(env config.Env, dbConfig *config.PostgresConfig, publisher interfaces.EventPublisher, attributeValidator interfaces.AttributeValidator, mailSender interfaces.MailSender) {
+	userRepo := repository.NewUserRepository(dbConfig)
+	expiryUseCase := usecases.NewExpiryUseCase(userRepo, publisher)
+	scheduledChangeUseCase := newScheduledChangeUseCase(env, dbConfig, attributeValidator, mailSender)
+	groupUseCase := usecases.NewGroupUseCase(repository.NewGroupRepository(dbConfig))
+
+	infrastructure.StartTenantJobs(dbConfig,
+		infrastructure.TenantJob{
+			Name:     "role-expiry",
+			Interval: time.Duration(env.ROLE_EXPIRY_INTERVAL) * time.Second,
+			Run:      expiryUseCase.ExpireRoleAssignments,
+		},
+		infrastructure.TenantJob{
+			Name:     "group-membership-expiry",
+			Interval: time.Duration(env.GROUP_EXPIRY_INTERVAL) * time.Second,
+			Run:      expiryUseCase.ExpireGroupMemberships,
+		},
+		infrastructure.TenantJob{
+			Name:     "scheduled-changes",
+			Interval: time.Duration(env.SCHEDULED_CHANGES_INTERVAL) * time.Second,
+			Run:      scheduledChangeUseCase.ApplyDueChanges,
+		},
+		// Roles and memberships that start later, and changes to the roles a
+		// user holds through groups, reach dynamic groups here
+		infrastructure.TenantJob{
+			Name:     "dynamic-groups",
+			Interval: time.Duration(env.DYNAMIC_GROUP_INTERVAL) * time.Second,
+			Run:      groupUseCase.EvaluateDynamicGroups,
+		},
+	)
+}
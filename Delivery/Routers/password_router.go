@@ -0,0 +1,31 @@
+package routers
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	controllers "github.com/google-run-code/Delivery/Controllers"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	infrastructure "github.com/google-run-code/Infrastructure"
+	repository "github.com/google-run-code/Repository"
+	usecases "github.com/google-run-code/Usecases"
+	"github.com/google-run-code/config"
+)
+
+func NewPasswordRouter(env config.Env, router *gin.RouterGroup, tenantRouter *gin.RouterGroup, dbConfig *config.PostgresConfig, mailSender interfaces.MailSender) {
+
+	passwordRepo := repository.NewPasswordRepository(dbConfig)
+	userRepo := repository.NewUserRepository(dbConfig)
+	emailService := infrastructure.NewEmailService(env, mailSender)
+	hasher := infrastructure.NewPasswordHasher()
+
+	passwordUseCase := usecases.NewPasswordUseCase(passwordRepo, userRepo, emailService, hasher,
+		time.Duration(env.PASSWORD_RESET_TTL)*time.Minute, env.PASSWORD_RESET_LIMIT)
+	passwordHandler := controllers.NewPasswordController(passwordUseCase)
+
+	router.GET("/policies/password", passwordHandler.GetPasswordPolicy)
+	router.PUT("/policies/password", passwordHandler.SavePasswordPolicy)
+
+	tenantRouter.POST("/password/forgot", passwordHandler.ForgotPassword)
+	tenantRouter.POST("/password/reset", passwordHandler.ResetPassword)
+}
@@ -21,4 +21,5 @@ func NewRoleRouter(env config.Env, router *gin.RouterGroup,  dbConfig *config.Po
 	router.POST("/roles", roleHandler.CreateRole)
 	router.PATCH("/roles/:id", roleHandler.UpdateRole)
 	router.DELETE("/roles/:id", roleHandler.DeleteRole)
+	router.POST("/roles/:id/restore", roleHandler.RestoreRole)
 }
\ No newline at end of file
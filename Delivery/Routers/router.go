@@ -3,17 +3,28 @@ package routers
 import (
 	"log"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	middleware "github.com/google-run-code/Delivery/Middlewares"
+	middlewares "github.com/google-run-code/Delivery/Middlewares"
 	models "github.com/google-run-code/Domain/Models"
 	infrastructure "github.com/google-run-code/Infrastructure"
+	repository "github.com/google-run-code/Repository"
 	"github.com/google-run-code/config"
 )
 
 func getDatabasesFromEnv(env config.Env) []string {
-	dbs := env.DB_NAMES
-	return strings.Split(dbs, ",")
+	return splitNames(env.DB_NAMES)
+}
+
+func splitNames(names string) []string {
+	var dbNames []string
+	for _, dbName := range strings.Split(names, ",") {
+		if dbName = strings.TrimSpace(dbName); dbName != "" {
+			dbNames = append(dbNames, dbName)
+		}
+	}
+	return dbNames
 }
 
 func SetUp() {
@@ -25,26 +36,67 @@ func SetUp() {
 		log.Fatalf("No database names provided")
 	}
 
-	dbConfig.InitializeConnections(dbNames)
+	disabled := make(map[string]bool)
+	for _, dbName := range splitNames(env.DISABLED_TENANTS) {
+		disabled[dbName] = true
+	}
 
-	for _, dbname := range dbNames {
-		dbConfig.Migrate(dbname, &models.User{}, &models.Role{}, &models.Group{})
+	var enabledNames, disabledNames []string
+	for _, dbName := range dbNames {
+		if disabled[dbName] {
+			disabledNames = append(disabledNames, dbName)
+		} else {
+			enabledNames = append(enabledNames, dbName)
+		}
 	}
 
+	dbConfig.DisableTenants(disabledNames)
+	dbConfig.RegisterTenants(enabledNames)
+
+	// Tenants are initialized in the background so the startup probe can report
+	// progress while migrations run.
+	go dbConfig.InitializeTenants(enabledNames, models.TenantSchema()...)
+
 	log.Println(dbNames, "dbname")
 
 	jwtService := infrastructure.NewJwtService(env)
-	middleware := middleware.DatabaseMiddleware(env, jwtService)
+	middleware := middlewares.DatabaseMiddleware(env, jwtService, dbConfig)
+
+	activityTracker := infrastructure.NewActivityTracker(repository.NewSignInRepository(dbConfig), time.Duration(env.ACTIVITY_FLUSH_INTERVAL)*time.Second)
 
 	router := gin.Default()
+	// Without trusted proxies the client IP is the address of the connection,
+	// so X-Forwarded-For cannot be used to spoof it
+	if err := router.SetTrustedProxies(splitNames(env.TRUSTED_PROXIES)); err != nil {
+		log.Fatalf("Invalid TRUSTED_PROXIES: %v", err)
+	}
 
 	public := router.Group("")
 	protected := public.Group("")
 	protected.Use(middleware)
-	NewUserRouter(*env, protected, dbConfig)
-	NewGroupRouter(*env, protected, dbConfig)
+	// Tokens bound to a user are only accepted on the endpoints of this group
+	delegated := public.Group("")
+	delegated.Use(middlewares.DelegatedMiddleware(env, jwtService, dbConfig, activityTracker))
+	tenantScoped := public.Group("")
+	tenantScoped.Use(middlewares.TenantMiddleware(dbConfig))
+	attributeValidator := infrastructure.NewAttributeValidator()
+	mailSender := infrastructure.NewMailSender(*env)
+	blobStore := infrastructure.NewBlobStore(*env)
+	eventPublisher := infrastructure.NewEventPublisher(*env)
+
+	NewUserRouter(*env, protected, delegated, tenantScoped, dbConfig, attributeValidator, mailSender, blobStore)
+	NewInvitationRouter(*env, protected, tenantScoped, dbConfig, attributeValidator, mailSender)
+	NewPasswordRouter(*env, protected, tenantScoped, dbConfig, mailSender)
+	NewSignInRouter(protected, tenantScoped, dbConfig, activityTracker, jwtService)
+	NewScheduledChangeRouter(*env, protected, dbConfig, attributeValidator, mailSender)
+	NewGroupRouter(*env, protected, delegated, dbConfig)
 	NewRoleRouter(*env, protected, dbConfig)
+	NewTrashRouter(*env, protected, dbConfig)
+	NewAttributeSchemaRouter(*env, protected, dbConfig, attributeValidator)
 	NewGenerateTokenRouter(public)
+	NewHealthRouter(public, dbConfig)
+
+	startJobs(*env, dbConfig, eventPublisher, attributeValidator, mailSender)
 
 	router.Run(":8081")
 }
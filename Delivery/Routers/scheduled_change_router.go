@@ -0,0 +1,39 @@
+package routers
+
+import (
+	"github.com/gin-gonic/gin"
+	controllers "github.com/google-run-code/Delivery/Controllers"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	infrastructure "github.com/google-run-code/Infrastructure"
+	repository "github.com/google-run-code/Repository"
+	usecases "github.com/google-run-code/Usecases"
+	"github.com/google-run-code/config"
+)
+
+func NewScheduledChangeRouter(env config.Env, router *gin.RouterGroup, dbConfig *config.PostgresConfig, attributeValidator interfaces.AttributeValidator, mailSender interfaces.MailSender) {
+
+	scheduledChangeUseCase := newScheduledChangeUseCase(env, dbConfig, attributeValidator, mailSender)
+	scheduledChangeHandler := controllers.NewScheduledChangeController(scheduledChangeUseCase)
+
+	router.GET("/scheduled-changes", scheduledChangeHandler.GetScheduledChanges)
+	router.GET("/scheduled-changes/:id", scheduledChangeHandler.GetScheduledChangeById)
+	router.POST("/scheduled-changes", scheduledChangeHandler.CreateScheduledChange)
+	router.POST("/scheduled-changes/:id/cancel", scheduledChangeHandler.CancelScheduledChange)
+}
+
+// newScheduledChangeUseCase applies changes through the same use cases the
+// endpoints for users, groups and roles use.
+func newScheduledChangeUseCase(env config.Env, dbConfig *config.PostgresConfig, attributeValidator interfaces.AttributeValidator, mailSender interfaces.MailSender) interfaces.ScheduledChangeUseCase {
+	changeRepo := repository.NewScheduledChangeRepository(dbConfig)
+	userRepo := repository.NewUserRepository(dbConfig)
+	roleRepo := repository.NewRoleRepository(dbConfig)
+	groupRepo := repository.NewGroupRepository(dbConfig)
+	schemaRepo := repository.NewAttributeSchemaRepository(dbConfig)
+	emailService := infrastructure.NewEmailService(env, mailSender)
+
+	userUseCase := usecases.NewUserUseCase(userRepo, emailService, roleRepo, groupRepo, schemaRepo, attributeValidator)
+	groupUseCase := usecases.NewGroupUseCase(groupRepo)
+	roleUseCase := usecases.NewRoleUseCase(roleRepo, userRepo)
+
+	return usecases.NewScheduledChangeUseCase(changeRepo, userUseCase, groupUseCase, roleUseCase)
+}
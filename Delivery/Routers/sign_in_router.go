@@ -0,0 +1,25 @@
+package routers
+
+import (
+	"github.com/gin-gonic/gin"
+	controllers "github.com/google-run-code/Delivery/Controllers"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	infrastructure "github.com/google-run-code/Infrastructure"
+	repository "github.com/google-run-code/Repository"
+	usecases "github.com/google-run-code/Usecases"
+	"github.com/google-run-code/config"
+)
+
+func NewSignInRouter(router *gin.RouterGroup, tenantRouter *gin.RouterGroup, dbConfig *config.PostgresConfig, tracker interfaces.ActivityTracker, jwtService interfaces.JwtService) {
+
+	signInRepo := repository.NewSignInRepository(dbConfig)
+	userRepo := repository.NewUserRepository(dbConfig)
+	hasher := infrastructure.NewPasswordHasher()
+
+	signInUseCase := usecases.NewSignInUseCase(signInRepo, userRepo, hasher, tracker, jwtService)
+	signInHandler := controllers.NewSignInController(signInUseCase)
+
+	router.GET("/users/:id/signins", signInHandler.GetUserSignIns)
+
+	tenantRouter.POST("/signin", signInHandler.SignIn)
+}
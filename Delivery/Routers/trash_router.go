@@ -0,0 +1,18 @@
+package routers
+
+import (
+	"github.com/gin-gonic/gin"
+	controllers "github.com/google-run-code/Delivery/Controllers"
+	repository "github.com/google-run-code/Repository"
+	usecases "github.com/google-run-code/Usecases"
+	"github.com/google-run-code/config"
+)
+
+func NewTrashRouter(env config.Env, router *gin.RouterGroup, dbConfig *config.PostgresConfig) {
+
+	trashRepo := repository.NewTrashRepository(dbConfig)
+	trashUseCase := usecases.NewTrashUseCase(trashRepo)
+	trashHandler := controllers.NewTrashController(trashUseCase)
+
+	router.GET("/trash", trashHandler.GetTrash)
+}
@@ -3,29 +3,69 @@ package routers
 import (
 	"github.com/gin-gonic/gin"
 	controllers "github.com/google-run-code/Delivery/Controllers"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
 	infrastructure "github.com/google-run-code/Infrastructure"
 	repository "github.com/google-run-code/Repository"
 	usecases "github.com/google-run-code/Usecases"
 	"github.com/google-run-code/config"
 )
 
-func NewUserRouter(env config.Env, router *gin.RouterGroup , dbConfig *config.PostgresConfig) {
+func NewUserRouter(env config.Env, router *gin.RouterGroup, delegatedRouter *gin.RouterGroup, tenantRouter *gin.RouterGroup, dbConfig *config.PostgresConfig, attributeValidator interfaces.AttributeValidator, mailSender interfaces.MailSender, blobStore interfaces.BlobStore) {
 
 	userRepo := repository.NewUserRepository(dbConfig)
 	roleRepo := repository.NewRoleRepository(dbConfig)
 	groupRepo := repository.NewGroupRepository(dbConfig)
-	emailService := infrastructure.NewEmailService(env)
+	schemaRepo := repository.NewAttributeSchemaRepository(dbConfig)
+	emailService := infrastructure.NewEmailService(env, mailSender)
 
-	userUseCase := usecases.NewUserUseCase(userRepo, emailService, roleRepo, groupRepo)
+	userUseCase := usecases.NewUserUseCase(userRepo, emailService, roleRepo, groupRepo, schemaRepo, attributeValidator)
 	userHandler := controllers.NewUserController(userUseCase)
 
+	avatarUseCase := usecases.NewAvatarUseCase(userRepo, blobStore, env.AVATAR_MAX_BYTES)
+	avatarHandler := controllers.NewAvatarController(avatarUseCase)
+
+	privacyUseCase := usecases.NewPrivacyUseCase(userRepo, blobStore)
+	privacyHandler := controllers.NewPrivacyController(privacyUseCase)
+
 	router.GET("/users", userHandler.GetUsers)
-	router.GET("/users/:id", userHandler.GetUserById)
+	router.GET("/users/export.csv", userHandler.ExportUsers)
 	router.GET("/users/:id/groups", userHandler.GetUsersGroup)
+	// Managers may look at themselves and their reports with a token bound to them
+	delegatedRouter.GET("/users/:id", userHandler.GetUserById)
+	delegatedRouter.GET("/users/:id/reports", userHandler.GetReports)
+	delegatedRouter.GET("/users/:id/reports/:reportId", userHandler.GetReport)
+	delegatedRouter.GET("/users/:id/chain", userHandler.GetManagementChain)
 
 	router.POST("/users", userHandler.CreateUser)
+	router.POST("/users:method", userHandler.BatchUsers)
+	router.POST("/users/import", userHandler.ImportUsers)
 	router.PATCH("/users/:id", userHandler.UpdateUser)
 	router.DELETE("/users/:id", userHandler.DeleteUser)
+	router.POST("/users/:id/restore", userHandler.RestoreUser)
+	router.POST("/users/:id/merge", userHandler.MergeUsers)
+
+	router.POST("/users/:id/activate", userHandler.ActivateUser)
+	router.POST("/users/:id/suspend", userHandler.SuspendUser)
+	router.POST("/users/:id/lock", userHandler.LockUser)
+	router.POST("/users/:id/deprovision", userHandler.DeprovisionUser)
+
+	router.GET("/users/:id/avatar", avatarHandler.GetAvatar)
+	router.PUT("/users/:id/avatar", avatarHandler.UploadAvatar)
+	router.DELETE("/users/:id/avatar", avatarHandler.DeleteAvatar)
+	router.GET("/users/:id/export", privacyHandler.ExportUser)
+	router.POST("/users/:id/erase", privacyHandler.EraseUser)
+
+	router.POST("/users/:id/verification", userHandler.SendEmailVerification)
+	tenantRouter.GET("/verify-email", userHandler.VerifyEmail)
+
+	router.GET("/users/:id/emails", userHandler.GetEmailAliases)
+	router.POST("/users/:id/emails", userHandler.AddEmailAlias)
+	router.POST("/users/:id/emails/:aliasId/verification", userHandler.SendEmailAliasVerification)
+	router.DELETE("/users/:id/emails/:aliasId", userHandler.DeleteEmailAlias)
+
+	router.GET("/users/:id/roles", userHandler.GetUserRoles)
+	router.POST("/users/:id/roles", userHandler.AddUserRole)
+	router.DELETE("/users/:id/roles/:roleId", userHandler.RemoveUserRole)
 
 	router.POST("/users/:id/groups", userHandler.AddUserToGroup)
 	router.DELETE("/users/:id/groups", userHandler.DeletetUserFromGroup)
@@ -0,0 +1,15 @@
+package dtos
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AttributeSchemaRequest struct {
+	Schema json.RawMessage `json:"schema" binding:"required"`
+}
+
+type AttributeSchemaResponse struct {
+	Schema    json.RawMessage `json:"schema"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
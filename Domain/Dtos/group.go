@@ -1,14 +1,86 @@
 package dtos
 
+import (
+	"encoding/json"
+	"time"
+)
+
+// GroupCreateRequest creates a group, nested in the group ParentId when set.
+// Every group has at least one owner. With a Rule the group is dynamic.
 type GroupCreateRequest struct {
-	Name string `json:"name" binding:"required"`
+	Name     string          `json:"name" binding:"required"`
+	ParentId string          `json:"parent_id"`
+	OwnerIds []string        `json:"owner_ids" binding:"required,min=1"`
+	Rule     json.RawMessage `json:"rule,omitempty"`
 }
 
+// GroupUpdateRequest changes the fields that are set. An empty ParentId makes
+// the group top-level. A Rule makes the group dynamic, and a null rule makes it
+// static again, keeping its current members.
 type GroupUpdateRequest struct {
-	Name string `json:"name"`
+	Name     string          `json:"name"`
+	ParentId *string         `json:"parent_id"`
+	Rule     json.RawMessage `json:"rule,omitempty"`
 }
 
+type GroupRulePreviewRequest struct {
+	Rule json.RawMessage `json:"rule" binding:"required"`
+}
+
+// GroupRulePreview lists the users a rule would make members of a group.
+type GroupRulePreview struct {
+	Count int            `json:"count"`
+	Users []UserResponse `json:"users"`
+}
+
+// GroupResponse is a group. Listed as one of a user's groups it also carries
+// the validity period of the membership, and InheritedFrom names the group the
+// user is a direct member of when the membership comes from a nested group.
 type GroupResponse struct {
-	UID   string    `json:"uid"`
+	UID           string          `json:"uid"`
+	Name          string          `json:"name"`
+	Type          string          `json:"type,omitempty"`
+	Rule          json.RawMessage `json:"rule,omitempty"`
+	ParentID      string          `json:"parent_id,omitempty"`
+	InheritedFrom string          `json:"inherited_from,omitempty"`
+	ValidFrom     *time.Time      `json:"valid_from,omitempty"`
+	ValidUntil    *time.Time      `json:"valid_until,omitempty"`
+}
+
+type GroupRoleRequest struct {
+	RoleId string `json:"role_id" binding:"required"`
+}
+
+// GroupReference names the group a user holds a role through.
+type GroupReference struct {
+	UID  string `json:"uid"`
 	Name string `json:"name"`
 }
+
+type GroupOwnerRequest struct {
+	UserId string `json:"user_id" binding:"required"`
+}
+
+// GroupMemberRequest puts a user in a group, optionally only from ValidFrom
+// until ValidUntil.
+type GroupMemberRequest struct {
+	ValidFrom  *time.Time `json:"valid_from"`
+	ValidUntil *time.Time `json:"valid_until"`
+}
+
+// GroupMembersRequest lists the users to set, add or remove as members of a
+// group. The validity period applies to the users that are added.
+type GroupMembersRequest struct {
+	UserIds    []string   `json:"user_ids" binding:"required"`
+	ValidFrom  *time.Time `json:"valid_from"`
+	ValidUntil *time.Time `json:"valid_until"`
+}
+
+// GroupMembersDiff is the outcome of a change to the members of a group. Added
+// and Unchanged are the members after the change, Removed and Unchanged the
+// members before it.
+type GroupMembersDiff struct {
+	Added     []UserResponse `json:"added"`
+	Removed   []UserResponse `json:"removed"`
+	Unchanged []UserResponse `json:"unchanged"`
+}
@@ -0,0 +1,32 @@
+package dtos
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type InvitationCreateRequest struct {
+	Email      string          `json:"email" binding:"required"`
+	Name       string          `json:"name"`
+	RoleId     string          `json:"role_id"`
+	GroupIds   []string        `json:"group_ids"`
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+}
+
+type InvitationAcceptRequest struct {
+	Token      string          `json:"token" binding:"required"`
+	Name       *string         `json:"name,omitempty"`
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+}
+
+type InvitationResponse struct {
+	UID        string     `json:"uid"`
+	UserUID    string     `json:"user_uid"`
+	Email      string     `json:"email"`
+	Status     string     `json:"status"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	Warning    string     `json:"warning,omitempty"`
+}
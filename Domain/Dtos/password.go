@@ -0,0 +1,29 @@
+package dtos
+
+import "time"
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+type PasswordPolicyRequest struct {
+	MinLength        int  `json:"min_length" binding:"required"`
+	RequireUppercase bool `json:"require_uppercase"`
+	RequireLowercase bool `json:"require_lowercase"`
+	RequireDigit     bool `json:"require_digit"`
+	RequireSymbol    bool `json:"require_symbol"`
+}
+
+type PasswordPolicyResponse struct {
+	MinLength        int        `json:"min_length"`
+	RequireUppercase bool       `json:"require_uppercase"`
+	RequireLowercase bool       `json:"require_lowercase"`
+	RequireDigit     bool       `json:"require_digit"`
+	RequireSymbol    bool       `json:"require_symbol"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+}
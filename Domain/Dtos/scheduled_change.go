@@ -0,0 +1,44 @@
+package dtos
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ScheduledChangeRequest schedules a change of the user, group or role with
+// TargetID. Payload is the body the matching endpoint takes: user.status takes
+// ScheduledStatusPayload, user.role.add UserRoleAssignRequest,
+// user.role.remove ScheduledRolePayload, user.groups.add AddUserToGroupRequest
+// and user.groups.remove RemoveUserFromGroupRequest. Deletions take none.
+type ScheduledChangeRequest struct {
+	Type        string          `json:"type" binding:"required"`
+	TargetID    string          `json:"target_id" binding:"required"`
+	EffectiveAt time.Time       `json:"effective_at" binding:"required"`
+	Payload     json.RawMessage `json:"payload,omitempty"`
+}
+
+type ScheduledStatusPayload struct {
+	Status string `json:"status" binding:"required"`
+}
+
+type ScheduledRolePayload struct {
+	RoleId string `json:"role_id" binding:"required"`
+}
+
+type ScheduledChangeFilter struct {
+	Status   string
+	TargetID string
+}
+
+type ScheduledChangeResponse struct {
+	UID         string          `json:"uid"`
+	Type        string          `json:"type"`
+	TargetID    string          `json:"target_id"`
+	Payload     json.RawMessage `json:"payload,omitempty"`
+	EffectiveAt time.Time       `json:"effective_at"`
+	Status      string          `json:"status"`
+	Result      string          `json:"result,omitempty"`
+	FinishedAt  *time.Time      `json:"finished_at,omitempty"`
+	CancelledAt *time.Time      `json:"cancelled_at,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
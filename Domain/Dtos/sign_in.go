@@ -0,0 +1,34 @@
+package dtos
+
+import "time"
+
+// SignInRequest checks a user's password. The address and user agent are
+// filled in from the request.
+type SignInRequest struct {
+	Email     string `json:"email" binding:"required"`
+	Password  string `json:"password" binding:"required"`
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+// SignInResult is the signed-in user with a token bound to them.
+type SignInResult struct {
+	UserResponse
+	Token string `json:"token"`
+}
+
+type SignInResponse struct {
+	Email     string    `json:"email"`
+	IPAddress string    `json:"ip_address"`
+	UserAgent string    `json:"user_agent"`
+	Outcome   string    `json:"outcome"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// UserActivity is the latest activity of a user that has not been written to
+// the database yet. LoginAt is empty when the user did not sign in.
+type UserActivity struct {
+	UserUID string
+	SeenAt  time.Time
+	LoginAt *time.Time
+}
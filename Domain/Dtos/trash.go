@@ -0,0 +1,10 @@
+package dtos
+
+import "time"
+
+type TrashEntryResponse struct {
+	Type      string    `json:"type"`
+	UID       string    `json:"uid"`
+	Name      string    `json:"name"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
@@ -1,61 +1,253 @@
 package dtos
 
+import (
+	"encoding/json"
+	"time"
+)
+
+// UserProfile holds the optional display details of a user.
+type UserProfile struct {
+	GivenName   string `json:"given_name,omitempty"`
+	FamilyName  string `json:"family_name,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	PhoneNumber string `json:"phone_number,omitempty"`
+	Locale      string `json:"locale,omitempty"`
+	Timezone    string `json:"timezone,omitempty"`
+	Title       string `json:"title,omitempty"`
+}
+
+// UserProfileUpdate changes the profile fields that are set. An empty string
+// clears the field.
+type UserProfileUpdate struct {
+	GivenName   *string `json:"given_name,omitempty"`
+	FamilyName  *string `json:"family_name,omitempty"`
+	DisplayName *string `json:"display_name,omitempty"`
+	PhoneNumber *string `json:"phone_number,omitempty"`
+	Locale      *string `json:"locale,omitempty"`
+	Timezone    *string `json:"timezone,omitempty"`
+	Title       *string `json:"title,omitempty"`
+}
+
 type UserCreateRequest struct {
-	Name   string `json:"name" binding:"required"`
-	Email  string `json:"email" binding:"required"`
-	Status int    `json:"status"`
-	RoleId string `json:"role_id"`
+	Name       string          `json:"name" binding:"required"`
+	Email      string          `json:"email" binding:"required"`
+	Status     string          `json:"status"`
+	RoleId     string          `json:"role_id"`
+	ManagerId  string          `json:"manager_id"`
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+	UserProfile
 }
 
 type UserUpdateRequest struct {
-	Name    *string `json:"name,omitempty"`
-	Email   *string `json:"email,omitempty"`
-	Status  *int    `json:"status,omitempty"`
-	RoleId  *string `json:"role_id,omitempty"`
-	UserUID string  `json:"UserUID"`
+	Name       *string         `json:"name,omitempty"`
+	Email      *string         `json:"email,omitempty"`
+	Status     *string         `json:"status,omitempty"`
+	RoleId     *string         `json:"role_id,omitempty"`
+	ManagerId  *string         `json:"manager_id,omitempty"`
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+	UserUID    string          `json:"UserUID"`
+	UserProfileUpdate
 }
 
+// AddUserToGroupRequest puts a user in groups, optionally only from ValidFrom
+// until ValidUntil.
 type AddUserToGroupRequest struct {
-	UserUID  string   `json:"UserUID"`
-	GroupIds []string `json:"group_ids" binding:"required"`
+	UserUID    string     `json:"UserUID"`
+	GroupIds   []string   `json:"group_ids" binding:"required"`
+	ValidFrom  *time.Time `json:"valid_from"`
+	ValidUntil *time.Time `json:"valid_until"`
+}
+
+// UserRoleAssignRequest gives a user a role, optionally only from StartsAt
+// until ExpiresAt.
+type UserRoleAssignRequest struct {
+	RoleId    string     `json:"role_id" binding:"required"`
+	Primary   bool       `json:"primary"`
+	StartsAt  *time.Time `json:"starts_at,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 }
 
-type AddUserToRoleRequest struct {
-	UserUID string `json:"UserUID"`
-	RoleId  string `json:"role_id" binding:"required"`
+// UserRoleResponse is one of the roles a user holds. Active is false until
+// StartsAt is reached. Source tells whether the user holds the role directly
+// or as a member of Group, in which case ExpiresAt is the end of the
+// membership.
+type UserRoleResponse struct {
+	UID        string          `json:"uid"`
+	Name       string          `json:"name"`
+	Rights     json.RawMessage `json:"rights"`
+	Primary    bool            `json:"primary"`
+	Active     bool            `json:"active"`
+	StartsAt   *time.Time      `json:"starts_at,omitempty"`
+	ExpiresAt  *time.Time      `json:"expires_at,omitempty"`
+	AssignedAt time.Time       `json:"assigned_at"`
+	Source     string          `json:"source"`
+	Group      *GroupReference `json:"group,omitempty"`
 }
 
+// RoleUserResponse is a user holding a role, with the bounds of their
+// assignment.
+type RoleUserResponse struct {
+	UserResponse
+	Primary   bool       `json:"primary"`
+	Active    bool       `json:"active"`
+	StartsAt  *time.Time `json:"starts_at,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// ExpiredRoleAssignment is a role assignment removed because it ran out.
+type ExpiredRoleAssignment struct {
+	UserUID   string
+	RoleUID   string
+	RoleName  string
+	Primary   bool
+	ExpiresAt time.Time
+}
+
+// ExpiredGroupMembership is a group membership removed because it ran out.
+type ExpiredGroupMembership struct {
+	UserUID    string
+	GroupUID   string
+	GroupName  string
+	ValidUntil time.Time
+}
+
+// UserResponse is a user. Listed as a member of a group, InheritedFrom names
+// the nested group the user is a direct member of.
 type UserResponse struct {
-	UID    string `json:"uid"`
-	Name   string `json:"name"`
-	Email  string `json:"email"`
-	Status int    `json:"status"`
+	UID           string `json:"uid"`
+	Name          string `json:"name"`
+	Email         string `json:"email"`
+	Status        string `json:"status"`
+	InheritedFrom string `json:"inherited_from,omitempty"`
 }
 
+// UserResponseSingle is a user with their relations. Role is the primary role,
+// Roles lists every role the user holds and Rights is the union of their rights.
 type UserResponseSingle struct {
-	UID    string          `json:"uid"`
-	Name   string          `json:"name"`
-	Email  string          `json:"email"`
-	Status int             `json:"status"`
-	Groups []GroupResponse `json:"groups"`
-	Role   *RoleResponse   `json:"role"`
+	UID             string               `json:"uid"`
+	Name            string               `json:"name"`
+	Email           string               `json:"email"`
+	EmailVerified   bool                 `json:"email_verified"`
+	EmailVerifiedAt *time.Time           `json:"email_verified_at,omitempty"`
+	Status          string               `json:"status"`
+	Attributes      json.RawMessage      `json:"attributes,omitempty"`
+	Groups          []GroupResponse      `json:"groups"`
+	Role            *RoleResponse        `json:"role"`
+	Roles           []UserRoleResponse   `json:"roles"`
+	Rights          json.RawMessage      `json:"rights,omitempty"`
+	Manager         *UserReference       `json:"manager,omitempty"`
+	EmailAliases    []EmailAliasResponse `json:"email_aliases,omitempty"`
+	AvatarURL       string               `json:"avatar_url,omitempty"`
+	LastLoginAt     *time.Time           `json:"last_login_at,omitempty"`
+	LastSeenAt      *time.Time           `json:"last_seen_at,omitempty"`
+	ErasedAt        *time.Time           `json:"erased_at,omitempty"`
+	UserProfile
 }
 
 type UserResponseAll struct {
-	UID    string               `json:"uid"`
-	Name   string               `json:"name"`
-	Email  string               `json:"email"`
-	Status int                  `json:"status"`
-	Role   *RoleResponseNoRight `json:"role"`
+	UID         string               `json:"uid"`
+	Name        string               `json:"name"`
+	Email       string               `json:"email"`
+	Status      string               `json:"status"`
+	Attributes  json.RawMessage      `json:"attributes,omitempty"`
+	Role        *RoleResponseNoRight `json:"role"`
+	Manager     *UserReference       `json:"manager,omitempty"`
+	AvatarURL   string               `json:"avatar_url,omitempty"`
+	LastLoginAt *time.Time           `json:"last_login_at,omitempty"`
+	LastSeenAt  *time.Time           `json:"last_seen_at,omitempty"`
+	UserProfile
+}
+
+// UserOrderColumns maps the values ?orderby= accepts to the column users are
+// sorted by. A leading "-" sorts in descending order.
+var UserOrderColumns = map[string]string{
+	"name":          "name",
+	"email":         "email_normalized",
+	"status":        "status",
+	"last_login_at": "last_login_at",
+	"last_seen_at":  "last_seen_at",
 }
 
 type SearchFields struct {
 	Name    string `json:"Name"`
 	Limit   int    `json:"limit"`
 	OrderBy string `json:"orderBy"`
+	// Attributes filters on custom attributes, matching each key's value as text.
+	Attributes map[string]string `json:"attributes"`
+	// Profile filters on profile fields by exact match. Name also matches the
+	// given, family and display names.
+	Profile UserProfile `json:"profile"`
+	// InactiveDays keeps users who have not signed in for that many days,
+	// including users who never did.
+	InactiveDays int `json:"inactive_days"`
 }
 
 type RemoveUserFromGroupRequest struct {
 	UserUID  string   `json:"UserUID"`
 	GroupIds []string `json:"group_ids" binding:"required"`
 }
+
+type MembershipFilter struct {
+	// Effective keeps only users whose status grants access.
+	Effective bool
+	// Transitive resolves nested groups: a member of a group is also a member
+	// of every group it is nested in.
+	Transitive bool
+}
+
+// UserAvatar locates a user's avatar in the blob store.
+type UserAvatar struct {
+	Key         string
+	ContentType string
+}
+
+type EmailAliasCreateRequest struct {
+	Email string `json:"email" binding:"required"`
+}
+
+type EmailAliasResponse struct {
+	UID        string     `json:"uid"`
+	Email      string     `json:"email"`
+	Verified   bool       `json:"verified"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// Role conflict strategies of a merge, used when the source holds roles the
+// target does not.
+const (
+	MergeRoleKeepTarget = "keep_target"
+	MergeRoleKeepSource = "keep_source"
+	MergeRoleFail       = "fail"
+)
+
+// UserMergeOverrides replaces fields of the surviving user. Fields that are not
+// set keep the survivor's value.
+type UserMergeOverrides struct {
+	Name       *string         `json:"name,omitempty"`
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+	UserProfileUpdate
+}
+
+type UserMergeRequest struct {
+	SourceID     string             `json:"source_id" binding:"required"`
+	RoleConflict string             `json:"role_conflict"`
+	Overrides    UserMergeOverrides `json:"overrides"`
+}
+
+type UserReference struct {
+	UID   string `json:"uid"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// UserReportResponse is a user in someone's reporting line. Depth is 1 for
+// direct reports and for the direct manager in a management chain.
+type UserReportResponse struct {
+	UID        string `json:"uid"`
+	Name       string `json:"name"`
+	Email      string `json:"email"`
+	Title      string `json:"title,omitempty"`
+	ManagerUID string `json:"manager_id,omitempty"`
+	Depth      int    `json:"depth"`
+}
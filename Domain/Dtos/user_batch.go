@@ -0,0 +1,47 @@
+package dtos
+
+import "encoding/json"
+
+const (
+	BatchOpCreate = "create"
+	BatchOpUpdate = "update"
+	BatchOpDelete = "delete"
+)
+
+type BatchUsersRequest struct {
+	Operations []BatchUserOperation `json:"operations" binding:"required"`
+	// ContinueOnError applies every valid operation instead of rolling back the
+	// whole batch on the first failure.
+	ContinueOnError bool `json:"continue_on_error"`
+}
+
+// BatchUserOperation carries a UserCreateRequest or UserUpdateRequest in Data,
+// depending on Op. ID is the user UID for updates and deletes.
+type BatchUserOperation struct {
+	Op   string          `json:"op"`
+	ID   string          `json:"id,omitempty"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// BatchUserChange is a validated operation handed to the repository.
+type BatchUserChange struct {
+	Index  int
+	Op     string
+	UID    string
+	Create *UserCreateRequest
+	Update *UserUpdateRequest
+}
+
+type BatchUserResult struct {
+	Index  int    `json:"index"`
+	Op     string `json:"op"`
+	UID    string `json:"uid,omitempty"`
+	Status int    `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type BatchUsersResponse struct {
+	Succeeded int                `json:"succeeded"`
+	Failed    int                `json:"failed"`
+	Results   []*BatchUserResult `json:"results"`
+}
@@ -0,0 +1,78 @@
+package dtos
+
+import "strings"
+
+// UserCSVGroupSeparator separates group names within the groups column.
+const UserCSVGroupSeparator = ";"
+
+const (
+	UserCSVFieldName   = "name"
+	UserCSVFieldEmail  = "email"
+	UserCSVFieldStatus = "status"
+	UserCSVFieldRole   = "role"
+	UserCSVFieldGroups = "groups"
+)
+
+// userCSVFormulaPrefixes are the characters that make a spreadsheet evaluate a
+// cell as a formula.
+const userCSVFormulaPrefixes = "=+-@\t\r"
+
+// EscapeUserCSVCell quotes a value that a spreadsheet would otherwise evaluate
+// as a formula, so a crafted user name cannot run when the export is opened.
+func EscapeUserCSVCell(value string) string {
+	if value != "" && strings.ContainsRune(userCSVFormulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// UnescapeUserCSVCell undoes EscapeUserCSVCell, so exported files import
+// unchanged.
+func UnescapeUserCSVCell(value string) string {
+	if len(value) > 1 && value[0] == '\'' && strings.ContainsRune(userCSVFormulaPrefixes, rune(value[1])) {
+		return value[1:]
+	}
+	return value
+}
+
+// UserExportRow is one exported user. Role is the user's primary role only.
+type UserExportRow struct {
+	UID    string
+	Name   string
+	Email  string
+	Status string
+	Role   string
+	Groups []string
+}
+
+// UserImportRow is a parsed CSV row. Empty fields leave the existing value of
+// an updated user unchanged.
+type UserImportRow struct {
+	Line   int
+	Name   string
+	Email  string
+	Status string
+	Role   string
+	Groups []string
+}
+
+type UserImportRequest struct {
+	// Mapping maps import fields to CSV column headers. Fields that are not
+	// mapped are read from the column with the same name.
+	Mapping map[string]string
+	DryRun  bool
+}
+
+type UserImportError struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+type UserImportResult struct {
+	DryRun  bool              `json:"dry_run"`
+	Total   int               `json:"total"`
+	Created int               `json:"created"`
+	Updated int               `json:"updated"`
+	Failed  int               `json:"failed"`
+	Errors  []UserImportError `json:"errors"`
+}
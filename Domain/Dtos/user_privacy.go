@@ -0,0 +1,31 @@
+package dtos
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// UserDataExport is everything stored about a user. Memberships and Roles
+// include those that are not valid yet, next to the ones on the profile that
+// grant access now.
+type UserDataExport struct {
+	ExportedAt   time.Time            `json:"exported_at"`
+	Profile      UserResponseSingle   `json:"profile"`
+	Memberships  []GroupResponse      `json:"memberships"`
+	Roles        []UserRoleResponse   `json:"roles"`
+	SignIns      []SignInResponse     `json:"sign_ins"`
+	Invitations  []InvitationResponse `json:"invitations"`
+	AuditEntries []AuditEntryResponse `json:"audit_entries"`
+}
+
+type AuditEntryResponse struct {
+	UID       string          `json:"uid"`
+	Action    string          `json:"action"`
+	Details   json.RawMessage `json:"details,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// ErasedUser is what an erasure leaves to clean up outside the database.
+type ErasedUser struct {
+	AvatarKeys []string
+}
@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"encoding/json"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+type AttributeValidator interface {
+	CheckSchema(schema json.RawMessage) error
+	Validate(schema json.RawMessage, attributes json.RawMessage) error
+}
+
+type AttributeSchemaController interface {
+	GetAttributeSchema(c *gin.Context)
+	SaveAttributeSchema(c *gin.Context)
+	DeleteAttributeSchema(c *gin.Context)
+}
+
+type AttributeSchemaUseCase interface {
+	GetAttributeSchema(ctx *gin.Context) (*dtos.AttributeSchemaResponse, *models.ErrorResponse)
+	SaveAttributeSchema(req dtos.AttributeSchemaRequest, ctx *gin.Context) (*dtos.AttributeSchemaResponse, *models.ErrorResponse)
+	DeleteAttributeSchema(ctx *gin.Context) *models.ErrorResponse
+}
+
+type AttributeSchemaRepository interface {
+	GetAttributeSchema(ctx *gin.Context) (*dtos.AttributeSchemaResponse, *models.ErrorResponse)
+	SaveAttributeSchema(schema json.RawMessage, ctx *gin.Context) (*dtos.AttributeSchemaResponse, *models.ErrorResponse)
+	DeleteAttributeSchema(ctx *gin.Context) *models.ErrorResponse
+}
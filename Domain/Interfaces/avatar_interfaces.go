@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"io"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+type AvatarController interface {
+	GetAvatar(c *gin.Context)
+	UploadAvatar(c *gin.Context)
+	DeleteAvatar(c *gin.Context)
+}
+
+type AvatarUseCase interface {
+	GetAvatar(userUID string, ctx *gin.Context) (io.ReadCloser, string, *models.ErrorResponse)
+	UploadAvatar(userUID string, content io.Reader, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse)
+	DeleteAvatar(userUID string, ctx *gin.Context) *models.ErrorResponse
+}
@@ -0,0 +1,11 @@
+package interfaces
+
+import "io"
+
+// BlobStore keeps binary content such as avatars outside the database. Open
+// returns an error wrapping fs.ErrNotExist for unknown keys.
+type BlobStore interface {
+	Put(key string, content io.Reader) error
+	Open(key string) (io.ReadCloser, error)
+	Delete(key string) error
+}
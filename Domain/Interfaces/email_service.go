@@ -1,5 +1,19 @@
 package interfaces
 
+import (
+	"time"
+
+	models "github.com/google-run-code/Domain/Models"
+)
+
 type EmailService interface {
 	IsValidEmail(email string) bool
+	SendVerificationEmail(tenant string, userUID string, name string, email string) error
+	ParseVerificationToken(token string) (*models.EmailVerificationClaims, error)
+	SendInvitationEmail(tenant string, name string, email string, token string, expiresAt time.Time) error
+	SendPasswordResetEmail(tenant string, name string, email string, token string, expiresAt time.Time) error
+}
+
+type MailSender interface {
+	Send(message models.MailMessage) error
 }
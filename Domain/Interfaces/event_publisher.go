@@ -0,0 +1,7 @@
+package interfaces
+
+import models "github.com/google-run-code/Domain/Models"
+
+type EventPublisher interface {
+	Publish(event models.Event) error
+}
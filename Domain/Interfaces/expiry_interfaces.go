@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"github.com/gin-gonic/gin"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// ExpiryUseCase removes time-bound assignments and memberships that ran out. Its methods run
+// as background jobs, once per tenant.
+type ExpiryUseCase interface {
+	ExpireRoleAssignments(ctx *gin.Context) *models.ErrorResponse
+	ExpireGroupMemberships(ctx *gin.Context) *models.ErrorResponse
+}
@@ -13,23 +13,67 @@ type GroupController interface {
 	CreateGroup(c *gin.Context)
 	UpdateGroup(c *gin.Context)
 	DeleteGroup(c *gin.Context)
+	RestoreGroup(c *gin.Context)
+	GetGroupOwners(c *gin.Context)
+	AddGroupOwner(c *gin.Context)
+	RemoveGroupOwner(c *gin.Context)
+	AddGroupMember(c *gin.Context)
+	RemoveGroupMember(c *gin.Context)
+	SetGroupMembers(c *gin.Context)
+	AddGroupMembers(c *gin.Context)
+	RemoveGroupMembers(c *gin.Context)
+	PreviewGroupRule(c *gin.Context)
+	GetGroupRoles(c *gin.Context)
+	AddGroupRole(c *gin.Context)
+	RemoveGroupRole(c *gin.Context)
 }
 
 type GroupUseCase interface {
 	GetAllGroups(ctx *gin.Context) ([]*dtos.GroupResponse, *models.ErrorResponse)
 	GetGroupById(id string, ctx *gin.Context) (*dtos.GroupResponse, *models.ErrorResponse)
-	GetGroupUsers(id string, ctx *gin.Context) ([]dtos.UserResponse, *models.ErrorResponse)
+	GetGroupUsers(id string, filter dtos.MembershipFilter, ctx *gin.Context) ([]dtos.UserResponse, *models.ErrorResponse)
 	CreateGroup(group dtos.GroupCreateRequest, ctx *gin.Context) (*dtos.GroupResponse, *models.ErrorResponse)
 	UpdateGroup(id string, group dtos.GroupUpdateRequest, ctx *gin.Context) (*dtos.GroupResponse, *models.ErrorResponse)
 	DeleteGroup(id string, ctx *gin.Context) *models.ErrorResponse
+	RestoreGroup(id string, ctx *gin.Context) (*dtos.GroupResponse, *models.ErrorResponse)
+	GetGroupOwners(id string, ctx *gin.Context) ([]dtos.UserResponse, *models.ErrorResponse)
+	AddGroupOwner(id string, req dtos.GroupOwnerRequest, ctx *gin.Context) ([]dtos.UserResponse, *models.ErrorResponse)
+	RemoveGroupOwner(id string, userId string, ctx *gin.Context) *models.ErrorResponse
+	AddGroupMember(id string, userId string, req dtos.GroupMemberRequest, ctx *gin.Context) *models.ErrorResponse
+	RemoveGroupMember(id string, userId string, ctx *gin.Context) *models.ErrorResponse
+	SetGroupMembers(id string, req dtos.GroupMembersRequest, ctx *gin.Context) (*dtos.GroupMembersDiff, *models.ErrorResponse)
+	AddGroupMembers(id string, req dtos.GroupMembersRequest, ctx *gin.Context) (*dtos.GroupMembersDiff, *models.ErrorResponse)
+	RemoveGroupMembers(id string, req dtos.GroupMembersRequest, ctx *gin.Context) (*dtos.GroupMembersDiff, *models.ErrorResponse)
+	PreviewGroupRule(req dtos.GroupRulePreviewRequest, ctx *gin.Context) (*dtos.GroupRulePreview, *models.ErrorResponse)
+	EvaluateDynamicGroups(ctx *gin.Context) *models.ErrorResponse
+	GetGroupRoles(id string, ctx *gin.Context) ([]dtos.RoleResponse, *models.ErrorResponse)
+	AddGroupRole(id string, req dtos.GroupRoleRequest, ctx *gin.Context) ([]dtos.RoleResponse, *models.ErrorResponse)
+	RemoveGroupRole(id string, roleId string, ctx *gin.Context) *models.ErrorResponse
 }
 
 type GroupRepository interface {
 	GetAllGroups(ctx *gin.Context) ([]*dtos.GroupResponse, *models.ErrorResponse)
 	GetGroupById(id string, ctx *gin.Context) (*dtos.GroupResponse, *models.ErrorResponse)
 	GetGroupUsers(id string, ctx *gin.Context) ([]dtos.UserResponse, *models.ErrorResponse)
+	GetGroupUsersTransitive(id string, ctx *gin.Context) ([]dtos.UserResponse, *models.ErrorResponse)
 	GetGroupByName(name string, ctx *gin.Context) (*dtos.GroupResponse, *models.ErrorResponse)
 	CreateGroup(group dtos.GroupCreateRequest, ctx *gin.Context) (*dtos.GroupResponse, *models.ErrorResponse)
 	UpdateGroup(id string, group dtos.GroupUpdateRequest, ctx *gin.Context) (*dtos.GroupResponse, *models.ErrorResponse)
 	DeleteGroup(id string, ctx *gin.Context) *models.ErrorResponse
+	RestoreGroup(id string, ctx *gin.Context) (*dtos.GroupResponse, *models.ErrorResponse)
+	GetGroupOwners(id string, ctx *gin.Context) ([]dtos.UserResponse, *models.ErrorResponse)
+	AddGroupOwner(id string, userUID string, ctx *gin.Context) *models.ErrorResponse
+	RemoveGroupOwner(id string, userUID string, ctx *gin.Context) *models.ErrorResponse
+	IsGroupOwner(id string, actor models.Actor, ctx *gin.Context) (bool, *models.ErrorResponse)
+	GroupGrantsRoles(id string, ctx *gin.Context) (bool, *models.ErrorResponse)
+	AddGroupMember(id string, userUID string, req dtos.GroupMemberRequest, ctx *gin.Context) *models.ErrorResponse
+	RemoveGroupMember(id string, userUID string, ctx *gin.Context) *models.ErrorResponse
+	SetGroupMembers(id string, req dtos.GroupMembersRequest, ctx *gin.Context) (*dtos.GroupMembersDiff, *models.ErrorResponse)
+	AddGroupMembers(id string, req dtos.GroupMembersRequest, ctx *gin.Context) (*dtos.GroupMembersDiff, *models.ErrorResponse)
+	RemoveGroupMembers(id string, req dtos.GroupMembersRequest, ctx *gin.Context) (*dtos.GroupMembersDiff, *models.ErrorResponse)
+	PreviewGroupRule(rule *models.GroupRule, ctx *gin.Context) (*dtos.GroupRulePreview, *models.ErrorResponse)
+	EvaluateDynamicGroups(ctx *gin.Context) *models.ErrorResponse
+	GetGroupRoles(id string, ctx *gin.Context) ([]dtos.RoleResponse, *models.ErrorResponse)
+	AddGroupRole(id string, roleUID string, ctx *gin.Context) *models.ErrorResponse
+	RemoveGroupRole(id string, roleUID string, ctx *gin.Context) *models.ErrorResponse
 }
@@ -0,0 +1,36 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+type InvitationController interface {
+	GetInvitations(c *gin.Context)
+	CreateInvitation(c *gin.Context)
+	ResendInvitation(c *gin.Context)
+	RevokeInvitation(c *gin.Context)
+	AcceptInvitation(c *gin.Context)
+}
+
+type InvitationUseCase interface {
+	GetInvitations(status string, ctx *gin.Context) ([]*dtos.InvitationResponse, *models.ErrorResponse)
+	CreateInvitation(req dtos.InvitationCreateRequest, ctx *gin.Context) (*dtos.InvitationResponse, *models.ErrorResponse)
+	ResendInvitation(id string, ctx *gin.Context) (*dtos.InvitationResponse, *models.ErrorResponse)
+	RevokeInvitation(id string, ctx *gin.Context) *models.ErrorResponse
+	AcceptInvitation(req dtos.InvitationAcceptRequest, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse)
+}
+
+type InvitationRepository interface {
+	GetInvitations(ctx *gin.Context) ([]*models.Invitation, *models.ErrorResponse)
+	GetInvitationById(uid string, ctx *gin.Context) (*models.Invitation, *models.ErrorResponse)
+	GetInvitationByTokenHash(tokenHash string, ctx *gin.Context) (*models.Invitation, *models.ErrorResponse)
+	CreateInvitation(user dtos.UserCreateRequest, groupUIDs []string, tokenHash string, expiresAt time.Time, ctx *gin.Context) (*models.Invitation, *models.ErrorResponse)
+	RenewInvitation(uid string, tokenHash string, expiresAt time.Time, ctx *gin.Context) (*models.Invitation, *models.ErrorResponse)
+	RevokeInvitation(uid string, ctx *gin.Context) *models.ErrorResponse
+	AcceptInvitation(uid string, name *string, attributes json.RawMessage, ctx *gin.Context) *models.ErrorResponse
+}
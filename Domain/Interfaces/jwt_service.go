@@ -6,4 +6,5 @@ type JwtService interface {
 	ValidateToken(tokenStr string) (*models.JWTCustome, error)
 	ValidateAuthHeader(authHeader string) ([]string, error)
 	GenerateToken(database string) (string, error)
+	GenerateUserToken(database string, userUID string) (string, error)
 }
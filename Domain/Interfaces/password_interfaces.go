@@ -0,0 +1,36 @@
+package interfaces
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	Compare(hash string, password string) bool
+}
+
+type PasswordController interface {
+	GetPasswordPolicy(c *gin.Context)
+	SavePasswordPolicy(c *gin.Context)
+	ForgotPassword(c *gin.Context)
+	ResetPassword(c *gin.Context)
+}
+
+type PasswordUseCase interface {
+	GetPasswordPolicy(ctx *gin.Context) (*dtos.PasswordPolicyResponse, *models.ErrorResponse)
+	SavePasswordPolicy(req dtos.PasswordPolicyRequest, ctx *gin.Context) (*dtos.PasswordPolicyResponse, *models.ErrorResponse)
+	ForgotPassword(req dtos.ForgotPasswordRequest, ctx *gin.Context) *models.ErrorResponse
+	ResetPassword(req dtos.ResetPasswordRequest, ctx *gin.Context) *models.ErrorResponse
+}
+
+type PasswordRepository interface {
+	GetPasswordPolicy(ctx *gin.Context) (*models.PasswordPolicy, *models.ErrorResponse)
+	SavePasswordPolicy(policy models.PasswordPolicy, ctx *gin.Context) (*models.PasswordPolicy, *models.ErrorResponse)
+	CreatePasswordReset(userID int, tokenHash string, expiresAt time.Time, since time.Time, limit int, ctx *gin.Context) (bool, *models.ErrorResponse)
+	GetPasswordReset(tokenHash string, ctx *gin.Context) (*models.PasswordReset, *models.ErrorResponse)
+	ResetPassword(tokenHash string, passwordHash string, ctx *gin.Context) *models.ErrorResponse
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+type PrivacyController interface {
+	ExportUser(c *gin.Context)
+	EraseUser(c *gin.Context)
+}
+
+type PrivacyUseCase interface {
+	ExportUser(userUID string, ctx *gin.Context) (*dtos.UserDataExport, *models.ErrorResponse)
+	EraseUser(userUID string, ctx *gin.Context) *models.ErrorResponse
+}
@@ -13,6 +13,7 @@ type RoleController interface {
 	UpdateRole(c *gin.Context)
 	DeleteRole(c *gin.Context)
 	GetRoleUsers(c *gin.Context)
+	RestoreRole(c *gin.Context)
 }
 
 type RoleUseCase interface {
@@ -21,7 +22,8 @@ type RoleUseCase interface {
 	CreateRole(role dtos.RoleCreateRequest, ctx *gin.Context) (*dtos.RoleResponse, *models.ErrorResponse)
 	UpdateRole(id string, role dtos.RoleUpdateRequest, ctx *gin.Context) (*dtos.RoleResponse, *models.ErrorResponse)
 	DeleteRole(id string, ctx *gin.Context) *models.ErrorResponse
-	GetRoleUsers(id string, ctx *gin.Context) ([]*dtos.UserResponse, *models.ErrorResponse)
+	GetRoleUsers(id string, filter dtos.MembershipFilter, ctx *gin.Context) ([]*dtos.RoleUserResponse, *models.ErrorResponse)
+	RestoreRole(id string, ctx *gin.Context) (*dtos.RoleResponse, *models.ErrorResponse)
 }
 
 type RoleRepository interface {
@@ -30,6 +32,7 @@ type RoleRepository interface {
 	CreateRole(role dtos.RoleCreateRequest, ctx *gin.Context) (*dtos.RoleResponse, *models.ErrorResponse)
 	UpdateRole(id string, role dtos.RoleUpdateRequest, ctx *gin.Context) (*dtos.RoleResponse, *models.ErrorResponse)
 	DeleteRole(id string, ctx *gin.Context) *models.ErrorResponse
-	GetRoleUsers(role *dtos.RoleResponse, ctx *gin.Context) ([]*dtos.UserResponse, *models.ErrorResponse)
+	GetRoleUsers(role *dtos.RoleResponse, ctx *gin.Context) ([]*dtos.RoleUserResponse, *models.ErrorResponse)
 	GetRoleByNameAndRights(role dtos.RoleCreateRequest, ctx *gin.Context) (*models.Role, *models.ErrorResponse)
+	RestoreRole(id string, ctx *gin.Context) (*dtos.RoleResponse, *models.ErrorResponse)
 }
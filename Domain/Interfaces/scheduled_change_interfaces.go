@@ -0,0 +1,34 @@
+package interfaces
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+type ScheduledChangeController interface {
+	GetScheduledChanges(c *gin.Context)
+	GetScheduledChangeById(c *gin.Context)
+	CreateScheduledChange(c *gin.Context)
+	CancelScheduledChange(c *gin.Context)
+}
+
+type ScheduledChangeUseCase interface {
+	GetScheduledChanges(filter dtos.ScheduledChangeFilter, ctx *gin.Context) ([]*dtos.ScheduledChangeResponse, *models.ErrorResponse)
+	GetScheduledChangeById(id string, ctx *gin.Context) (*dtos.ScheduledChangeResponse, *models.ErrorResponse)
+	CreateScheduledChange(req dtos.ScheduledChangeRequest, ctx *gin.Context) (*dtos.ScheduledChangeResponse, *models.ErrorResponse)
+	CancelScheduledChange(id string, ctx *gin.Context) (*dtos.ScheduledChangeResponse, *models.ErrorResponse)
+	// ApplyDueChanges runs as a background job, once per tenant.
+	ApplyDueChanges(ctx *gin.Context) *models.ErrorResponse
+}
+
+type ScheduledChangeRepository interface {
+	GetScheduledChanges(filter dtos.ScheduledChangeFilter, ctx *gin.Context) ([]*models.ScheduledChange, *models.ErrorResponse)
+	GetScheduledChangeById(uid string, ctx *gin.Context) (*models.ScheduledChange, *models.ErrorResponse)
+	CreateScheduledChange(change *models.ScheduledChange, ctx *gin.Context) *models.ErrorResponse
+	CancelScheduledChange(uid string, ctx *gin.Context) (*models.ScheduledChange, *models.ErrorResponse)
+	ClaimDueChanges(now time.Time, limit int, ctx *gin.Context) ([]*models.ScheduledChange, *models.ErrorResponse)
+	FinishScheduledChange(id int, status models.ScheduledChangeStatus, result string, ctx *gin.Context) *models.ErrorResponse
+}
@@ -0,0 +1,30 @@
+package interfaces
+
+import (
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+type SignInController interface {
+	SignIn(c *gin.Context)
+	GetUserSignIns(c *gin.Context)
+}
+
+type SignInUseCase interface {
+	SignIn(req dtos.SignInRequest, ctx *gin.Context) (*dtos.SignInResult, *models.ErrorResponse)
+	GetUserSignIns(uid string, limit int, ctx *gin.Context) ([]dtos.SignInResponse, *models.ErrorResponse)
+}
+
+type SignInRepository interface {
+	CreateSignIn(signIn models.SignIn, ctx *gin.Context) *models.ErrorResponse
+	GetUserSignIns(uid string, limit int, ctx *gin.Context) ([]dtos.SignInResponse, *models.ErrorResponse)
+	RecordActivity(activity []dtos.UserActivity, ctx *gin.Context) *models.ErrorResponse
+}
+
+// ActivityTracker notes that a user was active without slowing down the
+// request. Writes are batched and repeated activity within a short window is
+// only written once.
+type ActivityTracker interface {
+	Touch(ctx *gin.Context, userUID string, login bool)
+}
@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"github.com/gin-gonic/gin"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+type TenantRegistry interface {
+	StartupProgress() models.StartupProgress
+	ResolveTenant(name string) (*models.Tenant, bool)
+}
+
+type HealthController interface {
+	Startup(c *gin.Context)
+}
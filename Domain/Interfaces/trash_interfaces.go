@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+type TrashController interface {
+	GetTrash(c *gin.Context)
+}
+
+type TrashUseCase interface {
+	GetTrash(entityType string, ctx *gin.Context) ([]*dtos.TrashEntryResponse, *models.ErrorResponse)
+}
+
+type TrashRepository interface {
+	GetTrash(entityType string, ctx *gin.Context) ([]*dtos.TrashEntryResponse, *models.ErrorResponse)
+}
@@ -1,6 +1,10 @@
 package interfaces
 
 import (
+	"encoding/json"
+	"io"
+	"time"
+
 	"github.com/gin-gonic/gin"
 	dtos "github.com/google-run-code/Domain/Dtos"
 	models "github.com/google-run-code/Domain/Models"
@@ -15,21 +19,62 @@ type UserController interface {
 	DeleteUser(c *gin.Context)
 	AddUserToGroup(c *gin.Context)
 	DeletetUserFromGroup(c *gin.Context)
+	RestoreUser(c *gin.Context)
+	ActivateUser(c *gin.Context)
+	SuspendUser(c *gin.Context)
+	LockUser(c *gin.Context)
+	DeprovisionUser(c *gin.Context)
+	SendEmailVerification(c *gin.Context)
+	VerifyEmail(c *gin.Context)
+	GetEmailAliases(c *gin.Context)
+	AddEmailAlias(c *gin.Context)
+	SendEmailAliasVerification(c *gin.Context)
+	DeleteEmailAlias(c *gin.Context)
+	MergeUsers(c *gin.Context)
+	GetReports(c *gin.Context)
+	GetReport(c *gin.Context)
+	GetManagementChain(c *gin.Context)
+	GetUserRoles(c *gin.Context)
+	AddUserRole(c *gin.Context)
+	RemoveUserRole(c *gin.Context)
+	BatchUsers(c *gin.Context)
+	ExportUsers(c *gin.Context)
+	ImportUsers(c *gin.Context)
 }
 
 type UserUseCase interface {
 	ValidateEmail(email string) *models.ErrorResponse
+	ValidateAttributes(attributes json.RawMessage, ctx *gin.Context) *models.ErrorResponse
 	CheckEmailExists(email string, ctx *gin.Context) (*models.User, *models.ErrorResponse)
+	ValidateNewUser(user *dtos.UserCreateRequest, ctx *gin.Context) *models.ErrorResponse
 	GetAllUsers(ctx *gin.Context) ([]*dtos.UserResponseAll, *models.ErrorResponse)
 	GetUserById(id string, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse)
-	GetUsersGroup(id string, ctx *gin.Context) ([]*dtos.GroupResponse, *models.ErrorResponse)
+	GetUsersGroup(id string, filter dtos.MembershipFilter, ctx *gin.Context) ([]*dtos.GroupResponse, *models.ErrorResponse)
 	SearchUsers(searchFields dtos.SearchFields, ctx *gin.Context) ([]*dtos.UserResponseAll, *models.ErrorResponse)
 	CreateUser(user dtos.UserCreateRequest, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse)
 	UpdateUser(id string, user dtos.UserUpdateRequest, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse)
 	DeleteUser(id string, ctx *gin.Context) *models.ErrorResponse
 	AddUserToGroup(req dtos.AddUserToGroupRequest, ctx *gin.Context) (*models.ErrorResponse, string)
-	AddUserToRole(req dtos.AddUserToRoleRequest, ctx *gin.Context) *models.ErrorResponse
+	GetUserRoles(id string, ctx *gin.Context) ([]dtos.UserRoleResponse, *models.ErrorResponse)
+	AddUserRole(id string, req dtos.UserRoleAssignRequest, ctx *gin.Context) ([]dtos.UserRoleResponse, *models.ErrorResponse)
+	RemoveUserRole(id string, roleID string, ctx *gin.Context) *models.ErrorResponse
 	RemoveUserFromGroup(req dtos.RemoveUserFromGroupRequest, ctx *gin.Context) (string, *models.ErrorResponse)
+	RestoreUser(id string, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse)
+	ChangeUserStatus(id string, status models.UserStatus, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse)
+	SendEmailVerification(id string, ctx *gin.Context) *models.ErrorResponse
+	VerifyEmail(token string, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse)
+	GetEmailAliases(id string, ctx *gin.Context) ([]*dtos.EmailAliasResponse, *models.ErrorResponse)
+	AddEmailAlias(id string, req dtos.EmailAliasCreateRequest, ctx *gin.Context) (*dtos.EmailAliasResponse, *models.ErrorResponse)
+	SendEmailAliasVerification(id string, aliasID string, ctx *gin.Context) *models.ErrorResponse
+	DeleteEmailAlias(id string, aliasID string, ctx *gin.Context) *models.ErrorResponse
+	MergeUsers(id string, req dtos.UserMergeRequest, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse)
+	GetUserRedirect(id string, ctx *gin.Context) (string, *models.ErrorResponse)
+	GetReports(id string, all bool, ctx *gin.Context) ([]*dtos.UserReportResponse, *models.ErrorResponse)
+	GetManagementChain(id string, ctx *gin.Context) ([]*dtos.UserReportResponse, *models.ErrorResponse)
+	GetReport(id string, reportID string, ctx *gin.Context) (*dtos.UserReportResponse, *models.ErrorResponse)
+	BatchUsers(req dtos.BatchUsersRequest, ctx *gin.Context) (*dtos.BatchUsersResponse, *models.ErrorResponse)
+	ExportUsers(fn func(row *dtos.UserExportRow) error, ctx *gin.Context) *models.ErrorResponse
+	ImportUsers(reader io.Reader, req dtos.UserImportRequest, ctx *gin.Context) (*dtos.UserImportResult, *models.ErrorResponse)
 }
 
 type UserRepository interface {
@@ -37,12 +82,38 @@ type UserRepository interface {
 	GetUserById(id string, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse)
 	GetUserByEmail(email string, ctx *gin.Context) (*models.User, *models.ErrorResponse)
 	GetUsersGroups(uid string, ctx *gin.Context) ([]*dtos.GroupResponse, *models.ErrorResponse)
+	GetUsersGroupsTransitive(uid string, ctx *gin.Context) ([]*dtos.GroupResponse, *models.ErrorResponse)
 	SearchUsers(searchFields dtos.SearchFields, ctx *gin.Context) ([]*dtos.UserResponseAll, *models.ErrorResponse)
 	CreateUser(user dtos.UserCreateRequest, ctx *gin.Context) (*dtos.UserResponse, *models.ErrorResponse)
 	UpdateUser(id string, user *dtos.UserUpdateRequest, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse)
 	DeleteUser(id string, ctx *gin.Context) *models.ErrorResponse
 	AddUserToGroup(req dtos.AddUserToGroupRequest, ctx *gin.Context) *models.ErrorResponse
-	AddUserToRole(req dtos.AddUserToRoleRequest, ctx *gin.Context) *models.ErrorResponse
 	RemoveUserFromGroups(userUID string, groupUIDs []string, ctx *gin.Context) *models.ErrorResponse
-	RemoveUserRole(userID string, ctx *gin.Context) *models.ErrorResponse
+	GetUserRoles(uid string, ctx *gin.Context) ([]dtos.UserRoleResponse, *models.ErrorResponse)
+	AddUserRole(uid string, req dtos.UserRoleAssignRequest, ctx *gin.Context) *models.ErrorResponse
+	RemoveUserRole(uid string, roleUID string, ctx *gin.Context) *models.ErrorResponse
+	ExpireRoleAssignments(now time.Time, ctx *gin.Context) ([]dtos.ExpiredRoleAssignment, *models.ErrorResponse)
+	ExpireGroupMemberships(now time.Time, ctx *gin.Context) ([]dtos.ExpiredGroupMembership, *models.ErrorResponse)
+	RestoreUser(uid string, ctx *gin.Context) *models.ErrorResponse
+	UpdateUserStatus(uid string, status models.UserStatus, ctx *gin.Context) *models.ErrorResponse
+	MarkEmailVerified(uid string, email string, ctx *gin.Context) *models.ErrorResponse
+	IsEmailTaken(email string, exceptUserUID string, ctx *gin.Context) (bool, *models.ErrorResponse)
+	GetEmailAliases(userUID string, ctx *gin.Context) ([]*dtos.EmailAliasResponse, *models.ErrorResponse)
+	GetEmailAlias(userUID string, aliasUID string, ctx *gin.Context) (*dtos.EmailAliasResponse, *models.ErrorResponse)
+	CreateEmailAlias(userUID string, email string, ctx *gin.Context) (*dtos.EmailAliasResponse, *models.ErrorResponse)
+	DeleteEmailAlias(userUID string, aliasUID string, ctx *gin.Context) *models.ErrorResponse
+	MarkEmailAliasVerified(userUID string, email string, ctx *gin.Context) *models.ErrorResponse
+	MergeUsers(targetUID string, req dtos.UserMergeRequest, ctx *gin.Context) *models.ErrorResponse
+	GetUserRedirect(uid string, ctx *gin.Context) (string, *models.ErrorResponse)
+	GetReports(uid string, maxDepth int, ctx *gin.Context) ([]*dtos.UserReportResponse, *models.ErrorResponse)
+	GetManagementChain(uid string, ctx *gin.Context) ([]*dtos.UserReportResponse, *models.ErrorResponse)
+	IsManagerOf(uid string, actor models.Actor, ctx *gin.Context) (bool, *models.ErrorResponse)
+	GetUsersByIds(uids []string, ctx *gin.Context) ([]*models.User, *models.ErrorResponse)
+	ApplyUserBatch(changes []dtos.BatchUserChange, continueOnError bool, ctx *gin.Context) ([]*dtos.BatchUserResult, *models.ErrorResponse)
+	StreamUsers(fn func(row *dtos.UserExportRow) error, ctx *gin.Context) *models.ErrorResponse
+	GetUserAvatar(uid string, ctx *gin.Context) (*dtos.UserAvatar, *models.ErrorResponse)
+	SetUserAvatar(uid string, avatar *dtos.UserAvatar, ctx *gin.Context) (*dtos.UserAvatar, *models.ErrorResponse)
+	ImportUsers(next func() (*dtos.UserImportRow, error), dryRun bool, ctx *gin.Context) (*dtos.UserImportResult, *models.ErrorResponse)
+	ExportUser(uid string, ctx *gin.Context) (*dtos.UserDataExport, *models.ErrorResponse)
+	EraseUser(uid string, ctx *gin.Context) (*dtos.ErasedUser, *models.ErrorResponse)
 }
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AttributeSchemaID is the primary key of the single attribute schema row each
+// tenant database holds.
+const AttributeSchemaID = 1
+
+// AttributeSchema is the tenant's JSON Schema for User.Attributes.
+type AttributeSchema struct {
+	ID        int             `gorm:"primaryKey" json:"-"`
+	Schema    json.RawMessage `gorm:"type:jsonb;not null" json:"schema"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditTargetUser  = "user"
+	AuditTargetGroup = "group"
+)
+
+const (
+	AuditUserExported      = "user.exported"
+	AuditUserErased        = "user.erased"
+	AuditUserRoleAssigned  = "user.role.assigned"
+	AuditUserRoleRemoved   = "user.role.removed"
+	AuditUserRoleExpired   = "user.role.expired"
+	AuditGroupOwnerAdded   = "group.owner.added"
+	AuditGroupOwnerRemoved = "group.owner.removed"
+	AuditGroupRoleAdded    = "group.role.added"
+	AuditGroupRoleRemoved  = "group.role.removed"
+)
+
+// AuditEntry records something that happened to a user, group or role.
+// Entries are never changed and must not hold personal data, so they outlive
+// the erasure of the user they are about.
+type AuditEntry struct {
+	ID         int             `gorm:"primaryKey;autoIncrement" json:"-"`
+	UID        uuid.UUID       `gorm:"unique" json:"uid"`
+	Action     string          `gorm:"type:varchar(50);not null;index" json:"action"`
+	TargetType string          `gorm:"type:varchar(20);not null;index:idx_audit_entries_target,priority:1" json:"target_type"`
+	TargetUID  uuid.UUID       `gorm:"index:idx_audit_entries_target,priority:2" json:"target_uid"`
+	Details    json.RawMessage `gorm:"type:jsonb;not null;default:'{}'" json:"details"`
+	CreatedAt  time.Time       `gorm:"index" json:"created_at"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EmailAlias is a secondary address of a user. Once verified it resolves to
+// the user like the primary email does.
+type EmailAlias struct {
+	ID              int        `gorm:"primaryKey;autoIncrement" json:"id"`
+	UID             uuid.UUID  `gorm:"unique" json:"uid"`
+	UserID          int        `gorm:"not null;index;constraint:OnDelete:CASCADE" json:"user_id"`
+	Email           string     `gorm:"not null" json:"email"`
+	EmailNormalized string     `gorm:"not null;uniqueIndex" json:"-"`
+	VerifiedAt      *time.Time `json:"verified_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+func (a *EmailAlias) BeforeSave(tx *gorm.DB) error {
+	a.EmailNormalized = NormalizeEmail(a.Email)
+	return nil
+}
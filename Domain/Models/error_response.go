@@ -74,3 +74,24 @@ func UnprocessableEntity(msg string) *ErrorResponse {
 func Nil() *ErrorResponse {
 	return nil
 }
+
+func Gone(msg string) *ErrorResponse {
+	return &ErrorResponse{
+		Code:    http.StatusGone,
+		Message: msg,
+	}
+}
+
+func RequestEntityTooLarge(msg string) *ErrorResponse {
+	return &ErrorResponse{
+		Code:    http.StatusRequestEntityTooLarge,
+		Message: msg,
+	}
+}
+
+func UnsupportedMediaType(msg string) *ErrorResponse {
+	return &ErrorResponse{
+		Code:    http.StatusUnsupportedMediaType,
+		Message: msg,
+	}
+}
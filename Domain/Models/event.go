@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventRoleAssignmentExpired  = "user.role_assignment.expired"
+	EventGroupMembershipExpired = "user.group_membership.expired"
+)
+
+// Event tells other systems about a change they did not request, such as an
+// assignment running out.
+type Event struct {
+	ID         uuid.UUID      `json:"id"`
+	Type       string         `json:"type"`
+	Tenant     string         `json:"tenant"`
+	OccurredAt time.Time      `json:"occurred_at"`
+	Data       map[string]any `json:"data"`
+}
@@ -1,10 +1,26 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
 
 type Group struct {
-    ID    int       `gorm:"primaryKey;autoIncrement" json:"id"`
-    UID   uuid.UUID `gorm:"unique" json:"uid"` 
-    Name  string    `json:"name"`
-    Users []User    `gorm:"many2many:Groups_Users_Maps;foreignKey:ID;joinForeignKey:Groups_Id;References:ID;joinReferences:Users_Id" json:"users"`
-}
\ No newline at end of file
+	ID        int             `gorm:"primaryKey;autoIncrement" json:"id"`
+	UID       uuid.UUID       `gorm:"unique" json:"uid"`
+	Name      string          `json:"name"`
+	Type      string          `gorm:"type:varchar(10);not null;default:'static'" json:"type"`
+	Rule      json.RawMessage `gorm:"type:jsonb" json:"rule,omitempty"`
+	ParentID  *int            `gorm:"index;constraint:OnDelete:SET NULL" json:"parent_id"`
+	Parent    *Group          `gorm:"foreignKey:ParentID" json:"parent,omitempty"`
+	Users     []User          `gorm:"many2many:Groups_Users_Maps;foreignKey:ID;joinForeignKey:Groups_Id;References:ID;joinReferences:Users_Id" json:"users"`
+	Owners    []User          `gorm:"many2many:group_owners;joinForeignKey:GroupID;joinReferences:UserID" json:"owners,omitempty"`
+	Roles     []Role          `gorm:"many2many:group_roles;joinForeignKey:GroupID;joinReferences:RoleID" json:"roles,omitempty"`
+	DeletedAt gorm.DeletedAt  `gorm:"index" json:"-"`
+}
+
+func (g *Group) IsDynamic() bool {
+	return g.Type == GroupTypeDynamic
+}
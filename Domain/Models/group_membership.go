@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// GroupMembership puts a user in a group. A membership only counts between
+// ValidFrom and ValidUntil, and expired memberships are removed in the
+// background.
+type GroupMembership struct {
+	UsersId    int        `gorm:"primaryKey;autoIncrement:false" json:"user_id"`
+	GroupsId   int        `gorm:"primaryKey;autoIncrement:false;index" json:"group_id"`
+	ValidFrom  *time.Time `json:"valid_from,omitempty"`
+	ValidUntil *time.Time `gorm:"index" json:"valid_until,omitempty"`
+}
+
+// TableName keeps the join table the User and Group many2many relations use.
+func (GroupMembership) TableName() string {
+	return "groups_users_maps"
+}
+
+// ActiveAt reports whether the membership counts at the given time.
+func (m *GroupMembership) ActiveAt(at time.Time) bool {
+	return (m.ValidFrom == nil || !m.ValidFrom.After(at)) && (m.ValidUntil == nil || m.ValidUntil.After(at))
+}
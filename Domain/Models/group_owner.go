@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// GroupOwner makes a user an owner of a group. Owners may add and remove the
+// members of the group with a token bound to them.
+type GroupOwner struct {
+	GroupID   int       `gorm:"primaryKey;autoIncrement:false" json:"group_id"`
+	UserID    int       `gorm:"primaryKey;autoIncrement:false;index" json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName keeps the join table the Group owners relation uses.
+func (GroupOwner) TableName() string {
+	return "group_owners"
+}
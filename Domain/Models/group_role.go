@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// GroupRole binds a role to a group. Every member of the group, directly or
+// through a nested group, holds the role for as long as the membership lasts.
+type GroupRole struct {
+	GroupID   int       `gorm:"primaryKey;autoIncrement:false" json:"group_id"`
+	RoleID    int       `gorm:"primaryKey;autoIncrement:false;index" json:"role_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName keeps the join table the Group roles relation uses.
+func (GroupRole) TableName() string {
+	return "group_roles"
+}
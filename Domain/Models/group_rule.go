@@ -0,0 +1,127 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// A static group has its members added and removed by hand, while the members
+// of a dynamic group are the users matching its rule.
+const (
+	GroupTypeStatic  = "static"
+	GroupTypeDynamic = "dynamic"
+)
+
+const (
+	GroupRuleEq = "eq"
+	GroupRuleNe = "ne"
+	GroupRuleIn = "in"
+)
+
+// Fields a group rule can compare. Custom attributes are compared as
+// "attributes.<key>".
+const (
+	GroupRuleStatus          = "status"
+	GroupRuleEmailDomain     = "email_domain"
+	GroupRuleRole            = "role"
+	GroupRuleManager         = "manager"
+	GroupRuleAttributePrefix = "attributes."
+)
+
+// maxGroupRuleDepth caps how deeply rules can be combined.
+const maxGroupRuleDepth = 10
+
+// GroupRule decides the members of a dynamic group. A rule either combines
+// other rules with All, Any or Not, or compares a user field with Value, or
+// with Values for the "in" operator. Role compares the UID of any role the
+// user holds and manager the UID of the user's manager.
+type GroupRule struct {
+	All    []GroupRule `json:"all,omitempty"`
+	Any    []GroupRule `json:"any,omitempty"`
+	Not    *GroupRule  `json:"not,omitempty"`
+	Field  string      `json:"field,omitempty"`
+	Op     string      `json:"op,omitempty"`
+	Value  string      `json:"value,omitempty"`
+	Values []string    `json:"values,omitempty"`
+}
+
+func (r *GroupRule) Validate() error {
+	return r.validate(0)
+}
+
+// AttributeKey returns the custom attribute the rule compares, if any.
+func (r *GroupRule) AttributeKey() (string, bool) {
+	if !strings.HasPrefix(r.Field, GroupRuleAttributePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(r.Field, GroupRuleAttributePrefix), true
+}
+
+func (r *GroupRule) validate(depth int) error {
+	if depth >= maxGroupRuleDepth {
+		return fmt.Errorf("rules cannot be nested more than %d levels deep", maxGroupRuleDepth)
+	}
+
+	kinds := 0
+	for _, set := range []bool{len(r.All) > 0, len(r.Any) > 0, r.Not != nil, r.Field != ""} {
+		if set {
+			kinds++
+		}
+	}
+	if kinds != 1 {
+		return errors.New("every rule needs exactly one of all, any, not or field")
+	}
+
+	for _, rules := range [][]GroupRule{r.All, r.Any} {
+		for i := range rules {
+			if err := rules[i].validate(depth + 1); err != nil {
+				return err
+			}
+		}
+	}
+	if r.Not != nil {
+		return r.Not.validate(depth + 1)
+	}
+	if r.Field == "" {
+		return nil
+	}
+
+	values := r.Values
+	switch r.Op {
+	case GroupRuleEq, GroupRuleNe:
+		if len(r.Values) > 0 {
+			return fmt.Errorf("%s compares a single value", r.Op)
+		}
+		values = []string{r.Value}
+	case GroupRuleIn:
+		if len(r.Values) == 0 || r.Value != "" {
+			return errors.New("in compares a non-empty list of values")
+		}
+	default:
+		return fmt.Errorf("unknown operator %q", r.Op)
+	}
+
+	switch r.Field {
+	case GroupRuleStatus:
+		for _, value := range values {
+			if !UserStatus(value).IsValid() {
+				return fmt.Errorf("unknown status %q", value)
+			}
+		}
+	case GroupRuleRole, GroupRuleManager:
+		for _, value := range values {
+			if _, err := uuid.Parse(value); err != nil {
+				return fmt.Errorf("%s must be compared with a UID, got %q", r.Field, value)
+			}
+		}
+	case GroupRuleEmailDomain:
+	default:
+		if key, ok := r.AttributeKey(); !ok || key == "" {
+			return fmt.Errorf("unknown field %q", r.Field)
+		}
+	}
+	return nil
+}
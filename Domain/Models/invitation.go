@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InvitationStatus string
+
+const (
+	InvitationPending  InvitationStatus = "pending"
+	InvitationAccepted InvitationStatus = "accepted"
+	InvitationRevoked  InvitationStatus = "revoked"
+	InvitationExpired  InvitationStatus = "expired"
+)
+
+// Invitation links an invited user to a single-use token. Only the SHA-256
+// hash of the token is stored.
+type Invitation struct {
+	ID         int        `gorm:"primaryKey;autoIncrement" json:"id"`
+	UID        uuid.UUID  `gorm:"unique" json:"uid"`
+	UserID     int        `gorm:"index;not null;constraint:OnDelete:CASCADE" json:"user_id"`
+	User       User       `gorm:"foreignKey:UserID;references:ID" json:"-"`
+	Email      string     `json:"email"`
+	TokenHash  string     `gorm:"uniqueIndex" json:"-"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	AcceptedAt *time.Time `json:"accepted_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func (i *Invitation) Status(now time.Time) InvitationStatus {
+	switch {
+	case i.AcceptedAt != nil:
+		return InvitationAccepted
+	case i.RevokedAt != nil:
+		return InvitationRevoked
+	case now.After(i.ExpiresAt):
+		return InvitationExpired
+	default:
+		return InvitationPending
+	}
+}
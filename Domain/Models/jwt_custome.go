@@ -1,9 +1,39 @@
 package models
 
-import "github.com/dgrijalva/jwt-go"
+import (
+	"time"
 
+	"github.com/dgrijalva/jwt-go"
+)
+
+// JWTCustome are the claims of an access token. Tokens with a UserUID are
+// bound to that user and only grant what the user may do.
 type JWTCustome struct {
 	Database string `json:"database_name"`
 	Expires  int64  `json:"expires"`
+	UserUID  string `json:"user_uid,omitempty"`
+	jwt.StandardClaims
+}
+
+// ActorContextKey holds the *Actor of requests made with a token bound to a
+// user.
+const ActorContextKey = "actor"
+
+// Actor is the user a request acts for. Tokens issued before the user's
+// credentials last changed are no longer honoured.
+type Actor struct {
+	UserUID  string
+	IssuedAt time.Time
+}
+
+const EmailVerificationPurpose = "email_verification"
+
+// EmailVerificationClaims are carried by the signed link sent to a user's
+// address. The email is included so that changing it invalidates older links.
+type EmailVerificationClaims struct {
+	Purpose string `json:"purpose"`
+	Tenant  string `json:"tenant"`
+	UserUID string `json:"uid"`
+	Email   string `json:"email"`
 	jwt.StandardClaims
 }
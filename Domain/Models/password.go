@@ -0,0 +1,83 @@
+package models
+
+import (
+	"fmt"
+	"time"
+	"unicode"
+)
+
+// PasswordPolicyID is the primary key of the single password policy row each
+// tenant database holds.
+const PasswordPolicyID = 1
+
+// bcrypt ignores everything after 72 bytes, so longer passwords are rejected
+// rather than silently truncated.
+const maxPasswordBytes = 72
+
+type PasswordPolicy struct {
+	ID               int       `gorm:"primaryKey" json:"-"`
+	MinLength        int       `gorm:"not null" json:"min_length"`
+	RequireUppercase bool      `gorm:"not null" json:"require_uppercase"`
+	RequireLowercase bool      `gorm:"not null" json:"require_lowercase"`
+	RequireDigit     bool      `gorm:"not null" json:"require_digit"`
+	RequireSymbol    bool      `gorm:"not null" json:"require_symbol"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// DefaultPasswordPolicy applies to tenants that have not saved their own.
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{ID: PasswordPolicyID, MinLength: 12}
+}
+
+// Violations lists every rule the password breaks.
+func (p PasswordPolicy) Violations(password string) []string {
+	var violations []string
+
+	if len([]rune(password)) < p.MinLength {
+		violations = append(violations, fmt.Sprintf("must be at least %d characters long", p.MinLength))
+	}
+	if len(password) > maxPasswordBytes {
+		violations = append(violations, fmt.Sprintf("must be at most %d bytes long", maxPasswordBytes))
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			symbol = true
+		}
+	}
+
+	if p.RequireUppercase && !upper {
+		violations = append(violations, "must contain an uppercase letter")
+	}
+	if p.RequireLowercase && !lower {
+		violations = append(violations, "must contain a lowercase letter")
+	}
+	if p.RequireDigit && !digit {
+		violations = append(violations, "must contain a digit")
+	}
+	if p.RequireSymbol && !symbol {
+		violations = append(violations, "must contain a symbol")
+	}
+
+	return violations
+}
+
+// PasswordReset is a single-use reset token. Only the SHA-256 hash of the token
+// is stored.
+type PasswordReset struct {
+	ID        int       `gorm:"primaryKey;autoIncrement"`
+	UserID    int       `gorm:"index:idx_password_resets_user;not null;constraint:OnDelete:CASCADE"`
+	User      User      `gorm:"foreignKey:UserID;references:ID"`
+	TokenHash string    `gorm:"uniqueIndex"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"index:idx_password_resets_user"`
+}
@@ -4,12 +4,14 @@ import (
 	"encoding/json"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type Role struct {
-	ID     int             `gorm:"primaryKey;autoIncrement" json:"id"`
-	UID    uuid.UUID       `gorm:"unique" json:"uid"` 
-	Name   string          `json:"name"`
-	Rights json.RawMessage `gorm:"type:json" json:"rights"`
-	Users  []*User         `gorm:"foreignKey:RoleID" json:"users"` 
+	ID        int             `gorm:"primaryKey;autoIncrement" json:"id"`
+	UID       uuid.UUID       `gorm:"unique" json:"uid"`
+	Name      string          `json:"name"`
+	Rights    json.RawMessage `gorm:"type:json" json:"rights"`
+	Users     []*User         `gorm:"foreignKey:RoleID" json:"users"`
+	DeletedAt gorm.DeletedAt  `gorm:"index" json:"-"`
 }
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ScheduledChangeType string
+
+const (
+	ScheduledUserStatus       ScheduledChangeType = "user.status"
+	ScheduledUserRoleAdd      ScheduledChangeType = "user.role.add"
+	ScheduledUserRoleRemove   ScheduledChangeType = "user.role.remove"
+	ScheduledUserGroupsAdd    ScheduledChangeType = "user.groups.add"
+	ScheduledUserGroupsRemove ScheduledChangeType = "user.groups.remove"
+	ScheduledUserDelete       ScheduledChangeType = "user.delete"
+	ScheduledGroupDelete      ScheduledChangeType = "group.delete"
+	ScheduledRoleDelete       ScheduledChangeType = "role.delete"
+)
+
+type ScheduledChangeStatus string
+
+const (
+	ScheduledChangePending   ScheduledChangeStatus = "pending"
+	ScheduledChangeApplying  ScheduledChangeStatus = "applying"
+	ScheduledChangeApplied   ScheduledChangeStatus = "applied"
+	ScheduledChangeFailed    ScheduledChangeStatus = "failed"
+	ScheduledChangeCancelled ScheduledChangeStatus = "cancelled"
+)
+
+// ScheduledChange is a mutation of a user, group or role that is applied at
+// EffectiveAt. Payload holds the request the change is applied with, and
+// Result what applying it answered.
+type ScheduledChange struct {
+	ID          int                   `gorm:"primaryKey;autoIncrement" json:"id"`
+	UID         uuid.UUID             `gorm:"unique" json:"uid"`
+	Type        ScheduledChangeType   `gorm:"type:varchar(40);not null" json:"type"`
+	TargetUID   string                `gorm:"index" json:"target_uid"`
+	Payload     json.RawMessage       `gorm:"type:jsonb;not null;default:'{}'" json:"payload"`
+	EffectiveAt time.Time             `gorm:"index:idx_scheduled_changes_due,priority:2" json:"effective_at"`
+	Status      ScheduledChangeStatus `gorm:"type:varchar(20);not null;default:pending;index:idx_scheduled_changes_due,priority:1" json:"status"`
+	Result      string                `json:"result"`
+	ClaimedAt   *time.Time            `json:"-"`
+	FinishedAt  *time.Time            `json:"finished_at"`
+	CancelledAt *time.Time            `json:"cancelled_at"`
+	CreatedAt   time.Time             `json:"created_at"`
+}
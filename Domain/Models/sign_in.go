@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+const (
+	SignInSucceeded       = "success"
+	SignInUnknownUser     = "unknown_user"
+	SignInInvalidPassword = "invalid_password"
+	SignInInactiveUser    = "inactive_user"
+)
+
+// SignIn records a sign-in attempt. UserID is empty when the email did not
+// belong to any user.
+type SignIn struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID    *int      `gorm:"index:idx_sign_ins_user,priority:1" json:"-"`
+	User      *User     `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-"`
+	Email     string    `json:"email"`
+	IPAddress string    `json:"ip_address"`
+	UserAgent string    `json:"user_agent"`
+	Outcome   string    `gorm:"type:varchar(20);not null" json:"outcome"`
+	CreatedAt time.Time `gorm:"index:idx_sign_ins_user,priority:2" json:"created_at"`
+}
@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+type TenantState string
+
+const (
+	TenantPending      TenantState = "pending"
+	TenantInitializing TenantState = "initializing"
+	TenantReady        TenantState = "ready"
+	TenantUnavailable  TenantState = "unavailable"
+	TenantDisabled     TenantState = "disabled"
+)
+
+// TenantContextKey is the request context key under which DatabaseMiddleware
+// stores the resolved *Tenant.
+const TenantContextKey = "tenant"
+
+const (
+	ErrCodeTenantNotFound     = "tenant_not_found"
+	ErrCodeTenantDisabled     = "tenant_disabled"
+	ErrCodeTenantInitializing = "tenant_initializing"
+	ErrCodeTenantUnavailable  = "tenant_unavailable"
+)
+
+type Tenant struct {
+	Name       string      `json:"name"`
+	State      TenantState `json:"state"`
+	Error      string      `json:"error,omitempty"`
+	StartedAt  *time.Time  `json:"started_at,omitempty"`
+	FinishedAt *time.Time  `json:"finished_at,omitempty"`
+}
+
+type StartupProgress struct {
+	Total       int      `json:"total"`
+	Pending     int      `json:"pending"`
+	Ready       int      `json:"ready"`
+	Unavailable int      `json:"unavailable"`
+	Disabled    int      `json:"disabled"`
+	Done        bool     `json:"done"`
+	Tenants     []Tenant `json:"tenants"`
+}
+
+// TenantSchema returns every model stored in a tenant database, in the order
+// they are migrated.
+func TenantSchema() []interface{} {
+	return []interface{}{&User{}, &Role{}, &Group{}, &GroupMembership{}, &GroupOwner{}, &GroupRole{}, &TrashEntry{}, &AttributeSchema{}, &Invitation{}, &PasswordPolicy{}, &PasswordReset{}, &EmailAlias{}, &UserRedirect{}, &UserRole{}, &SignIn{}, &ScheduledChange{}, &AuditEntry{}}
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	TrashTypeUser  = "user"
+	TrashTypeGroup = "group"
+	TrashTypeRole  = "role"
+)
+
+// TrashEntry records a soft-deleted user, group or role together with the
+// relationships it had at deletion time, so a restore can bring them back.
+type TrashEntry struct {
+	ID         int             `gorm:"primaryKey;autoIncrement" json:"id"`
+	EntityType string          `gorm:"uniqueIndex:idx_trash_entity" json:"entity_type"`
+	EntityID   int             `gorm:"uniqueIndex:idx_trash_entity" json:"entity_id"`
+	EntityUID  uuid.UUID       `json:"entity_uid"`
+	Name       string          `json:"name"`
+	Snapshot   json.RawMessage `gorm:"type:jsonb" json:"snapshot"`
+	TrashedAt  time.Time       `gorm:"index" json:"trashed_at"`
+}
+
+// TrashSnapshot holds the relationships of a trashed entity. For a user RoleID
+// is the primary role and RoleIDs every role held; for a role UserIDs are the
+// users holding it as their primary role and AssigneeIDs every user holding it.
+// Memberships are the group memberships of a user or group with their validity
+// period. Older snapshots list them as GroupIDs or UserIDs instead. For a group
+// ParentID is the group it was nested in, ChildIDs the groups nested in it and
+// RoleIDs the roles bound to it; for a role GroupIDs are the groups it was
+// bound to. OwnedGroupIDs are the groups a user owned.
+type TrashSnapshot struct {
+	GroupIDs      []int             `json:"group_ids,omitempty"`
+	RoleID        *int              `json:"role_id,omitempty"`
+	RoleIDs       []int             `json:"role_ids,omitempty"`
+	UserIDs       []int             `json:"user_ids,omitempty"`
+	AssigneeIDs   []int             `json:"assignee_ids,omitempty"`
+	Memberships   []GroupMembership `json:"memberships,omitempty"`
+	ParentID      *int              `json:"parent_id,omitempty"`
+	ChildIDs      []int             `json:"child_ids,omitempty"`
+	OwnedGroupIDs []int             `json:"owned_group_ids,omitempty"`
+}
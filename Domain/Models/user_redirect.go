@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserRedirect points the UID of a user merged into another at the surviving
+// user. Redirects are kept flat: merging the survivor again repoints them.
+type UserRedirect struct {
+	ID       int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	FromUID  uuid.UUID `gorm:"uniqueIndex;not null" json:"from_uid"`
+	ToUserID int       `gorm:"not null;index" json:"to_user_id"`
+	ToUser   User      `gorm:"foreignKey:ToUserID" json:"-"`
+	MergedAt time.Time `gorm:"not null" json:"merged_at"`
+}
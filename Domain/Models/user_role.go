@@ -0,0 +1,103 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// A user holds a role either through an assignment of their own or through a
+// group the role is bound to.
+const (
+	RoleSourceDirect = "direct"
+	RoleSourceGroup  = "group"
+)
+
+// UserRole assigns a role to a user. A user can hold any number of roles; the
+// one referenced by User.RoleID is their primary role and always has an
+// assignment too. An assignment only grants its role between StartsAt and
+// ExpiresAt, and expired assignments are removed in the background.
+type UserRole struct {
+	UserID    int        `gorm:"primaryKey;autoIncrement:false;constraint:OnDelete:CASCADE" json:"user_id"`
+	RoleID    int        `gorm:"primaryKey;autoIncrement:false;index" json:"role_id"`
+	Role      *Role      `gorm:"foreignKey:RoleID;constraint:OnDelete:CASCADE" json:"role,omitempty"`
+	StartsAt  *time.Time `json:"starts_at"`
+	ExpiresAt *time.Time `gorm:"index" json:"expires_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// ActiveAt reports whether the assignment grants its role at the given time.
+func (r *UserRole) ActiveAt(at time.Time) bool {
+	return (r.StartsAt == nil || !r.StartsAt.After(at)) && (r.ExpiresAt == nil || r.ExpiresAt.After(at))
+}
+
+// MergeRights returns the union of the given role rights. Objects are merged
+// key by key, a boolean right is granted when any role grants it and lists are
+// joined without duplicates. For any other value the first role that sets it
+// wins, so callers pass the primary role first.
+func MergeRights(rights ...json.RawMessage) json.RawMessage {
+	var merged any
+	for _, raw := range rights {
+		if len(raw) == 0 {
+			continue
+		}
+		var value any
+		if err := json.Unmarshal(raw, &value); err != nil {
+			continue
+		}
+		merged = mergeRight(merged, value)
+	}
+
+	if merged == nil {
+		return nil
+	}
+	result, err := json.Marshal(merged)
+	if err != nil {
+		return nil
+	}
+	return result
+}
+
+func mergeRight(current, next any) any {
+	if current == nil {
+		return next
+	}
+
+	switch c := current.(type) {
+	case map[string]any:
+		n, ok := next.(map[string]any)
+		if !ok {
+			return current
+		}
+		for key, value := range n {
+			c[key] = mergeRight(c[key], value)
+		}
+		return c
+	case bool:
+		if n, ok := next.(bool); ok {
+			return c || n
+		}
+	case []any:
+		n, ok := next.([]any)
+		if !ok {
+			return current
+		}
+		for _, value := range n {
+			if !containsRight(c, value) {
+				c = append(c, value)
+			}
+		}
+		return c
+	}
+	return current
+}
+
+func containsRight(values []any, value any) bool {
+	encoded, _ := json.Marshal(value)
+	for _, existing := range values {
+		if e, _ := json.Marshal(existing); bytes.Equal(e, encoded) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package models
+
+import "fmt"
+
+type UserStatus string
+
+const (
+	UserStatusInvited       UserStatus = "invited"
+	UserStatusActive        UserStatus = "active"
+	UserStatusSuspended     UserStatus = "suspended"
+	UserStatusLocked        UserStatus = "locked"
+	UserStatusDeprovisioned UserStatus = "deprovisioned"
+)
+
+// userStatusTransitions lists, for every status, the statuses a user may move to.
+// Deprovisioned is terminal.
+var userStatusTransitions = map[UserStatus][]UserStatus{
+	UserStatusInvited:       {UserStatusActive, UserStatusDeprovisioned},
+	UserStatusActive:        {UserStatusSuspended, UserStatusLocked, UserStatusDeprovisioned},
+	UserStatusSuspended:     {UserStatusActive, UserStatusDeprovisioned},
+	UserStatusLocked:        {UserStatusActive, UserStatusDeprovisioned},
+	UserStatusDeprovisioned: {},
+}
+
+func (s UserStatus) IsValid() bool {
+	_, ok := userStatusTransitions[s]
+	return ok
+}
+
+func (s UserStatus) CanTransitionTo(next UserStatus) bool {
+	for _, allowed := range userStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckTransition returns why a user in this status cannot move to next, or nil
+// when the move is allowed.
+func (s UserStatus) CheckTransition(next UserStatus) *ErrorResponse {
+	if !next.IsValid() {
+		return BadRequest("Invalid status: " + string(next))
+	}
+	if !s.CanTransitionTo(next) {
+		return Conflict(fmt.Sprintf("User status cannot change from %s to %s", s, next))
+	}
+	return nil
+}
+
+// GrantsAccess reports whether a user in this status counts towards effective
+// group and role resolution.
+func (s UserStatus) GrantsAccess() bool {
+	return s == UserStatusActive
+}
@@ -1,14 +1,57 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
 
 type User struct {
-	ID     int       `gorm:"primaryKey;autoIncrement" json:"id"`
-	UID    uuid.UUID `gorm:"unique" json:"uid"`
-	Name   string    `json:"name"`
-	Email  string    `gorm:"unique" json:"email"`
-	Status int       `json:"status"`
-	Groups []Group   `gorm:"many2many:Groups_Users_Maps;foreignKey:ID;joinForeignKey:Users_Id;References:ID;joinReferences:Groups_Id" json:"groups"`
-	RoleID *int      `gorm:"index;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"role_id"`
-	Role   *Role     `gorm:"foreignKey:RoleID;references:ID" json:"role,omitempty"`
+	ID                   int             `gorm:"primaryKey;autoIncrement" json:"id"`
+	UID                  uuid.UUID       `gorm:"unique" json:"uid"`
+	Name                 string          `json:"name"`
+	Email                string          `json:"email"`
+	EmailNormalized      string          `gorm:"uniqueIndex:idx_users_email_normalized_active,where:deleted_at IS NULL" json:"-"`
+	Status               UserStatus      `gorm:"type:varchar(20);not null;default:active;index" json:"status"`
+	GivenName            string          `json:"given_name"`
+	FamilyName           string          `json:"family_name"`
+	DisplayName          string          `json:"display_name"`
+	PhoneNumber          string          `gorm:"index" json:"phone_number"`
+	Locale               string          `json:"locale"`
+	Timezone             string          `json:"timezone"`
+	Title                string          `json:"title"`
+	AvatarKey            string          `json:"-"`
+	AvatarContentType    string          `json:"-"`
+	EmailVerifiedAt      *time.Time      `json:"email_verified_at"`
+	PasswordHash         string          `json:"-"`
+	CredentialsChangedAt *time.Time      `json:"credentials_changed_at"`
+	LastLoginAt          *time.Time      `gorm:"index" json:"last_login_at"`
+	LastSeenAt           *time.Time      `json:"last_seen_at"`
+	ErasedAt             *time.Time      `json:"erased_at"`
+	Attributes           json.RawMessage `gorm:"type:jsonb;not null;default:'{}'" json:"attributes"`
+	Groups               []Group         `gorm:"many2many:Groups_Users_Maps;foreignKey:ID;joinForeignKey:Users_Id;References:ID;joinReferences:Groups_Id" json:"groups"`
+	ManagerID            *int            `gorm:"index;constraint:OnDelete:SET NULL" json:"manager_id"`
+	Manager              *User           `gorm:"foreignKey:ManagerID" json:"manager,omitempty"`
+	RoleID               *int            `gorm:"index;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"role_id"`
+	Role                 *Role           `gorm:"foreignKey:RoleID;references:ID" json:"role,omitempty"`
+	RoleAssignments      []UserRole      `gorm:"foreignKey:UserID" json:"roles,omitempty"`
+	EmailAliases         []EmailAlias    `gorm:"foreignKey:UserID" json:"email_aliases,omitempty"`
+	DeletedAt            gorm.DeletedAt  `gorm:"index" json:"-"`
+}
+
+// NormalizeEmail returns the form emails are compared in. Addresses that only
+// differ in case belong to the same user.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// BeforeSave keeps the normalized email in step with Email.
+func (u *User) BeforeSave(tx *gorm.DB) error {
+	if u.Email != "" {
+		u.EmailNormalized = NormalizeEmail(u.Email)
+	}
+	return nil
 }
@@ -0,0 +1,115 @@
+package infrastructure
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+type activityKey struct {
+	tenant  string
+	userUID string
+}
+
+type activityTracker struct {
+	repo     interfaces.SignInRepository
+	interval time.Duration
+
+	mu      sync.Mutex
+	tenants map[string]*models.Tenant
+	pending map[activityKey]*dtos.UserActivity
+	seen    map[activityKey]time.Time
+}
+
+// NewActivityTracker returns a tracker that writes activity every interval.
+// A user seen again within the interval is not written again, sign-ins always
+// are. Activity still pending when the process exits is lost.
+func NewActivityTracker(repo interfaces.SignInRepository, interval time.Duration) interfaces.ActivityTracker {
+	if interval <= 0 {
+		log.Fatalf("Activity flush interval must be positive")
+	}
+
+	tracker := &activityTracker{
+		repo:     repo,
+		interval: interval,
+		tenants:  make(map[string]*models.Tenant),
+		pending:  make(map[activityKey]*dtos.UserActivity),
+		seen:     make(map[activityKey]time.Time),
+	}
+	go tracker.run()
+	return tracker
+}
+
+func (t *activityTracker) Touch(ctx *gin.Context, userUID string, login bool) {
+	value, _ := ctx.Get(models.TenantContextKey)
+	tenant, ok := value.(*models.Tenant)
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	key := activityKey{tenant: tenant.Name, userUID: userUID}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if last, ok := t.seen[key]; ok && !login && now.Sub(last) < t.interval {
+		return
+	}
+	t.seen[key] = now
+	t.tenants[tenant.Name] = tenant
+
+	activity, ok := t.pending[key]
+	if !ok {
+		activity = &dtos.UserActivity{UserUID: userUID}
+		t.pending[key] = activity
+	}
+	activity.SeenAt = now
+	if login {
+		activity.LoginAt = &now
+	}
+}
+
+func (t *activityTracker) run() {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		t.flush()
+	}
+}
+
+func (t *activityTracker) flush() {
+	t.mu.Lock()
+	pending := t.pending
+	t.pending = make(map[activityKey]*dtos.UserActivity)
+	cutoff := time.Now().Add(-t.interval)
+	for key, last := range t.seen {
+		if last.Before(cutoff) {
+			delete(t.seen, key)
+		}
+	}
+	tenants := make(map[string]*models.Tenant, len(t.tenants))
+	for name, tenant := range t.tenants {
+		tenants[name] = tenant
+	}
+	t.mu.Unlock()
+
+	byTenant := make(map[string][]dtos.UserActivity)
+	for key, activity := range pending {
+		byTenant[key.tenant] = append(byTenant[key.tenant], *activity)
+	}
+
+	for name, activity := range byTenant {
+		ctx := &gin.Context{}
+		ctx.Set(models.TenantContextKey, tenants[name])
+		if err := t.repo.RecordActivity(activity, ctx); err != nil {
+			log.Printf("Failed to record activity of %d users for tenant %s: %s", len(activity), name, err.Message)
+		}
+	}
+}
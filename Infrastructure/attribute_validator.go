@@ -0,0 +1,80 @@
+package infrastructure
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+const attributeSchemaURL = "attributes.schema.json"
+
+type attributeValidator struct {
+	mu       sync.Mutex
+	compiled map[string]*jsonschema.Schema
+}
+
+func NewAttributeValidator() interfaces.AttributeValidator {
+	return &attributeValidator{
+		compiled: make(map[string]*jsonschema.Schema),
+	}
+}
+
+// compile returns the compiled form of schema, reusing earlier compilations of
+// the same schema document.
+func (v *attributeValidator) compile(schema json.RawMessage) (*jsonschema.Schema, error) {
+	key := string(schema)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if compiled, ok := v.compiled[key]; ok {
+		return compiled, nil
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.AssertFormat = true
+	// Tenant schemas must be self-contained; never follow $ref to files or URLs.
+	compiler.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("external references are not allowed: %s", url)
+	}
+
+	if err := compiler.AddResource(attributeSchemaURL, bytes.NewReader(schema)); err != nil {
+		return nil, err
+	}
+
+	compiled, err := compiler.Compile(attributeSchemaURL)
+	if err != nil {
+		return nil, err
+	}
+
+	v.compiled[key] = compiled
+	return compiled, nil
+}
+
+func (v *attributeValidator) CheckSchema(schema json.RawMessage) error {
+	_, err := v.compile(schema)
+	return err
+}
+
+func (v *attributeValidator) Validate(schema json.RawMessage, attributes json.RawMessage) error {
+	compiled, err := v.compile(schema)
+	if err != nil {
+		return err
+	}
+
+	// Numbers are decoded as json.Number so large integers keep their precision.
+	decoder := json.NewDecoder(bytes.NewReader(attributes))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return err
+	}
+
+	return compiled.Validate(document)
+}
@@ -0,0 +1,89 @@
+package infrastructure
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	"github.com/google-run-code/config"
+)
+
+// NewBlobStore picks the store configured by BLOB_DRIVER. Only the local
+// filesystem is supported so far.
+func NewBlobStore(env config.Env) interfaces.BlobStore {
+	switch strings.ToLower(env.BLOB_DRIVER) {
+	case "", "local":
+		return &localBlobStore{dir: env.BLOB_DIR}
+	default:
+		log.Fatalf("Unknown BLOB_DRIVER: %s", env.BLOB_DRIVER)
+		return nil
+	}
+}
+
+type localBlobStore struct {
+	dir string
+}
+
+// path maps a key to a file below the store directory, rejecting keys that
+// would escape it.
+func (s *localBlobStore) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if clean == "/" || clean != "/"+key {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(clean)), nil
+}
+
+func (s *localBlobStore) Put(key string, content io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so readers never see a partial blob
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *localBlobStore) Open(key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+func (s *localBlobStore) Delete(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
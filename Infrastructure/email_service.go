@@ -1,21 +1,121 @@
 package infrastructure
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
 	"github.com/asaskevich/govalidator"
+	"github.com/dgrijalva/jwt-go"
 	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
 	"github.com/google-run-code/config"
 )
 
 type emailService struct {
-	env config.Env
+	env        config.Env
+	mailSender interfaces.MailSender
 }
 
-func NewEmailService(env config.Env) interfaces.EmailService {
+func NewEmailService(env config.Env, mailSender interfaces.MailSender) interfaces.EmailService {
 	return &emailService{
-		env: env,
+		env:        env,
+		mailSender: mailSender,
 	}
 }
 
 func (es *emailService) IsValidEmail(email string) bool {
 	return govalidator.IsEmail(email)
 }
+
+func (es *emailService) verificationTTL() time.Duration {
+	if es.env.EMAIL_VERIFICATION_TTL > 0 {
+		return time.Duration(es.env.EMAIL_VERIFICATION_TTL) * time.Hour
+	}
+	return 48 * time.Hour
+}
+
+func (es *emailService) generateVerificationToken(tenant string, userUID string, email string) (string, error) {
+	claims := &models.EmailVerificationClaims{
+		Purpose: models.EmailVerificationPurpose,
+		Tenant:  tenant,
+		UserUID: userUID,
+		Email:   email,
+		StandardClaims: jwt.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: time.Now().Add(es.verificationTTL()).Unix(),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(es.env.JWT_SECRET))
+}
+
+func (es *emailService) SendVerificationEmail(tenant string, userUID string, name string, email string) error {
+	token, err := es.generateVerificationToken(tenant, userUID, email)
+	if err != nil {
+		return err
+	}
+
+	link := es.publicLink("/verify-email", tenant, token)
+
+	return es.mailSender.Send(models.MailMessage{
+		To:      email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hello %s,\n\nPlease confirm your email address by opening the link below:\n\n%s\n\nThe link expires in %s.\n",
+			name, link, es.verificationTTL()),
+	})
+}
+
+func (es *emailService) ParseVerificationToken(tokenStr string) (*models.EmailVerificationClaims, error) {
+	claims := &models.EmailVerificationClaims{}
+
+	token, err := jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(es.env.JWT_SECRET), nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid verification token: %v", err)
+	}
+
+	// Access tokens share the signing secret, so the purpose must be checked.
+	if !token.Valid || claims.Purpose != models.EmailVerificationPurpose {
+		return nil, fmt.Errorf("invalid verification token")
+	}
+
+	return claims, nil
+}
+
+func (es *emailService) publicLink(path string, tenant string, token string) string {
+	query := url.Values{}
+	query.Set("tenant", tenant)
+	query.Set("token", token)
+	return strings.TrimRight(es.env.PUBLIC_BASE_URL, "/") + path + "?" + query.Encode()
+}
+
+func (es *emailService) SendInvitationEmail(tenant string, name string, email string, token string, expiresAt time.Time) error {
+	greeting := "Hello"
+	if name != "" {
+		greeting += " " + name
+	}
+
+	return es.mailSender.Send(models.MailMessage{
+		To:      email,
+		Subject: "You have been invited",
+		Body: fmt.Sprintf("%s,\n\nYou have been invited to join. Open the link below to complete your profile:\n\n%s\n\nThe invitation expires on %s.\n",
+			greeting, es.publicLink("/accept-invitation", tenant, token), expiresAt.UTC().Format(time.RFC1123)),
+	})
+}
+
+func (es *emailService) SendPasswordResetEmail(tenant string, name string, email string, token string, expiresAt time.Time) error {
+	return es.mailSender.Send(models.MailMessage{
+		To:      email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hello %s,\n\nA password reset was requested for your account. Open the link below to choose a new password:\n\n%s\n\nThe link can be used once and expires on %s. If you did not ask for this, you can ignore this email.\n",
+			name, es.publicLink("/reset-password", tenant, token), expiresAt.UTC().Format(time.RFC1123)),
+	})
+}
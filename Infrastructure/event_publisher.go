@@ -0,0 +1,67 @@
+package infrastructure
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/google-run-code/config"
+)
+
+// NewEventPublisher picks the publisher configured by EVENT_DRIVER. The log
+// publisher is meant for local development.
+func NewEventPublisher(env config.Env) interfaces.EventPublisher {
+	switch strings.ToLower(env.EVENT_DRIVER) {
+	case "", "log":
+		return &logEventPublisher{}
+	case "webhook":
+		if env.EVENT_WEBHOOK_URL == "" {
+			log.Fatalf("EVENT_WEBHOOK_URL is required for the webhook EVENT_DRIVER")
+		}
+		return &webhookEventPublisher{url: env.EVENT_WEBHOOK_URL, client: &http.Client{Timeout: 10 * time.Second}}
+	default:
+		log.Fatalf("Unknown EVENT_DRIVER: %s", env.EVENT_DRIVER)
+		return nil
+	}
+}
+
+type logEventPublisher struct{}
+
+func (p *logEventPublisher) Publish(event models.Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	log.Printf("event %s", body)
+	return nil
+}
+
+// webhookEventPublisher posts every event as JSON to a single URL.
+type webhookEventPublisher struct {
+	url    string
+	client *http.Client
+}
+
+func (p *webhookEventPublisher) Publish(event models.Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.client.Post(p.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook answered %s", resp.Status)
+	}
+	return nil
+}
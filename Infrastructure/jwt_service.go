@@ -60,15 +60,26 @@ func (j *JwtService) ValidateAuthHeader(authHeader string) ([]string, error) {
 }
 
 func (j *JwtService) GenerateToken(database string) (string, error) {
-	expiresIn := 24 * time.Hour
+	return j.sign(&models.JWTCustome{Database: database})
+}
 
-	claims := &models.JWTCustome{
-		Expires:  time.Now().Add(expiresIn).Unix(),
+// GenerateUserToken issues a token bound to the user, which only grants what
+// the user may do.
+func (j *JwtService) GenerateUserToken(database string, userUID string) (string, error) {
+	return j.sign(&models.JWTCustome{
 		Database: database,
+		UserUID:  userUID,
 		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(expiresIn).Unix(),
+			IssuedAt: time.Now().Unix(),
 		},
-	}
+	})
+}
+
+func (j *JwtService) sign(claims *models.JWTCustome) (string, error) {
+	expiresIn := 24 * time.Hour
+
+	claims.Expires = time.Now().Add(expiresIn).Unix()
+	claims.ExpiresAt = claims.Expires
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	tokenStr, err := token.SignedString([]byte(j.Env.JWT_SECRET))
 	if err != nil {
@@ -0,0 +1,84 @@
+package infrastructure
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/google-run-code/config"
+)
+
+// NewMailSender picks the sender configured by MAIL_DRIVER. The file and log
+// senders are meant for local development.
+func NewMailSender(env config.Env) interfaces.MailSender {
+	switch strings.ToLower(env.MAIL_DRIVER) {
+	case "smtp":
+		return &smtpMailSender{env: env}
+	case "file":
+		return &fileMailSender{from: env.MAIL_FROM, dir: env.MAIL_DIR}
+	default:
+		return &logMailSender{from: env.MAIL_FROM}
+	}
+}
+
+func formatMessage(from string, message models.MailMessage) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", message.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", message.Subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	b.WriteString(message.Body)
+	return []byte(b.String())
+}
+
+type smtpMailSender struct {
+	env config.Env
+}
+
+func (s *smtpMailSender) Send(message models.MailMessage) error {
+	if s.env.SMTP_HOST == "" {
+		return fmt.Errorf("SMTP_HOST is not configured")
+	}
+
+	var auth smtp.Auth
+	if s.env.SMTP_USERNAME != "" {
+		auth = smtp.PlainAuth("", s.env.SMTP_USERNAME, s.env.SMTP_PASSWORD, s.env.SMTP_HOST)
+	}
+
+	addr := net.JoinHostPort(s.env.SMTP_HOST, s.env.SMTP_PORT)
+	return smtp.SendMail(addr, auth, s.env.MAIL_FROM, []string{message.To}, formatMessage(s.env.MAIL_FROM, message))
+}
+
+type fileMailSender struct {
+	from string
+	dir  string
+}
+
+// Send writes each message to its own .eml file so it can be opened in a mail client.
+func (s *fileMailSender) Send(message models.MailMessage) error {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return err
+	}
+
+	recipient := strings.NewReplacer("@", "_at_", "/", "_", "\\", "_").Replace(message.To)
+	name := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), recipient)
+	return os.WriteFile(filepath.Join(s.dir, name), formatMessage(s.from, message), 0o644)
+}
+
+type logMailSender struct {
+	from string
+}
+
+func (s *logMailSender) Send(message models.MailMessage) error {
+	log.Printf("mail from=%s to=%s subject=%q\n%s", s.from, message.To, message.Subject, message.Body)
+	return nil
+}
@@ -0,0 +1,24 @@
+package infrastructure
+
+import (
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type passwordHasher struct{}
+
+func NewPasswordHasher() interfaces.PasswordHasher {
+	return &passwordHasher{}
+}
+
+func (h *passwordHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func (h *passwordHasher) Compare(hash string, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
@@ -0,0 +1,50 @@
+package infrastructure
+
+import (
+	"log"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/google-run-code/config"
+)
+
+// TenantJob is background work that runs for every ready tenant at a fixed
+// interval. Run receives a context carrying the tenant, like a request would.
+type TenantJob struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx *gin.Context) *models.ErrorResponse
+}
+
+// StartTenantJobs runs each job on its own ticker until the process exits. A
+// job without an interval is disabled. Every instance runs the jobs, so they
+// must tolerate running concurrently.
+func StartTenantJobs(dbConfig *config.PostgresConfig, jobs ...TenantJob) {
+	for _, job := range jobs {
+		if job.Interval <= 0 {
+			log.Printf("Job %s is disabled", job.Name)
+			continue
+		}
+		go runTenantJob(dbConfig, job)
+	}
+}
+
+func runTenantJob(dbConfig *config.PostgresConfig, job TenantJob) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		for _, tenant := range dbConfig.StartupProgress().Tenants {
+			if tenant.State != models.TenantReady {
+				continue
+			}
+
+			ctx := &gin.Context{}
+			ctx.Set(models.TenantContextKey, &tenant)
+			if err := job.Run(ctx); err != nil {
+				log.Printf("Job %s failed for tenant %s: %s", job.Name, tenant.Name, err.Message)
+			}
+		}
+	}
+}
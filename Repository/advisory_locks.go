@@ -0,0 +1,24 @@
+package repository
+
+import "hash/fnv"
+
+// Keys of the transaction-level advisory locks the repositories take. Each is
+// derived from a name, the way the migration lock is, so keys stay distinct
+// without being assigned by hand.
+var (
+	// hierarchyLockKey serializes manager changes within a tenant, so two
+	// concurrent changes cannot each pass the cycle check and create a cycle
+	// together.
+	hierarchyLockKey = advisoryLockKey("user-hierarchy")
+
+	// groupHierarchyLockKey serializes changes to group nesting within a
+	// tenant, so two concurrent moves cannot each pass the cycle check and
+	// create a cycle together.
+	groupHierarchyLockKey = advisoryLockKey("group-hierarchy")
+)
+
+func advisoryLockKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte("lock:" + name))
+	return int64(h.Sum64())
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/google-run-code/config"
+)
+
+type attributeSchemaRepository struct {
+	dbConfig *config.PostgresConfig
+}
+
+func NewAttributeSchemaRepository(dbConfig *config.PostgresConfig) interfaces.AttributeSchemaRepository {
+	return &attributeSchemaRepository{
+		dbConfig: dbConfig,
+	}
+}
+
+func (r *attributeSchemaRepository) getDB(ctx *gin.Context) (*gorm.DB, error) {
+	value, _ := ctx.Get(models.TenantContextKey)
+	tenant, ok := value.(*models.Tenant)
+	if !ok {
+		return nil, models.InternalServerError("Tenant missing from request context")
+	}
+
+	db, err := r.dbConfig.GetDB(tenant.Name)
+	if err != nil {
+		return nil, models.InternalServerError("Failed to get database connection")
+	}
+
+	return db, nil
+}
+
+// GetAttributeSchema returns nil without an error when the tenant has not
+// defined a schema.
+func (r *attributeSchemaRepository) GetAttributeSchema(ctx *gin.Context) (*dtos.AttributeSchemaResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var schema models.AttributeSchema
+	if err := db.WithContext(ctx).First(&schema, models.AttributeSchemaID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return &dtos.AttributeSchemaResponse{
+		Schema:    schema.Schema,
+		UpdatedAt: schema.UpdatedAt,
+	}, nil
+}
+
+func (r *attributeSchemaRepository) SaveAttributeSchema(schema json.RawMessage, ctx *gin.Context) (*dtos.AttributeSchemaResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	record := models.AttributeSchema{
+		ID:        models.AttributeSchemaID,
+		Schema:    schema,
+		UpdatedAt: time.Now(),
+	}
+
+	if err := db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"schema", "updated_at"}),
+	}).Create(&record).Error; err != nil {
+		return nil, models.InternalServerError("Failed to save attribute schema: " + err.Error())
+	}
+
+	return &dtos.AttributeSchemaResponse{
+		Schema:    record.Schema,
+		UpdatedAt: record.UpdatedAt,
+	}, nil
+}
+
+func (r *attributeSchemaRepository) DeleteAttributeSchema(ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	result := db.WithContext(ctx).Delete(&models.AttributeSchema{}, models.AttributeSchemaID)
+	if result.Error != nil {
+		return models.InternalServerError("Failed to delete attribute schema: " + result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return models.NotFound("No attribute schema defined")
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// recordAudit adds an entry to the audit log as part of the caller's
+// transaction, so the entry exists exactly when the change does.
+func recordAudit(tx *gorm.DB, action string, targetType string, targetUID uuid.UUID, details map[string]any) error {
+	entry := models.AuditEntry{
+		UID:        uuid.New(),
+		Action:     action,
+		TargetType: targetType,
+		TargetUID:  targetUID,
+		Details:    json.RawMessage("{}"),
+	}
+	if len(details) > 0 {
+		data, err := json.Marshal(details)
+		if err != nil {
+			return err
+		}
+		entry.Details = data
+	}
+	return tx.Create(&entry).Error
+}
+
+// recordRoleAudit audits a change to one of the user's roles.
+func recordRoleAudit(tx *gorm.DB, action string, userUID uuid.UUID, roleID int) error {
+	var role models.Role
+	if err := tx.Unscoped().Select("uid", "name").First(&role, roleID).Error; err != nil {
+		return err
+	}
+	return recordAudit(tx, action, models.AuditTargetUser, userUID, map[string]any{
+		"role_id":   role.UID.String(),
+		"role_name": role.Name,
+	})
+}
+
+// auditEntries lists the audit log of one target, oldest first.
+func auditEntries(db *gorm.DB, targetType string, targetUID uuid.UUID) ([]dtos.AuditEntryResponse, error) {
+	var entries []models.AuditEntry
+	if err := db.Where("target_type = ? AND target_uid = ?", targetType, targetUID).
+		Order("created_at, id").
+		Find(&entries).Error; err != nil {
+		return nil, err
+	}
+
+	result := []dtos.AuditEntryResponse{}
+	for _, entry := range entries {
+		res := dtos.AuditEntryResponse{
+			UID:       entry.UID.String(),
+			Action:    entry.Action,
+			CreatedAt: entry.CreatedAt,
+		}
+		if string(entry.Details) != "{}" {
+			res.Details = entry.Details
+		}
+		result = append(result, res)
+	}
+	return result, nil
+}
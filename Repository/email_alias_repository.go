@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+func emailAliasResponse(alias *models.EmailAlias) dtos.EmailAliasResponse {
+	return dtos.EmailAliasResponse{
+		UID:        alias.UID.String(),
+		Email:      alias.Email,
+		Verified:   alias.VerifiedAt != nil,
+		VerifiedAt: alias.VerifiedAt,
+		CreatedAt:  alias.CreatedAt,
+	}
+}
+
+func aliasExists(tx *gorm.DB, email string) (bool, error) {
+	var count int64
+	err := tx.Model(&models.EmailAlias{}).Where("email_normalized = ?", models.NormalizeEmail(email)).Count(&count).Error
+	return count > 0, err
+}
+
+// checkAliasConflict guards the part of email uniqueness the database cannot
+// enforce: a primary email must not match any user's alias.
+func checkAliasConflict(tx *gorm.DB, email string) *models.ErrorResponse {
+	exists, err := aliasExists(tx, email)
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+	if exists {
+		return models.Conflict("Email is already in use")
+	}
+	return nil
+}
+
+// IsEmailTaken reports whether the email is the primary email of a user other
+// than exceptUserUID, or an alias of any user.
+func (r *userRepository) IsEmailTaken(email string, exceptUserUID string, ctx *gin.Context) (bool, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return false, models.InternalServerError(err.Error())
+	}
+
+	normalized := models.NormalizeEmail(email)
+	query := db.WithContext(ctx).Model(&models.User{}).Where("email_normalized = ?", normalized)
+	if exceptUserUID != "" {
+		query = query.Where("uid::text <> ?", exceptUserUID)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return false, models.InternalServerError(err.Error())
+	}
+	if count > 0 {
+		return true, nil
+	}
+
+	exists, err := aliasExists(db.WithContext(ctx), email)
+	if err != nil {
+		return false, models.InternalServerError(err.Error())
+	}
+
+	return exists, nil
+}
+
+func findUserID(db *gorm.DB, userUID string) (int, *models.ErrorResponse) {
+	var user models.User
+	if err := db.Select("id").Where("uid = ?", userUID).First(&user).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return 0, models.NotFound("User not found")
+		}
+		return 0, models.InternalServerError(err.Error())
+	}
+	return user.ID, nil
+}
+
+func (r *userRepository) GetEmailAliases(userUID string, ctx *gin.Context) ([]*dtos.EmailAliasResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	userID, errResp := findUserID(db.WithContext(ctx), userUID)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	var aliases []*models.EmailAlias
+	if err := db.WithContext(ctx).Where("user_id = ?", userID).Order("id").Find(&aliases).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var result []*dtos.EmailAliasResponse
+	for _, alias := range aliases {
+		response := emailAliasResponse(alias)
+		result = append(result, &response)
+	}
+
+	return result, nil
+}
+
+func (r *userRepository) CreateEmailAlias(userUID string, email string, ctx *gin.Context) (*dtos.EmailAliasResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	userID, errResp := findUserID(db.WithContext(ctx), userUID)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	alias := models.EmailAlias{
+		UID:    uuid.New(),
+		UserID: userID,
+		Email:  email,
+	}
+	if err := db.WithContext(ctx).Create(&alias).Error; err != nil {
+		if isUniqueViolation(err) {
+			return nil, models.Conflict("Email is already in use")
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	response := emailAliasResponse(&alias)
+	return &response, nil
+}
+
+func (r *userRepository) GetEmailAlias(userUID string, aliasUID string, ctx *gin.Context) (*dtos.EmailAliasResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var alias models.EmailAlias
+	if err := db.WithContext(ctx).
+		Joins("JOIN users ON users.id = email_aliases.user_id AND users.deleted_at IS NULL").
+		Where("users.uid = ? AND email_aliases.uid = ?", userUID, aliasUID).
+		First(&alias).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, models.NotFound("Email alias not found")
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	response := emailAliasResponse(&alias)
+	return &response, nil
+}
+
+func (r *userRepository) DeleteEmailAlias(userUID string, aliasUID string, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	result := db.WithContext(ctx).
+		Where("uid = ? AND user_id = (SELECT id FROM users WHERE uid = ? AND deleted_at IS NULL)", aliasUID, userUID).
+		Delete(&models.EmailAlias{})
+	if result.Error != nil {
+		return models.InternalServerError(result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return models.NotFound("Email alias not found")
+	}
+
+	return nil
+}
+
+func (r *userRepository) MarkEmailAliasVerified(userUID string, email string, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	result := db.WithContext(ctx).Model(&models.EmailAlias{}).
+		Where("email_normalized = ? AND user_id = (SELECT id FROM users WHERE uid = ? AND deleted_at IS NULL)", models.NormalizeEmail(email), userUID).
+		Update("verified_at", gorm.Expr("COALESCE(verified_at, ?)", time.Now()))
+	if result.Error != nil {
+		return models.InternalServerError("Failed to verify email alias: " + result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return models.Conflict("The email address has changed since the link was sent")
+	}
+
+	return nil
+}
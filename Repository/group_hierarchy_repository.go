@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// subgroupsQuery lists a group and every group nested in it, with how deep.
+const subgroupsQuery = `
+WITH RECURSIVE subgroups AS (
+	SELECT g.id, 0 AS depth FROM groups g WHERE g.id = ?
+	UNION ALL
+	SELECT g.id, s.depth + 1 FROM groups g
+	JOIN subgroups s ON g.parent_id = s.id
+	WHERE g.deleted_at IS NULL AND s.depth < ?
+)`
+
+// transitiveMembersQuery lists the members of a group and of the groups nested
+// in it. Users in several of them are listed once, for the nearest group.
+const transitiveMembersQuery = subgroupsQuery + `
+SELECT DISTINCT ON (users.id) users.uid::text AS uid, users.name, users.email, users.status,
+       CASE WHEN s.depth > 0 THEN g.uid::text ELSE '' END AS inherited_from
+FROM subgroups s
+JOIN groups g ON g.id = s.id
+JOIN groups_users_maps ON groups_users_maps.groups_id = s.id
+JOIN users ON users.id = groups_users_maps.users_id
+WHERE users.deleted_at IS NULL AND ` + activeMembership + `
+ORDER BY users.id, s.depth`
+
+// supergroupsQuery lists the groups a user is a member of now and every group
+// those are nested in, with the group the user is a direct member of, how deep
+// and the period of that membership.
+const supergroupsQuery = `
+WITH RECURSIVE supergroups AS (
+	SELECT groups_users_maps.groups_id AS id, groups_users_maps.groups_id AS via, 0 AS depth,
+	       groups_users_maps.valid_from, groups_users_maps.valid_until
+	FROM groups_users_maps
+	WHERE groups_users_maps.users_id = ? AND ` + activeMembership + `
+	UNION ALL
+	SELECT g.parent_id, s.via, s.depth + 1, s.valid_from, s.valid_until FROM groups g
+	JOIN supergroups s ON g.id = s.id
+	WHERE g.parent_id IS NOT NULL AND g.deleted_at IS NULL AND s.depth < ?
+)`
+
+// transitiveGroupsQuery lists the groups a user is a member of and every group
+// those are nested in. Each group is listed once, through the nearest group
+// the user is a direct member of, with the period of that membership.
+const transitiveGroupsQuery = supergroupsQuery + `
+SELECT uid, name, parent_id, inherited_from, valid_from, valid_until FROM (
+	SELECT DISTINCT ON (g.id) g.id, g.uid::text AS uid, g.name, COALESCE(p.uid::text, '') AS parent_id,
+	       CASE WHEN s.depth > 0 THEN v.uid::text ELSE '' END AS inherited_from,
+	       s.valid_from, s.valid_until
+	FROM supergroups s
+	JOIN groups g ON g.id = s.id AND g.deleted_at IS NULL
+	JOIN groups v ON v.id = s.via AND v.deleted_at IS NULL
+	LEFT JOIN groups p ON p.id = g.parent_id
+	ORDER BY g.id, s.depth
+) transitive
+ORDER BY id`
+
+func groupResponse(group *models.Group) *dtos.GroupResponse {
+	res := &dtos.GroupResponse{
+		UID:  group.UID.String(),
+		Name: group.Name,
+		Type: group.Type,
+		Rule: group.Rule,
+	}
+	if group.Parent != nil {
+		res.ParentID = group.Parent.UID.String()
+	}
+	return res
+}
+
+// assignParent nests the group in the group parentUID or, with an empty
+// parentUID, makes it top-level. It refuses a parent that is nested in the
+// group, directly or not, since that would close a cycle.
+func assignParent(tx *gorm.DB, group *models.Group, parentUID string) *models.ErrorResponse {
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", groupHierarchyLockKey).Error; err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if parentUID == "" {
+		if err := tx.Model(group).Update("parent_id", nil).Error; err != nil {
+			return models.InternalServerError("Failed to update parent group: " + err.Error())
+		}
+		group.ParentID = nil
+		group.Parent = nil
+		return nil
+	}
+
+	var parent models.Group
+	if err := tx.Where("uid = ?", parentUID).First(&parent).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.NotFound("Parent group not found")
+		}
+		return models.InternalServerError(err.Error())
+	}
+
+	if parent.ID == group.ID {
+		return models.Conflict("A group cannot be nested in itself")
+	}
+	cycle, err := nestedIn(tx, parent.ID, group.ID)
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+	if cycle {
+		return models.Conflict("The parent group is nested in this group, which would create a cycle")
+	}
+
+	if err := tx.Model(group).Update("parent_id", parent.ID).Error; err != nil {
+		return models.InternalServerError("Failed to update parent group: " + err.Error())
+	}
+	group.ParentID = &parent.ID
+	group.Parent = &parent
+	return nil
+}
+
+// nestedIn reports whether the group id is the group ancestorID or nested in
+// it.
+func nestedIn(tx *gorm.DB, id int, ancestorID int) (bool, error) {
+	var ids []int
+	if err := tx.Raw(subgroupsQuery+` SELECT id FROM subgroups`, ancestorID, maxHierarchyDepth).
+		Scan(&ids).Error; err != nil {
+		return false, err
+	}
+	for _, subgroupID := range ids {
+		if subgroupID == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// detachChildren makes the groups nested in a group that is being deleted
+// top-level and returns them.
+func detachChildren(tx *gorm.DB, groupID int) ([]int, error) {
+	var childIDs []int
+	if err := tx.Model(&models.Group{}).Where("parent_id = ?", groupID).Order("id").
+		Pluck("id", &childIDs).Error; err != nil {
+		return nil, err
+	}
+	if len(childIDs) == 0 {
+		return nil, nil
+	}
+	if err := tx.Model(&models.Group{}).Where("id IN ?", childIDs).Update("parent_id", nil).Error; err != nil {
+		return nil, err
+	}
+	return childIDs, nil
+}
+
+// restoreNesting puts a restored group back in its parent and takes back the
+// groups that were nested in it. A parent that is gone, a child that was
+// nested elsewhere in the meantime and anything that would now close a cycle
+// are skipped.
+func restoreNesting(tx *gorm.DB, group *models.Group, snapshot *models.TrashSnapshot) error {
+	if snapshot.ParentID == nil && len(snapshot.ChildIDs) == 0 {
+		return nil
+	}
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", groupHierarchyLockKey).Error; err != nil {
+		return err
+	}
+
+	if snapshot.ParentID != nil {
+		var parent models.Group
+		err := tx.Select("id").First(&parent, *snapshot.ParentID).Error
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return err
+		}
+		if err == nil {
+			if err := tx.Model(group).Update("parent_id", parent.ID).Error; err != nil {
+				return err
+			}
+			group.ParentID = &parent.ID
+		}
+	}
+
+	if len(snapshot.ChildIDs) == 0 {
+		return nil
+	}
+	var children []models.Group
+	if err := tx.Where("id IN ? AND parent_id IS NULL", snapshot.ChildIDs).Order("id").Find(&children).Error; err != nil {
+		return err
+	}
+	for _, child := range children {
+		if group.ParentID != nil {
+			cycle, err := nestedIn(tx, *group.ParentID, child.ID)
+			if err != nil {
+				return err
+			}
+			if cycle {
+				continue
+			}
+		}
+		if err := tx.Model(&child).Update("parent_id", group.ID).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetGroupUsersTransitive lists the members of the group and of every group
+// nested in it.
+func (r *groupRepository) GetGroupUsersTransitive(uid string, ctx *gin.Context) ([]dtos.UserResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var group models.Group
+	if err := db.WithContext(ctx).Select("id").Where("uid = ?", uid).First(&group).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, models.NotFound("Group not found")
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var users []dtos.UserResponse
+	if err := db.WithContext(ctx).Raw(transitiveMembersQuery, group.ID, maxHierarchyDepth).Scan(&users).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return users, nil
+}
+
+// GetUsersGroupsTransitive lists the groups the user is a member of, directly
+// or through a group nested in them.
+func (r *userRepository) GetUsersGroupsTransitive(uid string, ctx *gin.Context) ([]*dtos.GroupResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	userID, errResp := findUserID(db.WithContext(ctx), uid)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	var groups []*dtos.GroupResponse
+	if err := db.WithContext(ctx).Raw(transitiveGroupsQuery, userID, maxHierarchyDepth).Scan(&groups).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return groups, nil
+}
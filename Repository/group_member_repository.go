@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// How a declarative change treats the members of a group: set replaces them
+// with the listed users, while add and remove only touch the listed users.
+const (
+	membersSet = iota
+	membersAdd
+	membersRemove
+)
+
+// findMembers loads the users with the given UIDs, each once.
+func findMembers(tx *gorm.DB, userUIDs []string) ([]models.User, *models.ErrorResponse) {
+	if len(userUIDs) == 0 {
+		return nil, nil
+	}
+
+	var users []models.User
+	if err := tx.Select("id", "uid", "name", "email", "status").
+		Where("uid IN ?", userUIDs).
+		Order("id").
+		Find(&users).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	found := make(map[string]bool, len(users))
+	for _, user := range users {
+		found[user.UID.String()] = true
+	}
+	for _, uid := range userUIDs {
+		if parsed, err := uuid.Parse(uid); err != nil || !found[parsed.String()] {
+			return nil, models.NotFound("User not found: " + uid)
+		}
+	}
+	return users, nil
+}
+
+// changeMembers applies a declarative change to the members of a group and
+// returns the difference it made. The group and its memberships stay locked
+// until the change is committed, so concurrent changes cannot lose each other.
+// Memberships that expired but were not removed yet do not count.
+func (r *groupRepository) changeMembers(id string, req dtos.GroupMembersRequest, change int, ctx *gin.Context) (*dtos.GroupMembersDiff, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	diff := &dtos.GroupMembersDiff{
+		Added:     []dtos.UserResponse{},
+		Removed:   []dtos.UserResponse{},
+		Unchanged: []dtos.UserResponse{},
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		group, errResp := lockGroup(tx, id)
+		if errResp != nil {
+			return errResp
+		}
+		if group.IsDynamic() {
+			return dynamicMembersError(group)
+		}
+
+		listed, errResp := findMembers(tx, req.UserIds)
+		if errResp != nil {
+			return errResp
+		}
+
+		var memberships []models.GroupMembership
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("groups_id = ? AND (valid_until IS NULL OR valid_until > now())", group.ID).
+			Find(&memberships).Error; err != nil {
+			return models.InternalServerError(err.Error())
+		}
+
+		users := make(map[int]models.User)
+		isMember := make(map[int]bool, len(memberships))
+		for _, membership := range memberships {
+			isMember[membership.UsersId] = true
+		}
+		isListed := make(map[int]bool, len(listed))
+		for _, user := range listed {
+			isListed[user.ID] = true
+			users[user.ID] = user
+		}
+
+		added := make(map[int]bool)
+		removed := make(map[int]bool)
+		for _, user := range listed {
+			if change == membersRemove && isMember[user.ID] {
+				removed[user.ID] = true
+			} else if change != membersRemove && !isMember[user.ID] {
+				added[user.ID] = true
+			}
+		}
+		if change == membersSet {
+			for _, membership := range memberships {
+				if !isListed[membership.UsersId] {
+					removed[membership.UsersId] = true
+				}
+			}
+		}
+
+		var newMemberships []models.GroupMembership
+		for _, user := range listed {
+			if added[user.ID] {
+				newMemberships = append(newMemberships, models.GroupMembership{
+					UsersId:    user.ID,
+					GroupsId:   group.ID,
+					ValidFrom:  req.ValidFrom,
+					ValidUntil: req.ValidUntil,
+				})
+			}
+		}
+		// A membership that expired but was not removed yet is renewed
+		if len(newMemberships) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "users_id"}, {Name: "groups_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{"valid_from", "valid_until"}),
+			}).Create(&newMemberships).Error; err != nil {
+				return models.InternalServerError("Failed to add group members: " + err.Error())
+			}
+		}
+
+		var removedIDs []int
+		for userID := range removed {
+			removedIDs = append(removedIDs, userID)
+		}
+		if len(removedIDs) > 0 {
+			if err := tx.Where("groups_id = ? AND users_id IN ?", group.ID, removedIDs).
+				Delete(&models.GroupMembership{}).Error; err != nil {
+				return models.InternalServerError("Failed to remove group members: " + err.Error())
+			}
+		}
+
+		// Members that were not listed are only known by their id so far
+		var unlisted []int
+		for _, membership := range memberships {
+			if !isListed[membership.UsersId] {
+				unlisted = append(unlisted, membership.UsersId)
+			}
+		}
+		if len(unlisted) > 0 {
+			var others []models.User
+			if err := tx.Select("id", "uid", "name", "email", "status").
+				Where("id IN ?", unlisted).
+				Find(&others).Error; err != nil {
+				return models.InternalServerError(err.Error())
+			}
+			for _, user := range others {
+				users[user.ID] = user
+			}
+		}
+
+		userIDs := make([]int, 0, len(users))
+		for userID := range users {
+			userIDs = append(userIDs, userID)
+		}
+		sort.Ints(userIDs)
+		for _, userID := range userIDs {
+			user := users[userID]
+			res := dtos.UserResponse{
+				UID:    user.UID.String(),
+				Name:   user.Name,
+				Email:  user.Email,
+				Status: string(user.Status),
+			}
+			switch {
+			case added[userID]:
+				diff.Added = append(diff.Added, res)
+			case removed[userID]:
+				diff.Removed = append(diff.Removed, res)
+			case isMember[userID]:
+				diff.Unchanged = append(diff.Unchanged, res)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, toErrorResponse(err)
+	}
+
+	return diff, nil
+}
+
+// SetGroupMembers makes the listed users the only members of the group.
+func (r *groupRepository) SetGroupMembers(id string, req dtos.GroupMembersRequest, ctx *gin.Context) (*dtos.GroupMembersDiff, *models.ErrorResponse) {
+	return r.changeMembers(id, req, membersSet, ctx)
+}
+
+// AddGroupMembers puts the listed users in the group. Users that are already
+// members keep their validity period.
+func (r *groupRepository) AddGroupMembers(id string, req dtos.GroupMembersRequest, ctx *gin.Context) (*dtos.GroupMembersDiff, *models.ErrorResponse) {
+	return r.changeMembers(id, req, membersAdd, ctx)
+}
+
+// RemoveGroupMembers takes the listed users out of the group. Listed users
+// that are not members are ignored.
+func (r *groupRepository) RemoveGroupMembers(id string, req dtos.GroupMembersRequest, ctx *gin.Context) (*dtos.GroupMembersDiff, *models.ErrorResponse) {
+	return r.changeMembers(id, req, membersRemove, ctx)
+}
@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// activeMembership matches the rows of groups_users_maps that are valid now.
+// Memberships that expired but were not removed yet are left out.
+const activeMembership = `(groups_users_maps.valid_from IS NULL OR groups_users_maps.valid_from <= now())
+	AND (groups_users_maps.valid_until IS NULL OR groups_users_maps.valid_until > now())`
+
+// userGroups lists the groups the user is a member of now.
+func userGroups(db *gorm.DB, userID int) ([]dtos.GroupResponse, error) {
+	var groups []dtos.GroupResponse
+	err := db.Table("groups").
+		Select("groups.uid::text AS uid, groups.name, groups_users_maps.valid_from, groups_users_maps.valid_until").
+		Joins("JOIN groups_users_maps ON groups_users_maps.groups_id = groups.id").
+		Where("groups_users_maps.users_id = ? AND groups.deleted_at IS NULL AND "+activeMembership, userID).
+		Order("groups.id").
+		Scan(&groups).Error
+	return groups, err
+}
+
+// groupMembers lists the users that are a member of the group now.
+func groupMembers(db *gorm.DB, groupID int) ([]dtos.UserResponse, error) {
+	var users []dtos.UserResponse
+	err := db.Table("users").
+		Select("users.uid::text AS uid, users.name, users.email, users.status").
+		Joins("JOIN groups_users_maps ON groups_users_maps.users_id = users.id").
+		Where("groups_users_maps.groups_id = ? AND users.deleted_at IS NULL AND "+activeMembership, groupID).
+		Order("users.id").
+		Scan(&users).Error
+	return users, err
+}
+
+// detachMemberships removes the group memberships whose column (users_id or
+// groups_id) is id and returns them.
+func detachMemberships(tx *gorm.DB, column string, id int) ([]models.GroupMembership, error) {
+	var memberships []models.GroupMembership
+	if err := tx.Where(column+" = ?", id).Order("users_id, groups_id").Find(&memberships).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Where(column+" = ?", id).Delete(&models.GroupMembership{}).Error; err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
+
+// restoreMemberships brings back the memberships recorded in a snapshot.
+// Memberships that expired in the meantime, or whose user or group is gone,
+// are dropped.
+func restoreMemberships(tx *gorm.DB, memberships []models.GroupMembership) error {
+	if len(memberships) == 0 {
+		return nil
+	}
+
+	var userIDs, groupIDs []int
+	for _, membership := range memberships {
+		userIDs = append(userIDs, membership.UsersId)
+		groupIDs = append(groupIDs, membership.GroupsId)
+	}
+
+	var existingUsers, existingGroups []int
+	if err := tx.Model(&models.User{}).Where("id IN ?", userIDs).Pluck("id", &existingUsers).Error; err != nil {
+		return err
+	}
+	if err := tx.Model(&models.Group{}).Where("id IN ?", groupIDs).Pluck("id", &existingGroups).Error; err != nil {
+		return err
+	}
+	users := make(map[int]bool)
+	for _, id := range existingUsers {
+		users[id] = true
+	}
+	groups := make(map[int]bool)
+	for _, id := range existingGroups {
+		groups[id] = true
+	}
+
+	now := time.Now()
+	var restored []models.GroupMembership
+	for _, membership := range memberships {
+		if !users[membership.UsersId] || !groups[membership.GroupsId] {
+			continue
+		}
+		if membership.ValidUntil != nil && !membership.ValidUntil.After(now) {
+			continue
+		}
+		restored = append(restored, membership)
+	}
+	if len(restored) == 0 {
+		return nil
+	}
+
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&restored).Error
+}
+
+// snapshotMemberships returns the memberships of a snapshot, including the
+// ones older snapshots recorded as bare IDs for the given user or group.
+func snapshotMemberships(snapshot *models.TrashSnapshot, userID, groupID int) []models.GroupMembership {
+	memberships := snapshot.Memberships
+	for _, id := range snapshot.GroupIDs {
+		memberships = append(memberships, models.GroupMembership{UsersId: userID, GroupsId: id})
+	}
+	if groupID != 0 {
+		for _, id := range snapshot.UserIDs {
+			memberships = append(memberships, models.GroupMembership{UsersId: id, GroupsId: groupID})
+		}
+	}
+	return memberships
+}
+
+// GetUsersGroups lists the groups the user is a member of now, with the
+// validity period of each membership.
+func (r *userRepository) GetUsersGroups(uid string, ctx *gin.Context) ([]*dtos.GroupResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	userID, errResp := findUserID(db.WithContext(ctx), uid)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	groups, err := userGroups(db.WithContext(ctx), userID)
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var result []*dtos.GroupResponse
+	for i := range groups {
+		result = append(result, &groups[i])
+	}
+
+	return result, nil
+}
+
+// AddUserToGroup puts the user in the groups, or changes the validity period
+// of memberships they already have.
+func (r *userRepository) AddUserToGroup(req dtos.AddUserToGroupRequest, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	userID, errResp := findUserID(db.WithContext(ctx), req.UserUID)
+	if errResp != nil {
+		return errResp
+	}
+
+	var groupIDs []int
+	if err := db.WithContext(ctx).Model(&models.Group{}).
+		Where("uid IN ?", req.GroupIds).
+		Pluck("id", &groupIDs).Error; err != nil {
+		return models.InternalServerError(err.Error())
+	}
+	if len(groupIDs) == 0 {
+		return models.NotFound("One or more groups not found")
+	}
+
+	var memberships []models.GroupMembership
+	for _, groupID := range groupIDs {
+		memberships = append(memberships, models.GroupMembership{
+			UsersId:    userID,
+			GroupsId:   groupID,
+			ValidFrom:  req.ValidFrom,
+			ValidUntil: req.ValidUntil,
+		})
+	}
+
+	if err := db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "users_id"}, {Name: "groups_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"valid_from", "valid_until"}),
+	}).Create(&memberships).Error; err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+// ExpireGroupMemberships removes up to expiryBatchSize memberships that
+// expired before now and returns them. Concurrent sweeps skip each other's
+// rows.
+func (r *userRepository) ExpireGroupMemberships(now time.Time, ctx *gin.Context) ([]dtos.ExpiredGroupMembership, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var expired []dtos.ExpiredGroupMembership
+	if err := db.WithContext(ctx).Raw(`
+		WITH expired AS (
+			DELETE FROM groups_users_maps
+			WHERE (users_id, groups_id) IN (
+				SELECT users_id, groups_id FROM groups_users_maps
+				WHERE valid_until <= ?
+				ORDER BY valid_until
+				LIMIT ?
+				FOR UPDATE SKIP LOCKED)
+			RETURNING users_id, groups_id, valid_until
+		)
+		SELECT u.uid::text AS user_uid, g.uid::text AS group_uid, g.name AS group_name, e.valid_until
+		FROM expired e
+		JOIN users u ON u.id = e.users_id
+		JOIN groups g ON g.id = e.groups_id
+		ORDER BY e.valid_until`, now, expiryBatchSize).
+		Scan(&expired).Error; err != nil {
+		return nil, models.InternalServerError("Failed to expire group memberships: " + err.Error())
+	}
+
+	return expired, nil
+}
@@ -0,0 +1,383 @@
+package repository
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// lockGroup loads the group and locks it until the transaction ends, so that
+// concurrent ownership changes cannot leave it without an owner.
+func lockGroup(tx *gorm.DB, uid string) (*models.Group, *models.ErrorResponse) {
+	var group models.Group
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("uid = ?", uid).
+		First(&group).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, models.NotFound("Group not found")
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+	return &group, nil
+}
+
+// addOwner makes the user an owner of the group and audits it. Adding an
+// existing owner changes nothing.
+func addOwner(tx *gorm.DB, group *models.Group, userID int, userUID uuid.UUID) error {
+	res := tx.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.GroupOwner{GroupID: group.ID, UserID: userID})
+	if res.Error != nil || res.RowsAffected == 0 {
+		return res.Error
+	}
+	return recordAudit(tx, models.AuditGroupOwnerAdded, models.AuditTargetGroup, group.UID, map[string]any{
+		"user_id": userUID.String(),
+	})
+}
+
+// addOwners makes the users with the given UIDs owners of the group.
+func addOwners(tx *gorm.DB, group *models.Group, userUIDs []string) *models.ErrorResponse {
+	for _, uid := range userUIDs {
+		var user models.User
+		if err := tx.Select("id", "uid").Where("uid = ?", uid).First(&user).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return models.NotFound("Owner not found: " + uid)
+			}
+			return models.InternalServerError(err.Error())
+		}
+		if err := addOwner(tx, group, user.ID, user.UID); err != nil {
+			return models.InternalServerError("Failed to add group owner: " + err.Error())
+		}
+	}
+	return nil
+}
+
+// removeOwner takes the ownership of the group away from the user and audits
+// it.
+func removeOwner(tx *gorm.DB, group *models.Group, userID int, userUID uuid.UUID) error {
+	if err := tx.Where("group_id = ? AND user_id = ?", group.ID, userID).
+		Delete(&models.GroupOwner{}).Error; err != nil {
+		return err
+	}
+	return recordAudit(tx, models.AuditGroupOwnerRemoved, models.AuditTargetGroup, group.UID, map[string]any{
+		"user_id": userUID.String(),
+	})
+}
+
+// ownedGroups locks and returns every group, deleted or not, the user owns.
+func ownedGroups(tx *gorm.DB, userID int) ([]models.Group, error) {
+	var groups []models.Group
+	err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN (SELECT group_id FROM group_owners WHERE user_id = ?)", userID).
+		Order("id").
+		Find(&groups).Error
+	return groups, err
+}
+
+// releaseOwnerships takes every ownership away from a user that is about to be
+// deleted and returns the groups they owned. A user who is the only owner of a
+// group cannot be deleted until someone else owns it.
+func releaseOwnerships(tx *gorm.DB, user *models.User) ([]int, *models.ErrorResponse) {
+	groups, err := ownedGroups(tx, user.ID)
+	if err != nil {
+		return nil, models.InternalServerError("Failed to read owned groups: " + err.Error())
+	}
+
+	var groupIDs []int
+	for i := range groups {
+		group := &groups[i]
+		if !group.DeletedAt.Valid {
+			var others int64
+			if err := tx.Model(&models.GroupOwner{}).
+				Where("group_id = ? AND user_id <> ?", group.ID, user.ID).
+				Count(&others).Error; err != nil {
+				return nil, models.InternalServerError(err.Error())
+			}
+			if others == 0 {
+				return nil, models.Conflict("User is the only owner of group " + group.Name)
+			}
+		}
+
+		if err := removeOwner(tx, group, user.ID, user.UID); err != nil {
+			return nil, models.InternalServerError("Failed to release group ownership: " + err.Error())
+		}
+		groupIDs = append(groupIDs, group.ID)
+	}
+	return groupIDs, nil
+}
+
+// restoreOwnerships gives a restored user back the groups they owned.
+func restoreOwnerships(tx *gorm.DB, user *models.User, snapshot *models.TrashSnapshot) error {
+	if snapshot == nil || len(snapshot.OwnedGroupIDs) == 0 {
+		return nil
+	}
+
+	var groups []models.Group
+	if err := tx.Unscoped().Where("id IN ?", snapshot.OwnedGroupIDs).Find(&groups).Error; err != nil {
+		return err
+	}
+	for i := range groups {
+		if err := addOwner(tx, &groups[i], user.ID, user.UID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transferOwnerships hands the groups the source owns over to the target.
+func transferOwnerships(tx *gorm.DB, source *models.User, target *models.User) error {
+	groups, err := ownedGroups(tx, source.ID)
+	if err != nil {
+		return err
+	}
+	for i := range groups {
+		if err := addOwner(tx, &groups[i], target.ID, target.UID); err != nil {
+			return err
+		}
+		if err := removeOwner(tx, &groups[i], source.ID, source.UID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *groupRepository) GetGroupOwners(id string, ctx *gin.Context) ([]dtos.UserResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var group models.Group
+	if err := db.WithContext(ctx).Where("uid = ?", id).First(&group).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, models.NotFound("Group not found")
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	owners := []dtos.UserResponse{}
+	if err := db.WithContext(ctx).Table("users").
+		Select("users.uid::text AS uid, users.name, users.email, users.status").
+		Joins("JOIN group_owners ON group_owners.user_id = users.id").
+		Where("group_owners.group_id = ? AND users.deleted_at IS NULL", group.ID).
+		Order("group_owners.created_at, users.id").
+		Scan(&owners).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return owners, nil
+}
+
+func (r *groupRepository) AddGroupOwner(id string, userUID string, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		group, errResp := lockGroup(tx, id)
+		if errResp != nil {
+			return errResp
+		}
+		if errResp := addOwners(tx, group, []string{userUID}); errResp != nil {
+			return errResp
+		}
+		return nil
+	}); err != nil {
+		return toErrorResponse(err)
+	}
+
+	return nil
+}
+
+// RemoveGroupOwner takes the ownership of the group away from the user. The
+// last owner of a group cannot be removed.
+func (r *groupRepository) RemoveGroupOwner(id string, userUID string, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		group, errResp := lockGroup(tx, id)
+		if errResp != nil {
+			return errResp
+		}
+
+		var user models.User
+		if err := tx.Select("id", "uid").Where("uid = ?", userUID).First(&user).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return models.NotFound("User not found")
+			}
+			return models.InternalServerError(err.Error())
+		}
+
+		var owners []int
+		if err := tx.Model(&models.GroupOwner{}).
+			Where("group_id = ?", group.ID).
+			Pluck("user_id", &owners).Error; err != nil {
+			return models.InternalServerError(err.Error())
+		}
+
+		isOwner := false
+		for _, ownerID := range owners {
+			if ownerID == user.ID {
+				isOwner = true
+				break
+			}
+		}
+		if !isOwner {
+			return models.NotFound("User is not an owner of the group")
+		}
+		if len(owners) == 1 {
+			return models.Conflict("A group must keep at least one owner")
+		}
+
+		if err := removeOwner(tx, group, user.ID, user.UID); err != nil {
+			return models.InternalServerError("Failed to remove group owner: " + err.Error())
+		}
+		return nil
+	}); err != nil {
+		return toErrorResponse(err)
+	}
+
+	return nil
+}
+
+// actingUser loads the user an actor acts for, or nil when the user no longer
+// exists, is not active, or changed their credentials after the token was
+// issued.
+func actingUser(db *gorm.DB, actor models.Actor) (*models.User, error) {
+	var user models.User
+	if err := db.Select("id", "uid", "status", "credentials_changed_at").
+		Where("uid = ?", actor.UserUID).
+		First(&user).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !user.Status.GrantsAccess() {
+		return nil, nil
+	}
+	if user.CredentialsChangedAt != nil && user.CredentialsChangedAt.After(actor.IssuedAt) {
+		return nil, nil
+	}
+	return &user, nil
+}
+
+// IsGroupOwner reports whether the actor owns the group. Only active users
+// whose credentials did not change since their token was issued count.
+func (r *groupRepository) IsGroupOwner(id string, actor models.Actor, ctx *gin.Context) (bool, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return false, models.InternalServerError(err.Error())
+	}
+
+	user, err := actingUser(db.WithContext(ctx), actor)
+	if err != nil {
+		return false, models.InternalServerError(err.Error())
+	}
+	if user == nil {
+		return false, nil
+	}
+
+	var count int64
+	if err := db.WithContext(ctx).Model(&models.GroupOwner{}).
+		Joins("JOIN groups ON groups.id = group_owners.group_id").
+		Where("groups.uid = ? AND groups.deleted_at IS NULL AND group_owners.user_id = ?", id, user.ID).
+		Count(&count).Error; err != nil {
+		return false, models.InternalServerError(err.Error())
+	}
+
+	return count > 0, nil
+}
+
+// dynamicMembersError refuses to change the members of a dynamic group by
+// hand.
+func dynamicMembersError(group *models.Group) *models.ErrorResponse {
+	return models.Conflict("The members of dynamic group " + group.Name + " are managed by its rule")
+}
+
+// AddGroupMember puts the user in the group, or changes the validity period of
+// the membership they already have.
+func (r *groupRepository) AddGroupMember(id string, userUID string, req dtos.GroupMemberRequest, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	var group models.Group
+	if err := db.WithContext(ctx).Where("uid = ?", id).First(&group).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.NotFound("Group not found")
+		}
+		return models.InternalServerError(err.Error())
+	}
+	if group.IsDynamic() {
+		return dynamicMembersError(&group)
+	}
+
+	userID, errResp := findUserID(db.WithContext(ctx), userUID)
+	if errResp != nil {
+		return errResp
+	}
+
+	if err := db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "users_id"}, {Name: "groups_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"valid_from", "valid_until"}),
+	}).Create(&models.GroupMembership{
+		UsersId:    userID,
+		GroupsId:   group.ID,
+		ValidFrom:  req.ValidFrom,
+		ValidUntil: req.ValidUntil,
+	}).Error; err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+func (r *groupRepository) RemoveGroupMember(id string, userUID string, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	var group models.Group
+	if err := db.WithContext(ctx).Where("uid = ?", id).First(&group).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.NotFound("Group not found")
+		}
+		return models.InternalServerError(err.Error())
+	}
+	if group.IsDynamic() {
+		return dynamicMembersError(&group)
+	}
+
+	userID, errResp := findUserID(db.WithContext(ctx), userUID)
+	if errResp != nil {
+		return errResp
+	}
+
+	res := db.WithContext(ctx).
+		Where("users_id = ? AND groups_id = ?", userID, group.ID).
+		Delete(&models.GroupMembership{})
+	if res.Error != nil {
+		return models.InternalServerError(res.Error.Error())
+	}
+	if res.RowsAffected == 0 {
+		return models.NotFound("User is not a member of the group")
+	}
+
+	return nil
+}
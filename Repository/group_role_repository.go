@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// inheritedRolesQuery lists the roles bound to the groups a user is a member
+// of, directly or through a nested group. A role bound to several of them is
+// listed once per group, with the longest membership that grants it.
+const inheritedRolesQuery = supergroupsQuery + `
+SELECT uid, name, rights, group_uid, group_name, assigned_at, valid_until FROM (
+	SELECT DISTINCT ON (g.id, r.id) g.id AS group_id, r.id AS role_id, r.uid::text AS uid, r.name, r.rights,
+	       g.uid::text AS group_uid, g.name AS group_name, group_roles.created_at AS assigned_at, s.valid_until
+	FROM supergroups s
+	JOIN groups g ON g.id = s.id AND g.deleted_at IS NULL
+	JOIN groups v ON v.id = s.via AND v.deleted_at IS NULL
+	JOIN group_roles ON group_roles.group_id = g.id
+	JOIN roles r ON r.id = group_roles.role_id AND r.deleted_at IS NULL
+	ORDER BY g.id, r.id, s.valid_until DESC NULLS FIRST
+) inherited
+ORDER BY assigned_at, group_id, role_id`
+
+// grantsRolesQuery reports whether roles are bound to a group or to any group
+// it is nested in, which its members would all hold.
+const grantsRolesQuery = `
+WITH RECURSIVE ancestors AS (
+	SELECT g.id, g.parent_id, 0 AS depth FROM groups g WHERE g.uid = ? AND g.deleted_at IS NULL
+	UNION ALL
+	SELECT g.id, g.parent_id, a.depth + 1 FROM groups g
+	JOIN ancestors a ON g.id = a.parent_id
+	WHERE g.deleted_at IS NULL AND a.depth < ?
+)
+SELECT EXISTS (
+	SELECT 1 FROM ancestors
+	JOIN group_roles ON group_roles.group_id = ancestors.id
+	JOIN roles ON roles.id = group_roles.role_id AND roles.deleted_at IS NULL
+)`
+
+// inheritedRoleRow is a role a user holds through a group.
+type inheritedRoleRow struct {
+	UID        string
+	Name       string
+	Rights     json.RawMessage
+	GroupUID   string
+	GroupName  string
+	AssignedAt time.Time
+	ValidUntil *time.Time
+}
+
+// inheritedRoles lists the roles a user holds through the groups they are a
+// member of.
+func inheritedRoles(db *gorm.DB, userID int) ([]dtos.UserRoleResponse, error) {
+	var rows []inheritedRoleRow
+	if err := db.Raw(inheritedRolesQuery, userID, maxHierarchyDepth).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	roles := []dtos.UserRoleResponse{}
+	for _, row := range rows {
+		roles = append(roles, dtos.UserRoleResponse{
+			UID:        row.UID,
+			Name:       row.Name,
+			Rights:     row.Rights,
+			Active:     true,
+			ExpiresAt:  row.ValidUntil,
+			AssignedAt: row.AssignedAt,
+			Source:     models.RoleSourceGroup,
+			Group:      &dtos.GroupReference{UID: row.GroupUID, Name: row.GroupName},
+		})
+	}
+	return roles, nil
+}
+
+// effectiveRoles lists the roles of a user loaded with loadRoles followed by
+// the roles they hold through their groups.
+func effectiveRoles(db *gorm.DB, user *models.User) ([]dtos.UserRoleResponse, error) {
+	inherited, err := inheritedRoles(db, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	return append(userRoles(user), inherited...), nil
+}
+
+// detachGroupRoles removes the role bindings whose column (group_id or
+// role_id) is id and returns the ids on the other side.
+func detachGroupRoles(tx *gorm.DB, column string, id int) ([]int, error) {
+	other := "role_id"
+	if column == "role_id" {
+		other = "group_id"
+	}
+
+	var ids []int
+	if err := tx.Model(&models.GroupRole{}).Where(column+" = ?", id).Order(other).
+		Pluck(other, &ids).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Where(column+" = ?", id).Delete(&models.GroupRole{}).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// restoreGroupRoles binds the roles to the groups again, skipping the groups
+// and roles that are gone.
+func restoreGroupRoles(tx *gorm.DB, groupIDs []int, roleIDs []int) error {
+	if len(groupIDs) == 0 || len(roleIDs) == 0 {
+		return nil
+	}
+	return tx.Exec(`INSERT INTO group_roles (group_id, role_id, created_at)
+		SELECT groups.id, roles.id, now() FROM groups CROSS JOIN roles
+		WHERE groups.id IN ? AND groups.deleted_at IS NULL AND roles.id IN ? AND roles.deleted_at IS NULL
+		ON CONFLICT DO NOTHING`, groupIDs, roleIDs).Error
+}
+
+func (r *groupRepository) GetGroupRoles(id string, ctx *gin.Context) ([]dtos.RoleResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var group models.Group
+	if err := db.WithContext(ctx).Where("uid = ?", id).First(&group).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, models.NotFound("Group not found")
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	roles := []dtos.RoleResponse{}
+	if err := db.WithContext(ctx).Table("roles").
+		Select("roles.uid::text AS uid, roles.name, roles.rights").
+		Joins("JOIN group_roles ON group_roles.role_id = roles.id").
+		Where("group_roles.group_id = ? AND roles.deleted_at IS NULL", group.ID).
+		Order("group_roles.created_at, roles.id").
+		Scan(&roles).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return roles, nil
+}
+
+// AddGroupRole binds the role to the group. Binding it again changes nothing.
+func (r *groupRepository) AddGroupRole(id string, roleUID string, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		group, errResp := lockGroup(tx, id)
+		if errResp != nil {
+			return errResp
+		}
+		role, errResp := findRole(tx, roleUID)
+		if errResp != nil {
+			return errResp
+		}
+
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.GroupRole{GroupID: group.ID, RoleID: role.ID})
+		if res.Error != nil {
+			return models.InternalServerError("Failed to bind role: " + res.Error.Error())
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		if err := recordAudit(tx, models.AuditGroupRoleAdded, models.AuditTargetGroup, group.UID, map[string]any{
+			"role_id":   role.UID.String(),
+			"role_name": role.Name,
+		}); err != nil {
+			return models.InternalServerError(err.Error())
+		}
+		return nil
+	}); err != nil {
+		return toErrorResponse(err)
+	}
+
+	return nil
+}
+
+func (r *groupRepository) RemoveGroupRole(id string, roleUID string, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		group, errResp := lockGroup(tx, id)
+		if errResp != nil {
+			return errResp
+		}
+		role, errResp := findRole(tx, roleUID)
+		if errResp != nil {
+			return errResp
+		}
+
+		res := tx.Where("group_id = ? AND role_id = ?", group.ID, role.ID).Delete(&models.GroupRole{})
+		if res.Error != nil {
+			return models.InternalServerError("Failed to unbind role: " + res.Error.Error())
+		}
+		if res.RowsAffected == 0 {
+			return models.NotFound("Role is not bound to the group")
+		}
+		if err := recordAudit(tx, models.AuditGroupRoleRemoved, models.AuditTargetGroup, group.UID, map[string]any{
+			"role_id":   role.UID.String(),
+			"role_name": role.Name,
+		}); err != nil {
+			return models.InternalServerError(err.Error())
+		}
+		return nil
+	}); err != nil {
+		return toErrorResponse(err)
+	}
+
+	return nil
+}
+
+// GroupGrantsRoles reports whether members of the group hold roles because of
+// it, through a role bound to the group or to a group it is nested in.
+func (r *groupRepository) GroupGrantsRoles(id string, ctx *gin.Context) (bool, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return false, models.InternalServerError(err.Error())
+	}
+
+	var grants bool
+	if err := db.WithContext(ctx).Raw(grantsRolesQuery, id, maxHierarchyDepth).Scan(&grants).Error; err != nil {
+		return false, models.InternalServerError(err.Error())
+	}
+	return grants, nil
+}
@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// ruleFields maps the fields of a group rule to a condition on the users
+// table, completed with the comparison of the rule.
+var ruleFields = map[string]string{
+	models.GroupRuleStatus:      `users.status %s`,
+	models.GroupRuleEmailDomain: `split_part(users.email_normalized, '@', 2) %s`,
+	models.GroupRuleRole: `EXISTS (SELECT 1 FROM roles WHERE roles.deleted_at IS NULL AND roles.uid %s AND (
+		EXISTS (SELECT 1 FROM user_roles WHERE user_roles.user_id = users.id AND user_roles.role_id = roles.id
+			AND (user_roles.starts_at IS NULL OR user_roles.starts_at <= now())
+			AND (user_roles.expires_at IS NULL OR user_roles.expires_at > now()))
+		OR roles.id IN (` + heldThroughGroups + `)))`,
+	models.GroupRuleManager: `EXISTS (SELECT 1 FROM users managers
+		WHERE managers.id = users.manager_id AND managers.deleted_at IS NULL AND managers.uid %s)`,
+}
+
+// heldThroughGroups lists the ids of the roles bound to the groups the user of
+// the outer query is a member of now, directly or through a nested group.
+var heldThroughGroups = `WITH RECURSIVE supergroups AS (
+	SELECT groups_users_maps.groups_id AS id, 0 AS depth FROM groups_users_maps
+	WHERE groups_users_maps.users_id = users.id AND ` + activeMembership + `
+	UNION ALL
+	SELECT g.parent_id, s.depth + 1 FROM groups g
+	JOIN supergroups s ON g.id = s.id
+	WHERE g.parent_id IS NOT NULL AND g.deleted_at IS NULL AND s.depth < ` + strconv.Itoa(maxHierarchyDepth) + `
+)
+SELECT group_roles.role_id FROM supergroups
+JOIN groups g ON g.id = supergroups.id AND g.deleted_at IS NULL
+JOIN group_roles ON group_roles.group_id = g.id`
+
+// ruleCondition turns a validated rule into a condition on the users table.
+// A field that is missing, such as an unset attribute, never equals a value.
+func ruleCondition(rule *models.GroupRule) (string, []any) {
+	switch {
+	case len(rule.All) > 0:
+		return joinRules(rule.All, " AND ")
+	case len(rule.Any) > 0:
+		return joinRules(rule.Any, " OR ")
+	case rule.Not != nil:
+		condition, args := ruleCondition(rule.Not)
+		return "NOT " + condition, args
+	}
+
+	var args []any
+	field, ok := ruleFields[rule.Field]
+	if key, isAttribute := rule.AttributeKey(); !ok && isAttribute {
+		field = `users.attributes ->> ? %s`
+		args = append(args, key)
+	}
+
+	values := append([]string{}, rule.Values...)
+	if rule.Op != models.GroupRuleIn {
+		values = []string{rule.Value}
+	}
+	if rule.Field == models.GroupRuleEmailDomain {
+		for i := range values {
+			values[i] = strings.ToLower(values[i])
+		}
+	}
+
+	condition := "COALESCE(" + fmt.Sprintf(field, "IN ?") + ", false)"
+	args = append(args, values)
+	if rule.Op == models.GroupRuleNe {
+		condition = "NOT " + condition
+	}
+	return condition, args
+}
+
+func joinRules(rules []models.GroupRule, separator string) (string, []any) {
+	var conditions []string
+	var args []any
+	for i := range rules {
+		condition, ruleArgs := ruleCondition(&rules[i])
+		conditions = append(conditions, condition)
+		args = append(args, ruleArgs...)
+	}
+	return "(" + strings.Join(conditions, separator) + ")", args
+}
+
+// groupRule reads the rule of a dynamic group.
+func groupRule(group *models.Group) (*models.GroupRule, error) {
+	var rule models.GroupRule
+	if err := json.Unmarshal(group.Rule, &rule); err != nil {
+		return nil, fmt.Errorf("invalid rule of group %s: %w", group.Name, err)
+	}
+	return &rule, nil
+}
+
+// evaluateGroup makes the members of a dynamic group the users matching its
+// rule. With userIDs only those users are evaluated, otherwise every user.
+func evaluateGroup(tx *gorm.DB, group *models.Group, userIDs []int) error {
+	rule, err := groupRule(group)
+	if err != nil {
+		return err
+	}
+	condition, args := ruleCondition(rule)
+
+	matching := `SELECT users.id FROM users WHERE users.deleted_at IS NULL AND ` + condition
+	scope := "TRUE"
+	var scopeArgs []any
+	if userIDs != nil {
+		matching += ` AND users.id IN ?`
+		args = append(args, userIDs)
+		scope = "groups_users_maps.users_id IN ?"
+		scopeArgs = append(scopeArgs, userIDs)
+	}
+
+	// Dynamic memberships are never limited to a period
+	if err := tx.Exec(`INSERT INTO groups_users_maps (users_id, groups_id)
+		SELECT matching.id, ? FROM (`+matching+`) matching
+		ON CONFLICT (users_id, groups_id) DO UPDATE SET valid_from = NULL, valid_until = NULL`,
+		append([]any{group.ID}, args...)...).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec(`DELETE FROM groups_users_maps
+		WHERE groups_id = ? AND `+scope+` AND users_id NOT IN (`+matching+`)`,
+		append(append([]any{group.ID}, scopeArgs...), args...)...).Error
+}
+
+// assignRule makes the group dynamic with the rule and replaces its members
+// with the users matching it, or makes it static again for a null rule.
+func assignRule(tx *gorm.DB, group *models.Group, rule json.RawMessage) *models.ErrorResponse {
+	if string(rule) == "null" {
+		group.Type = models.GroupTypeStatic
+		group.Rule = nil
+	} else {
+		group.Type = models.GroupTypeDynamic
+		group.Rule = rule
+	}
+
+	if err := tx.Model(group).Updates(map[string]any{"type": group.Type, "rule": group.Rule}).Error; err != nil {
+		return models.InternalServerError(err.Error())
+	}
+	if group.IsDynamic() {
+		if err := evaluateGroup(tx, group, nil); err != nil {
+			return models.InternalServerError("Failed to evaluate group rule: " + err.Error())
+		}
+	}
+	return nil
+}
+
+// syncDynamicGroups re-evaluates every dynamic group for the given users after
+// they changed.
+func syncDynamicGroups(tx *gorm.DB, userIDs ...int) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return evaluateDynamicGroups(tx, userIDs)
+}
+
+// evaluateDynamicGroups re-evaluates every dynamic group, for the given users
+// or, when userIDs is nil, for every user.
+func evaluateDynamicGroups(tx *gorm.DB, userIDs []int) error {
+	var groups []models.Group
+	if err := tx.Where("type = ?", models.GroupTypeDynamic).Order("id").Find(&groups).Error; err != nil {
+		return err
+	}
+	for i := range groups {
+		if err := evaluateGroup(tx, &groups[i], userIDs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PreviewGroupRule lists the users the rule would make members of a group.
+func (r *groupRepository) PreviewGroupRule(rule *models.GroupRule, ctx *gin.Context) (*dtos.GroupRulePreview, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	condition, args := ruleCondition(rule)
+	users := []dtos.UserResponse{}
+	if err := db.WithContext(ctx).Table("users").
+		Select("users.uid::text AS uid, users.name, users.email, users.status").
+		Where("users.deleted_at IS NULL").
+		Where(condition, args...).
+		Order("users.id").
+		Scan(&users).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return &dtos.GroupRulePreview{Count: len(users), Users: users}, nil
+}
+
+// EvaluateDynamicGroups re-evaluates every dynamic group for every user.
+func (r *groupRepository) EvaluateDynamicGroups(ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return evaluateDynamicGroups(tx, nil)
+	}); err != nil {
+		return models.InternalServerError("Failed to evaluate dynamic groups: " + err.Error())
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	dtos "github.com/google-run-code/Domain/Dtos"
 	interfaces "github.com/google-run-code/Domain/Interfaces"
@@ -22,9 +23,14 @@ func NewGroupRepository(dbConfig *config.PostgresConfig) interfaces.GroupReposit
 }
 
 func (r *groupRepository) getDB(ctx *gin.Context) (*gorm.DB, error) {
-	dbName := ctx.GetString("dbName")
-	db, ok := r.dbConfig.GetDB(dbName)
-	if ok != nil {
+	value, _ := ctx.Get(models.TenantContextKey)
+	tenant, ok := value.(*models.Tenant)
+	if !ok {
+		return nil, models.InternalServerError("Tenant missing from request context")
+	}
+
+	db, err := r.dbConfig.GetDB(tenant.Name)
+	if err != nil {
 		return nil, models.InternalServerError("Failed to get database connection")
 	}
 
@@ -39,7 +45,7 @@ func (r *groupRepository) GetAllGroups(ctx *gin.Context) ([]*dtos.GroupResponse,
 		return nil, models.InternalServerError(err.Error())
 	}
 
-	if err := db.WithContext(ctx).Preload("Users").Find(&groups).Error; err != nil {
+	if err := db.WithContext(ctx).Preload("Parent").Find(&groups).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return []*dtos.GroupResponse{}, nil
 		}
@@ -49,11 +55,7 @@ func (r *groupRepository) GetAllGroups(ctx *gin.Context) ([]*dtos.GroupResponse,
 	var result []*dtos.GroupResponse
 
 	for _, group := range groups {
-		result = append(result, &dtos.GroupResponse{
-			UID:  group.UID.String(),
-			Name: group.Name,
-		})
-
+		result = append(result, groupResponse(group))
 	}
 	return result, nil
 }
@@ -66,7 +68,7 @@ func (r *groupRepository) GetGroupById(UID string, ctx *gin.Context) (*dtos.Grou
 		return nil, models.InternalServerError(err.Error())
 	}
 
-	if err := db.WithContext(ctx).Preload("Users").
+	if err := db.WithContext(ctx).Preload("Parent").
 		Where("uid = ?", UID).
 		First(&group).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -75,11 +77,7 @@ func (r *groupRepository) GetGroupById(UID string, ctx *gin.Context) (*dtos.Grou
 		return nil, models.InternalServerError(err.Error())
 	}
 
-	result := dtos.GroupResponse{
-		UID:  group.UID.String(),
-		Name: group.Name,
-	}
-	return &result, nil
+	return groupResponse(&group), nil
 }
 
 func (r *groupRepository) GetGroupUsers(UID string, ctx *gin.Context) ([]dtos.UserResponse, *models.ErrorResponse) {
@@ -91,7 +89,6 @@ func (r *groupRepository) GetGroupUsers(UID string, ctx *gin.Context) ([]dtos.Us
 	}
 
 	if err := db.WithContext(ctx).
-		Preload("Users").
 		Where("uid = ?", UID).
 		First(&group).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -100,15 +97,9 @@ func (r *groupRepository) GetGroupUsers(UID string, ctx *gin.Context) ([]dtos.Us
 		return nil, models.InternalServerError(err.Error())
 	}
 
-	var users []dtos.UserResponse
-
-	for _, user := range group.Users {
-		users = append(users, dtos.UserResponse{
-			UID:    user.UID.String(),
-			Name:   user.Name,
-			Email:  user.Email,
-			Status: user.Status,
-		})
+	users, err := groupMembers(db.WithContext(ctx), group.ID)
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
 	}
 
 	return users, nil
@@ -122,18 +113,14 @@ func (r *groupRepository) GetGroupByName(name string, ctx *gin.Context) (*dtos.G
 		return nil, models.InternalServerError(err.Error())
 	}
 
-	if err := db.WithContext(ctx).Where("name = ?", name).Preload("Users").First(&group).Error; err != nil {
+	if err := db.WithContext(ctx).Where("name = ?", name).Preload("Parent").First(&group).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, models.NotFound("Group not found")
 		}
 		return nil, models.InternalServerError(err.Error())
 	}
 
-	result := dtos.GroupResponse{
-		UID:  group.UID.String(),
-		Name: group.Name,
-	}
-	return &result, nil
+	return groupResponse(&group), nil
 }
 
 func (r *groupRepository) CreateGroup(group dtos.GroupCreateRequest, ctx *gin.Context) (*dtos.GroupResponse, *models.ErrorResponse) {
@@ -146,14 +133,41 @@ func (r *groupRepository) CreateGroup(group dtos.GroupCreateRequest, ctx *gin.Co
 	newGroup := models.Group{
 		UID:  uuid.New(),
 		Name: group.Name,
+		Type: models.GroupTypeStatic,
 	}
-	if err := db.WithContext(ctx).Create(&newGroup).Error; err != nil {
-		return nil, models.InternalServerError(err.Error())
+	if len(group.Rule) > 0 {
+		newGroup.Type = models.GroupTypeDynamic
+		newGroup.Rule = group.Rule
+	}
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if group.ParentId != "" {
+			var parent models.Group
+			if err := tx.Where("uid = ?", group.ParentId).First(&parent).Error; err != nil {
+				if err == gorm.ErrRecordNotFound {
+					return models.NotFound("Parent group not found")
+				}
+				return models.InternalServerError(err.Error())
+			}
+			newGroup.ParentID = &parent.ID
+			newGroup.Parent = &parent
+		}
+
+		if err := tx.Omit(clause.Associations).Create(&newGroup).Error; err != nil {
+			return models.InternalServerError(err.Error())
+		}
+		if errResp := addOwners(tx, &newGroup, group.OwnerIds); errResp != nil {
+			return errResp
+		}
+		if newGroup.IsDynamic() {
+			if err := evaluateGroup(tx, &newGroup, nil); err != nil {
+				return models.InternalServerError("Failed to evaluate group rule: " + err.Error())
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, toErrorResponse(err)
 	}
-	return &dtos.GroupResponse{
-		UID:  newGroup.UID.String(),
-		Name: newGroup.Name,
-	}, nil
+	return groupResponse(&newGroup), nil
 }
 
 func (r *groupRepository) UpdateGroup(UID string, group dtos.GroupUpdateRequest, ctx *gin.Context) (*dtos.GroupResponse, *models.ErrorResponse) {
@@ -164,20 +178,38 @@ func (r *groupRepository) UpdateGroup(UID string, group dtos.GroupUpdateRequest,
 		return nil, models.InternalServerError(err.Error())
 	}
 
-	if err := db.WithContext(ctx).
-		Where("uid = ?", UID).
-		First(&existingGroup).Error; err != nil {
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Preload("Parent").
+			Where("uid = ?", UID).
+			First(&existingGroup).Error; err != nil {
 
-		return nil, models.InternalServerError(err.Error())
-	}
+			return models.InternalServerError(err.Error())
+		}
 
-	existingGroup.Name = group.Name
+		if group.Name != "" {
+			existingGroup.Name = group.Name
+			if err := tx.Model(&existingGroup).Update("name", existingGroup.Name).Error; err != nil {
+				return models.InternalServerError(err.Error())
+			}
+		}
 
-	if err := db.WithContext(ctx).Save(&existingGroup).Error; err != nil {
-		return nil, models.InternalServerError(err.Error())
+		if group.ParentId != nil {
+			if errResp := assignParent(tx, &existingGroup, *group.ParentId); errResp != nil {
+				return errResp
+			}
+		}
+
+		if group.Rule != nil {
+			if errResp := assignRule(tx, &existingGroup, group.Rule); errResp != nil {
+				return errResp
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, toErrorResponse(err)
 	}
 
-	return &dtos.GroupResponse{UID: existingGroup.UID.String(), Name: existingGroup.Name}, nil
+	return groupResponse(&existingGroup), nil
 }
 func (r *groupRepository) DeleteGroup(id string, ctx *gin.Context) *models.ErrorResponse {
 	// Parse the UUID from the string
@@ -203,17 +235,113 @@ func (r *groupRepository) DeleteGroup(id string, ctx *gin.Context) *models.Error
 		return models.InternalServerError("Failed to fetch group: " + err.Error())
 	}
 
-	// Dissociate users from the group
-	if err := db.WithContext(ctx).Model(&group).Association("Users").Clear(); err != nil {
-		return models.InternalServerError("Failed to clear group associations: " + err.Error())
-	}
+	var snapshot models.TrashSnapshot
 
-	// Delete the group
-	if err := db.WithContext(ctx).
-		Where("uid = ?", UID). // Use uid for deletion
-		Delete(&group).Error; err != nil {
-		return models.InternalServerError("Failed to delete group: " + err.Error())
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Dissociate users from the group
+		memberships, err := detachMemberships(tx, "groups_id", group.ID)
+		if err != nil {
+			return models.InternalServerError("Failed to clear group associations: " + err.Error())
+		}
+		snapshot.Memberships = memberships
+
+		// Nested groups become top-level rather than disappear with the group
+		childIDs, err := detachChildren(tx, group.ID)
+		if err != nil {
+			return models.InternalServerError("Failed to detach nested groups: " + err.Error())
+		}
+		snapshot.ChildIDs = childIDs
+
+		// Members stop holding the roles bound to the group
+		roleIDs, err := detachGroupRoles(tx, "group_id", group.ID)
+		if err != nil {
+			return models.InternalServerError("Failed to unbind group roles: " + err.Error())
+		}
+		snapshot.RoleIDs = roleIDs
+		if group.ParentID != nil {
+			parentID := *group.ParentID
+			snapshot.ParentID = &parentID
+			if err := tx.Model(&group).Update("parent_id", nil).Error; err != nil {
+				return models.InternalServerError("Failed to detach group from its parent: " + err.Error())
+			}
+		}
+
+		if err := moveToTrash(tx, models.TrashTypeGroup, group.ID, group.UID, group.Name, snapshot); err != nil {
+			return models.InternalServerError("Failed to record deleted group: " + err.Error())
+		}
+
+		// Soft delete the group
+		if err := tx.Where("uid = ?", UID).Delete(&models.Group{}).Error; err != nil {
+			return models.InternalServerError("Failed to delete group: " + err.Error())
+		}
+		return nil
+	}); err != nil {
+		return toErrorResponse(err)
 	}
 
 	return nil
 }
+
+func (r *groupRepository) RestoreGroup(id string, ctx *gin.Context) (*dtos.GroupResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var group models.Group
+	if err := db.WithContext(ctx).Unscoped().
+		Where("uid = ? AND deleted_at IS NOT NULL", id).
+		First(&group).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, models.NotFound("Deleted group not found")
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var count int64
+	if err := db.WithContext(ctx).Model(&models.Group{}).
+		Where("name = ?", group.Name).
+		Count(&count).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+	if count > 0 {
+		return nil, models.Conflict("Another group with this name already exists")
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		snapshot, err := takeFromTrash(tx, models.TrashTypeGroup, group.ID)
+		if err != nil {
+			return models.InternalServerError("Failed to read deleted group snapshot: " + err.Error())
+		}
+
+		if err := tx.Unscoped().Model(&group).Update("deleted_at", nil).Error; err != nil {
+			return models.InternalServerError("Failed to restore group: " + err.Error())
+		}
+
+		// Only members that still exist are restored
+		if err := restoreMemberships(tx, snapshotMemberships(snapshot, 0, group.ID)); err != nil {
+			return models.InternalServerError("Failed to restore group members: " + err.Error())
+		}
+
+		if err := restoreNesting(tx, &group, snapshot); err != nil {
+			return models.InternalServerError("Failed to restore nested groups: " + err.Error())
+		}
+
+		if err := restoreGroupRoles(tx, []int{group.ID}, snapshot.RoleIDs); err != nil {
+			return models.InternalServerError("Failed to restore group roles: " + err.Error())
+		}
+
+		// Users may have changed while the group was in the trash
+		if group.IsDynamic() {
+			if err := evaluateGroup(tx, &group, nil); err != nil {
+				return models.InternalServerError("Failed to evaluate group rule: " + err.Error())
+			}
+		}
+		return tx.Preload("Parent").First(&group, group.ID).Error
+	}); err != nil {
+		return nil, toErrorResponse(err)
+	}
+
+	return groupResponse(&group), nil
+}
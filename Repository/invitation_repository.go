@@ -0,0 +1,263 @@
+package repository
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/google-run-code/config"
+)
+
+type invitationRepository struct {
+	dbConfig *config.PostgresConfig
+}
+
+func NewInvitationRepository(dbConfig *config.PostgresConfig) interfaces.InvitationRepository {
+	return &invitationRepository{
+		dbConfig: dbConfig,
+	}
+}
+
+func (r *invitationRepository) getDB(ctx *gin.Context) (*gorm.DB, error) {
+	value, _ := ctx.Get(models.TenantContextKey)
+	tenant, ok := value.(*models.Tenant)
+	if !ok {
+		return nil, models.InternalServerError("Tenant missing from request context")
+	}
+
+	db, err := r.dbConfig.GetDB(tenant.Name)
+	if err != nil {
+		return nil, models.InternalServerError("Failed to get database connection")
+	}
+
+	return db, nil
+}
+
+func (r *invitationRepository) GetInvitations(ctx *gin.Context) ([]*models.Invitation, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var invitations []*models.Invitation
+	if err := db.WithContext(ctx).Preload("User").Order("created_at DESC").Find(&invitations).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return invitations, nil
+}
+
+func (r *invitationRepository) findInvitation(ctx *gin.Context, query string, value string) (*models.Invitation, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var invitation models.Invitation
+	if err := db.WithContext(ctx).Preload("User").Where(query, value).First(&invitation).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, models.NotFound("Invitation not found")
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return &invitation, nil
+}
+
+func (r *invitationRepository) GetInvitationById(uid string, ctx *gin.Context) (*models.Invitation, *models.ErrorResponse) {
+	return r.findInvitation(ctx, "uid = ?", uid)
+}
+
+func (r *invitationRepository) GetInvitationByTokenHash(tokenHash string, ctx *gin.Context) (*models.Invitation, *models.ErrorResponse) {
+	return r.findInvitation(ctx, "token_hash = ?", tokenHash)
+}
+
+// CreateInvitation creates the invited user, puts them in the groups and
+// stores their invitation in one transaction. Groups that do not exist are
+// skipped, but at least one of them must.
+func (r *invitationRepository) CreateInvitation(user dtos.UserCreateRequest, groupUIDs []string, tokenHash string, expiresAt time.Time, ctx *gin.Context) (*models.Invitation, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var invitation models.Invitation
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		newUser, errResp := createUser(tx, user)
+		if errResp != nil {
+			return errResp
+		}
+
+		if len(groupUIDs) > 0 {
+			var groups []models.Group
+			if err := tx.Where("uid IN ?", groupUIDs).Order("id").Find(&groups).Error; err != nil {
+				return models.InternalServerError(err.Error())
+			}
+			if len(groups) == 0 {
+				return models.NotFound("None of the specified groups were found")
+			}
+			var memberships []models.GroupMembership
+			for i := range groups {
+				if groups[i].IsDynamic() {
+					return dynamicMembersError(&groups[i])
+				}
+				memberships = append(memberships, models.GroupMembership{UsersId: newUser.ID, GroupsId: groups[i].ID})
+			}
+			if err := tx.Create(&memberships).Error; err != nil {
+				return models.InternalServerError("Failed to add user to groups: " + err.Error())
+			}
+		}
+		if err := syncDynamicGroups(tx, newUser.ID); err != nil {
+			return models.InternalServerError("Failed to update dynamic groups: " + err.Error())
+		}
+
+		invitation = models.Invitation{
+			UID:       uuid.New(),
+			UserID:    newUser.ID,
+			User:      *newUser,
+			Email:     newUser.Email,
+			TokenHash: tokenHash,
+			ExpiresAt: expiresAt,
+		}
+		if err := tx.Omit("User").Create(&invitation).Error; err != nil {
+			return models.InternalServerError("Failed to create invitation: " + err.Error())
+		}
+		return nil
+	}); err != nil {
+		return nil, toErrorResponse(err)
+	}
+
+	return &invitation, nil
+}
+
+// RenewInvitation replaces the token of a pending or expired invitation, which
+// invalidates any link sent before.
+func (r *invitationRepository) RenewInvitation(uid string, tokenHash string, expiresAt time.Time, ctx *gin.Context) (*models.Invitation, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	result := db.WithContext(ctx).Model(&models.Invitation{}).
+		Where("uid = ? AND accepted_at IS NULL AND revoked_at IS NULL", uid).
+		Updates(map[string]interface{}{"token_hash": tokenHash, "expires_at": expiresAt})
+	if result.Error != nil {
+		return nil, models.InternalServerError("Failed to renew invitation: " + result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return nil, models.Conflict("Invitation is no longer pending")
+	}
+
+	return r.GetInvitationById(uid, ctx)
+}
+
+// RevokeInvitation also deprovisions the invited user if they never accepted.
+func (r *invitationRepository) RevokeInvitation(uid string, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var invitation models.Invitation
+		if err := tx.Where("uid = ?", uid).First(&invitation).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return models.NotFound("Invitation not found")
+			}
+			return models.InternalServerError(err.Error())
+		}
+
+		result := tx.Model(&invitation).
+			Where("accepted_at IS NULL AND revoked_at IS NULL").
+			Update("revoked_at", time.Now())
+		if result.Error != nil {
+			return models.InternalServerError("Failed to revoke invitation: " + result.Error.Error())
+		}
+		if result.RowsAffected == 0 {
+			return models.Conflict("Invitation is no longer pending")
+		}
+
+		if err := tx.Model(&models.User{}).
+			Where("id = ? AND status = ?", invitation.UserID, models.UserStatusInvited).
+			Update("status", models.UserStatusDeprovisioned).Error; err != nil {
+			return models.InternalServerError("Failed to deprovision invited user: " + err.Error())
+		}
+		return nil
+	}); err != nil {
+		return toErrorResponse(err)
+	}
+
+	return nil
+}
+
+// AcceptInvitation consumes the invitation and activates the user in one
+// transaction. Accepting proves ownership of the address, so the email is
+// marked as verified.
+func (r *invitationRepository) AcceptInvitation(uid string, name *string, attributes json.RawMessage, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var invitation models.Invitation
+		if err := tx.Where("uid = ?", uid).First(&invitation).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return models.NotFound("Invitation not found")
+			}
+			return models.InternalServerError(err.Error())
+		}
+
+		now := time.Now()
+		result := tx.Model(&invitation).
+			Where("accepted_at IS NULL AND revoked_at IS NULL AND expires_at > ?", now).
+			Update("accepted_at", now)
+		if result.Error != nil {
+			return models.InternalServerError("Failed to accept invitation: " + result.Error.Error())
+		}
+		if result.RowsAffected == 0 {
+			return models.Conflict("Invitation is no longer pending")
+		}
+
+		updates := map[string]interface{}{
+			"status":            models.UserStatusActive,
+			"email_verified_at": now,
+		}
+		if name != nil {
+			updates["name"] = *name
+		}
+		if attributes != nil {
+			updates["attributes"] = string(attributes)
+		}
+
+		result = tx.Model(&models.User{}).
+			Where("id = ? AND status = ?", invitation.UserID, models.UserStatusInvited).
+			Updates(updates)
+		if result.Error != nil {
+			return models.InternalServerError("Failed to activate user: " + result.Error.Error())
+		}
+		if result.RowsAffected == 0 {
+			return models.Conflict("The invited user is no longer awaiting activation")
+		}
+		if err := syncDynamicGroups(tx, invitation.UserID); err != nil {
+			return models.InternalServerError("Failed to update dynamic groups: " + err.Error())
+		}
+		return nil
+	}); err != nil {
+		return toErrorResponse(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/google-run-code/config"
+)
+
+type passwordRepository struct {
+	dbConfig *config.PostgresConfig
+}
+
+func NewPasswordRepository(dbConfig *config.PostgresConfig) interfaces.PasswordRepository {
+	return &passwordRepository{
+		dbConfig: dbConfig,
+	}
+}
+
+func (r *passwordRepository) getDB(ctx *gin.Context) (*gorm.DB, error) {
+	value, _ := ctx.Get(models.TenantContextKey)
+	tenant, ok := value.(*models.Tenant)
+	if !ok {
+		return nil, models.InternalServerError("Tenant missing from request context")
+	}
+
+	db, err := r.dbConfig.GetDB(tenant.Name)
+	if err != nil {
+		return nil, models.InternalServerError("Failed to get database connection")
+	}
+
+	return db, nil
+}
+
+// GetPasswordPolicy returns nil without an error when the tenant has not saved
+// a policy.
+func (r *passwordRepository) GetPasswordPolicy(ctx *gin.Context) (*models.PasswordPolicy, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var policy models.PasswordPolicy
+	if err := db.WithContext(ctx).First(&policy, models.PasswordPolicyID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return &policy, nil
+}
+
+func (r *passwordRepository) SavePasswordPolicy(policy models.PasswordPolicy, ctx *gin.Context) (*models.PasswordPolicy, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	policy.ID = models.PasswordPolicyID
+	policy.UpdatedAt = time.Now()
+
+	if err := db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"min_length", "require_uppercase", "require_lowercase", "require_digit", "require_symbol", "updated_at"}),
+	}).Create(&policy).Error; err != nil {
+		return nil, models.InternalServerError("Failed to save password policy: " + err.Error())
+	}
+
+	return &policy, nil
+}
+
+// CreatePasswordReset stores a reset token unless the user was already given
+// limit tokens since the given time, and reports whether it did. The user stays
+// locked while the tokens are counted, so concurrent requests cannot exceed the
+// limit together.
+func (r *passwordRepository) CreatePasswordReset(userID int, tokenHash string, expiresAt time.Time, since time.Time, limit int, ctx *gin.Context) (bool, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return false, models.InternalServerError(err.Error())
+	}
+
+	created := false
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&user, userID).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return models.NotFound("User not found")
+			}
+			return models.InternalServerError(err.Error())
+		}
+
+		var count int64
+		if err := tx.Model(&models.PasswordReset{}).
+			Where("user_id = ? AND created_at > ?", userID, since).
+			Count(&count).Error; err != nil {
+			return models.InternalServerError(err.Error())
+		}
+		if count >= int64(limit) {
+			return nil
+		}
+
+		reset := models.PasswordReset{
+			UserID:    userID,
+			TokenHash: tokenHash,
+			ExpiresAt: expiresAt,
+		}
+		if err := tx.Omit("User").Create(&reset).Error; err != nil {
+			return models.InternalServerError("Failed to create password reset: " + err.Error())
+		}
+		created = true
+		return nil
+	}); err != nil {
+		return false, toErrorResponse(err)
+	}
+
+	return created, nil
+}
+
+func (r *passwordRepository) GetPasswordReset(tokenHash string, ctx *gin.Context) (*models.PasswordReset, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var reset models.PasswordReset
+	if err := db.WithContext(ctx).Preload("User").
+		Where("token_hash = ?", tokenHash).
+		First(&reset).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, models.NotFound("Password reset not found")
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return &reset, nil
+}
+
+// ResetPassword consumes the token, stores the new hash and stamps
+// credentials_changed_at so that sessions issued earlier stop being accepted.
+// Every other outstanding reset token of the user is consumed as well.
+func (r *passwordRepository) ResetPassword(tokenHash string, passwordHash string, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		var reset models.PasswordReset
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ? AND used_at IS NULL AND expires_at > ?", tokenHash, now).
+			First(&reset).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return models.BadRequest("Invalid or expired reset token")
+			}
+			return models.InternalServerError(err.Error())
+		}
+
+		if err := tx.Model(&models.PasswordReset{}).
+			Where("user_id = ? AND used_at IS NULL", reset.UserID).
+			Update("used_at", now).Error; err != nil {
+			return models.InternalServerError("Failed to consume reset token: " + err.Error())
+		}
+
+		if err := tx.Model(&models.User{}).
+			Where("id = ?", reset.UserID).
+			Updates(map[string]interface{}{
+				"password_hash":          passwordHash,
+				"credentials_changed_at": now,
+			}).Error; err != nil {
+			return models.InternalServerError("Failed to update password: " + err.Error())
+		}
+		return nil
+	}); err != nil {
+		return toErrorResponse(err)
+	}
+
+	return nil
+}
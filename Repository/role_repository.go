@@ -2,6 +2,7 @@ package repository
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -24,9 +25,14 @@ func NewRoleRepository(dbConfig *config.PostgresConfig) interfaces.RoleRepositor
 }
 
 func (r *roleRepository) getDB(ctx *gin.Context) (*gorm.DB, error) {
-	dbName := ctx.GetString("dbName")
-	db, ok := r.dbConfig.GetDB(dbName)
-	if ok != nil {
+	value, _ := ctx.Get(models.TenantContextKey)
+	tenant, ok := value.(*models.Tenant)
+	if !ok {
+		return nil, models.InternalServerError("Tenant missing from request context")
+	}
+
+	db, err := r.dbConfig.GetDB(tenant.Name)
+	if err != nil {
 		return nil, models.InternalServerError("Failed to get database connection")
 	}
 
@@ -159,22 +165,141 @@ func (r *roleRepository) DeleteRole(UID string, ctx *gin.Context) *models.ErrorR
 		return models.InternalServerError("Failed to fetch role: " + err.Error())
 	}
 
+	var snapshot models.TrashSnapshot
 	if err := db.WithContext(ctx).Model(&models.User{}).
 		Where("role_id = ?", role.ID).
-		Update("role_id", gorm.Expr("NULL")).Error; err != nil {
-		return models.InternalServerError("Failed to dissociate users from role: " + err.Error())
+		Pluck("id", &snapshot.UserIDs).Error; err != nil {
+		return models.InternalServerError("Failed to fetch role users: " + err.Error())
+	}
+	if err := db.WithContext(ctx).Model(&models.UserRole{}).
+		Where("role_id = ?", role.ID).
+		Order("user_id").
+		Pluck("user_id", &snapshot.AssigneeIDs).Error; err != nil {
+		return models.InternalServerError("Failed to fetch role users: " + err.Error())
 	}
 
-	if err := db.WithContext(ctx).
-		Where("uid = ?", roleUID).
-		Delete(&models.Role{}).Error; err != nil {
-		return models.InternalServerError("Failed to delete role: " + err.Error())
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Members of the groups the role is bound to stop holding it
+		groupIDs, err := detachGroupRoles(tx, "role_id", role.ID)
+		if err != nil {
+			return models.InternalServerError("Failed to unbind role from groups: " + err.Error())
+		}
+		snapshot.GroupIDs = groupIDs
+
+		if err := moveToTrash(tx, models.TrashTypeRole, role.ID, role.UID, role.Name, snapshot); err != nil {
+			return models.InternalServerError("Failed to record deleted role: " + err.Error())
+		}
+
+		if err := tx.Model(&models.User{}).
+			Where("role_id = ?", role.ID).
+			Update("role_id", gorm.Expr("NULL")).Error; err != nil {
+			return models.InternalServerError("Failed to dissociate users from role: " + err.Error())
+		}
+
+		if err := tx.Where("role_id = ?", role.ID).Delete(&models.UserRole{}).Error; err != nil {
+			return models.InternalServerError("Failed to remove role assignments: " + err.Error())
+		}
+
+		if err := tx.Where("uid = ?", roleUID).Delete(&models.Role{}).Error; err != nil {
+			return models.InternalServerError("Failed to delete role: " + err.Error())
+		}
+
+		if err := syncDynamicGroups(tx, append(snapshot.AssigneeIDs, snapshot.UserIDs...)...); err != nil {
+			return models.InternalServerError("Failed to update dynamic groups: " + err.Error())
+		}
+		return nil
+	}); err != nil {
+		return toErrorResponse(err)
 	}
 
 	return nil
 }
 
-func (r *roleRepository) GetRoleUsers(role *dtos.RoleResponse, ctx *gin.Context) ([]*dtos.UserResponse, *models.ErrorResponse) {
+func (r *roleRepository) RestoreRole(UID string, ctx *gin.Context) (*dtos.RoleResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var role models.Role
+	if err := db.WithContext(ctx).Unscoped().
+		Where("uid = ? AND deleted_at IS NOT NULL", UID).
+		First(&role).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, models.NotFound("Deleted role not found")
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var count int64
+	if err := db.WithContext(ctx).Model(&models.Role{}).
+		Where("name = ? AND rights::jsonb = ?::jsonb", role.Name, string(role.Rights)).
+		Count(&count).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+	if count > 0 {
+		return nil, models.Conflict("Another role with this name and rights already exists")
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		snapshot, err := takeFromTrash(tx, models.TrashTypeRole, role.ID)
+		if err != nil {
+			return models.InternalServerError("Failed to read deleted role snapshot: " + err.Error())
+		}
+
+		if err := tx.Unscoped().Model(&role).Update("deleted_at", nil).Error; err != nil {
+			return models.InternalServerError("Failed to restore role: " + err.Error())
+		}
+
+		if userIDs := append(snapshot.AssigneeIDs, snapshot.UserIDs...); len(userIDs) > 0 {
+			if err := tx.Exec(`INSERT INTO user_roles (user_id, role_id, created_at)
+				SELECT id, ?, now() FROM users WHERE id IN ? AND deleted_at IS NULL
+				ON CONFLICT DO NOTHING`, role.ID, userIDs).Error; err != nil {
+				return models.InternalServerError("Failed to restore role users: " + err.Error())
+			}
+		}
+
+		if err := restoreGroupRoles(tx, snapshot.GroupIDs, []int{role.ID}); err != nil {
+			return models.InternalServerError("Failed to restore role groups: " + err.Error())
+		}
+
+		// Users that were given another primary role in the meantime keep it
+		if len(snapshot.UserIDs) > 0 {
+			if err := tx.Model(&models.User{}).
+				Where("id IN ? AND role_id IS NULL", snapshot.UserIDs).
+				Update("role_id", role.ID).Error; err != nil {
+				return models.InternalServerError("Failed to restore role users: " + err.Error())
+			}
+		}
+
+		if err := syncDynamicGroups(tx, append(snapshot.AssigneeIDs, snapshot.UserIDs...)...); err != nil {
+			return models.InternalServerError("Failed to update dynamic groups: " + err.Error())
+		}
+		return nil
+	}); err != nil {
+		return nil, toErrorResponse(err)
+	}
+
+	return &dtos.RoleResponse{
+		UID:    role.UID.String(),
+		Name:   role.Name,
+		Rights: role.Rights,
+	}, nil
+}
+
+// roleUserRow is a user holding a role, read together with the assignment.
+type roleUserRow struct {
+	UID       string
+	Name      string
+	Email     string
+	Status    string
+	Primary   bool
+	StartsAt  *time.Time
+	ExpiresAt *time.Time
+}
+
+func (r *roleRepository) GetRoleUsers(role *dtos.RoleResponse, ctx *gin.Context) ([]*dtos.RoleUserResponse, *models.ErrorResponse) {
 	var roleModel models.Role
 	db, err := r.getDB(ctx)
 
@@ -189,18 +314,34 @@ func (r *roleRepository) GetRoleUsers(role *dtos.RoleResponse, ctx *gin.Context)
 		return nil, models.InternalServerError(err.Error())
 	}
 
-	var users []*models.User
-	if err := db.WithContext(ctx).Model(&roleModel).Association("Users").Find(&users); err != nil {
+	// Expired assignments the sweeper has not removed yet are left out
+	var rows []roleUserRow
+	if err := db.WithContext(ctx).Raw(`
+		SELECT u.uid::text AS uid, u.name, u.email, u.status,
+		       COALESCE(u.role_id = ur.role_id, false) AS "primary", ur.starts_at, ur.expires_at
+		FROM user_roles ur
+		JOIN users u ON u.id = ur.user_id AND u.deleted_at IS NULL
+		WHERE ur.role_id = ? AND (ur.expires_at IS NULL OR ur.expires_at > now())
+		ORDER BY u.id`, roleModel.ID).
+		Scan(&rows).Error; err != nil {
 		return nil, models.InternalServerError(err.Error())
 	}
 
-	result := make([]*dtos.UserResponse, len(users))
-	for i, user := range users {
-		result[i] = &dtos.UserResponse{
-			UID:    user.UID.String(),
-			Name:   user.Name,
-			Email:  user.Email,
-			Status: user.Status,
+	now := time.Now()
+	result := make([]*dtos.RoleUserResponse, len(rows))
+	for i, row := range rows {
+		assignment := models.UserRole{StartsAt: row.StartsAt, ExpiresAt: row.ExpiresAt}
+		result[i] = &dtos.RoleUserResponse{
+			UserResponse: dtos.UserResponse{
+				UID:    row.UID,
+				Name:   row.Name,
+				Email:  row.Email,
+				Status: row.Status,
+			},
+			Primary:   row.Primary,
+			Active:    assignment.ActiveAt(now),
+			StartsAt:  row.StartsAt,
+			ExpiresAt: row.ExpiresAt,
 		}
 	}
 
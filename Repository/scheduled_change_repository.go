@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/google-run-code/config"
+)
+
+// claimTimeout is how long a claimed change may stay applying before another
+// run takes it over, for instance after the process applying it died.
+const claimTimeout = 10 * time.Minute
+
+type scheduledChangeRepository struct {
+	dbConfig *config.PostgresConfig
+}
+
+func NewScheduledChangeRepository(dbConfig *config.PostgresConfig) interfaces.ScheduledChangeRepository {
+	return &scheduledChangeRepository{
+		dbConfig: dbConfig,
+	}
+}
+
+func (r *scheduledChangeRepository) getDB(ctx *gin.Context) (*gorm.DB, error) {
+	value, _ := ctx.Get(models.TenantContextKey)
+	tenant, ok := value.(*models.Tenant)
+	if !ok {
+		return nil, models.InternalServerError("Tenant missing from request context")
+	}
+
+	db, err := r.dbConfig.GetDB(tenant.Name)
+	if err != nil {
+		return nil, models.InternalServerError("Failed to get database connection")
+	}
+
+	return db, nil
+}
+
+func (r *scheduledChangeRepository) GetScheduledChanges(filter dtos.ScheduledChangeFilter, ctx *gin.Context) ([]*models.ScheduledChange, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	query := db.WithContext(ctx).Order("effective_at, id")
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.TargetID != "" {
+		query = query.Where("target_uid = ?", filter.TargetID)
+	}
+
+	var changes []*models.ScheduledChange
+	if err := query.Find(&changes).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return changes, nil
+}
+
+func (r *scheduledChangeRepository) GetScheduledChangeById(uid string, ctx *gin.Context) (*models.ScheduledChange, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var change models.ScheduledChange
+	if err := db.WithContext(ctx).Where("uid = ?", uid).First(&change).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, models.NotFound("Scheduled change not found")
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return &change, nil
+}
+
+func (r *scheduledChangeRepository) CreateScheduledChange(change *models.ScheduledChange, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if err := db.WithContext(ctx).Create(change).Error; err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+// CancelScheduledChange cancels a change that has not been picked up yet.
+func (r *scheduledChangeRepository) CancelScheduledChange(uid string, ctx *gin.Context) (*models.ScheduledChange, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var change models.ScheduledChange
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("uid = ?", uid).
+			First(&change).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return models.NotFound("Scheduled change not found")
+			}
+			return models.InternalServerError(err.Error())
+		}
+		if change.Status != models.ScheduledChangePending {
+			return models.Conflict("Only pending changes can be cancelled")
+		}
+
+		now := time.Now()
+		change.Status = models.ScheduledChangeCancelled
+		change.CancelledAt = &now
+		if err := tx.Model(&change).Updates(map[string]any{
+			"status":       change.Status,
+			"cancelled_at": change.CancelledAt,
+		}).Error; err != nil {
+			return models.InternalServerError("Failed to cancel scheduled change: " + err.Error())
+		}
+		return nil
+	}); err != nil {
+		return nil, toErrorResponse(err)
+	}
+
+	return &change, nil
+}
+
+// ClaimDueChanges marks up to limit changes that are due as applying and
+// returns them in the order they take effect. Changes another run claimed
+// more than claimTimeout ago are claimed again.
+func (r *scheduledChangeRepository) ClaimDueChanges(now time.Time, limit int, ctx *gin.Context) ([]*models.ScheduledChange, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var changes []*models.ScheduledChange
+	if err := db.WithContext(ctx).Raw(`
+		UPDATE scheduled_changes SET status = ?, claimed_at = ?
+		WHERE id IN (
+			SELECT id FROM scheduled_changes
+			WHERE (status = ? AND effective_at <= ?) OR (status = ? AND claimed_at < ?)
+			ORDER BY effective_at, id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED)
+		RETURNING *`,
+		models.ScheduledChangeApplying, now,
+		models.ScheduledChangePending, now, models.ScheduledChangeApplying, now.Add(-claimTimeout),
+		limit).
+		Scan(&changes).Error; err != nil {
+		return nil, models.InternalServerError("Failed to claim scheduled changes: " + err.Error())
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if !changes[i].EffectiveAt.Equal(changes[j].EffectiveAt) {
+			return changes[i].EffectiveAt.Before(changes[j].EffectiveAt)
+		}
+		return changes[i].ID < changes[j].ID
+	})
+
+	return changes, nil
+}
+
+// FinishScheduledChange records the outcome of a claimed change.
+func (r *scheduledChangeRepository) FinishScheduledChange(id int, status models.ScheduledChangeStatus, result string, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if err := db.WithContext(ctx).Model(&models.ScheduledChange{}).
+		Where("id = ? AND status = ?", id, models.ScheduledChangeApplying).
+		Updates(map[string]any{
+			"status":      status,
+			"result":      result,
+			"finished_at": time.Now(),
+		}).Error; err != nil {
+		return models.InternalServerError("Failed to record scheduled change result: " + err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/google-run-code/config"
+)
+
+type signInRepository struct {
+	dbConfig *config.PostgresConfig
+}
+
+func NewSignInRepository(dbConfig *config.PostgresConfig) interfaces.SignInRepository {
+	return &signInRepository{
+		dbConfig: dbConfig,
+	}
+}
+
+func (r *signInRepository) getDB(ctx *gin.Context) (*gorm.DB, error) {
+	value, _ := ctx.Get(models.TenantContextKey)
+	tenant, ok := value.(*models.Tenant)
+	if !ok {
+		return nil, models.InternalServerError("Tenant missing from request context")
+	}
+
+	db, err := r.dbConfig.GetDB(tenant.Name)
+	if err != nil {
+		return nil, models.InternalServerError("Failed to get database connection")
+	}
+
+	return db, nil
+}
+
+func (r *signInRepository) CreateSignIn(signIn models.SignIn, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if err := db.WithContext(ctx).Omit(clause.Associations).Create(&signIn).Error; err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+// GetUserSignIns lists the most recent sign-in attempts of a user, newest
+// first.
+func (r *signInRepository) GetUserSignIns(uid string, limit int, ctx *gin.Context) ([]dtos.SignInResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	userID, errResp := findUserID(db.WithContext(ctx), uid)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	var signIns []models.SignIn
+	if err := db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&signIns).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var result []dtos.SignInResponse
+	for _, signIn := range signIns {
+		result = append(result, dtos.SignInResponse{
+			Email:     signIn.Email,
+			IPAddress: signIn.IPAddress,
+			UserAgent: signIn.UserAgent,
+			Outcome:   signIn.Outcome,
+			CreatedAt: signIn.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// RecordActivity writes batched user activity. Timestamps only move forward,
+// so a late batch never overwrites newer activity.
+func (r *signInRepository) RecordActivity(activity []dtos.UserActivity, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, a := range activity {
+			if err := tx.Exec(`UPDATE users
+				SET last_seen_at = GREATEST(last_seen_at, ?),
+				    last_login_at = GREATEST(last_login_at, ?)
+				WHERE uid = ?`, a.SeenAt, a.LoginAt, a.UserUID).Error; err != nil {
+				return models.InternalServerError("Failed to record user activity: " + err.Error())
+			}
+		}
+		return nil
+	}); err != nil {
+		return toErrorResponse(err)
+	}
+
+	return nil
+}
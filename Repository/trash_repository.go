@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/google-run-code/config"
+)
+
+type trashRepository struct {
+	dbConfig *config.PostgresConfig
+}
+
+func NewTrashRepository(dbConfig *config.PostgresConfig) interfaces.TrashRepository {
+	return &trashRepository{
+		dbConfig: dbConfig,
+	}
+}
+
+func (r *trashRepository) getDB(ctx *gin.Context) (*gorm.DB, error) {
+	value, _ := ctx.Get(models.TenantContextKey)
+	tenant, ok := value.(*models.Tenant)
+	if !ok {
+		return nil, models.InternalServerError("Tenant missing from request context")
+	}
+
+	db, err := r.dbConfig.GetDB(tenant.Name)
+	if err != nil {
+		return nil, models.InternalServerError("Failed to get database connection")
+	}
+
+	return db, nil
+}
+
+func (r *trashRepository) GetTrash(entityType string, ctx *gin.Context) ([]*dtos.TrashEntryResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	query := db.WithContext(ctx).Order("trashed_at DESC")
+	if entityType != "" {
+		query = query.Where("entity_type = ?", entityType)
+	}
+
+	var entries []*models.TrashEntry
+	if err := query.Find(&entries).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var result []*dtos.TrashEntryResponse
+	for _, entry := range entries {
+		result = append(result, &dtos.TrashEntryResponse{
+			Type:      entry.EntityType,
+			UID:       entry.EntityUID.String(),
+			Name:      entry.Name,
+			DeletedAt: entry.TrashedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// moveToTrash stores the relationships of an entity that is about to be soft
+// deleted. It must run in the same transaction as the delete.
+func moveToTrash(tx *gorm.DB, entityType string, id int, uid uuid.UUID, name string, snapshot models.TrashSnapshot) error {
+	raw, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(&models.TrashEntry{
+		EntityType: entityType,
+		EntityID:   id,
+		EntityUID:  uid,
+		Name:       name,
+		Snapshot:   raw,
+		TrashedAt:  time.Now(),
+	}).Error
+}
+
+// takeFromTrash removes the trash entry of a restored entity and returns the
+// relationships recorded when it was deleted.
+func takeFromTrash(tx *gorm.DB, entityType string, id int) (*models.TrashSnapshot, error) {
+	var entry models.TrashEntry
+	if err := tx.Where("entity_type = ? AND entity_id = ?", entityType, id).First(&entry).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &models.TrashSnapshot{}, nil
+		}
+		return nil, err
+	}
+
+	var snapshot models.TrashSnapshot
+	if err := json.Unmarshal(entry.Snapshot, &snapshot); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Delete(&entry).Error; err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// toErrorResponse unwraps errors returned from a transaction closure, keeping
+// any *models.ErrorResponse produced inside it.
+func toErrorResponse(err error) *models.ErrorResponse {
+	if errResp, ok := err.(*models.ErrorResponse); ok {
+		return errResp
+	}
+	return models.InternalServerError(err.Error())
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+const pgUniqueViolation = "23505"
+
+var errBatchRolledBack = errors.New("batch rolled back")
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation
+}
+
+func (r *userRepository) GetUsersByIds(uids []string, ctx *gin.Context) ([]*models.User, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var users []*models.User
+	if len(uids) == 0 {
+		return users, nil
+	}
+
+	if err := db.WithContext(ctx).Where("uid IN ?", uids).Find(&users).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return users, nil
+}
+
+// ApplyUserBatch applies the changes in one transaction and returns one result
+// per change. Unless continueOnError is set, the first failure rolls back the
+// whole batch and every other change is reported as 424. With continueOnError
+// each change runs in its own savepoint.
+func (r *userRepository) ApplyUserBatch(changes []dtos.BatchUserChange, continueOnError bool, ctx *gin.Context) ([]*dtos.BatchUserResult, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	results := make([]*dtos.BatchUserResult, len(changes))
+
+	txErr := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		users, err := batchUsers(tx, changes)
+		if err != nil {
+			return models.InternalServerError(err.Error())
+		}
+
+		for i, change := range changes {
+			savepoint := fmt.Sprintf("batch_item_%d", i)
+			if continueOnError {
+				if err := tx.SavePoint(savepoint).Error; err != nil {
+					return models.InternalServerError(err.Error())
+				}
+			}
+
+			result, itemErr := applyUserChange(tx, change, users)
+			if itemErr == nil {
+				results[i] = result
+				continue
+			}
+
+			results[i] = &dtos.BatchUserResult{Index: change.Index, Op: change.Op, UID: change.UID, Status: itemErr.Code, Error: itemErr.Message}
+			if !continueOnError {
+				return errBatchRolledBack
+			}
+			if err := tx.RollbackTo(savepoint).Error; err != nil {
+				return models.InternalServerError(err.Error())
+			}
+		}
+		return nil
+	})
+
+	if txErr == errBatchRolledBack {
+		for i, change := range changes {
+			if results[i] == nil || results[i].Error == "" {
+				results[i] = &dtos.BatchUserResult{
+					Index:  change.Index,
+					Op:     change.Op,
+					UID:    change.UID,
+					Status: http.StatusFailedDependency,
+					Error:  "Not applied because another operation in the batch failed",
+				}
+			}
+		}
+		return results, nil
+	}
+	if txErr != nil {
+		return nil, toErrorResponse(txErr)
+	}
+
+	return results, nil
+}
+
+// batchUsers loads and locks every user updated or deleted by the batch in one
+// query.
+func batchUsers(tx *gorm.DB, changes []dtos.BatchUserChange) (map[string]*models.User, error) {
+	var uids []string
+	for _, change := range changes {
+		if change.Op != dtos.BatchOpCreate {
+			uids = append(uids, change.UID)
+		}
+	}
+
+	users := make(map[string]*models.User)
+	if len(uids) == 0 {
+		return users, nil
+	}
+
+	var list []*models.User
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("uid IN ?", uids).Order("id").Find(&list).Error; err != nil {
+		return nil, err
+	}
+	for _, user := range list {
+		users[user.UID.String()] = user
+	}
+
+	return users, nil
+}
+
+func applyUserChange(tx *gorm.DB, change dtos.BatchUserChange, users map[string]*models.User) (*dtos.BatchUserResult, *models.ErrorResponse) {
+	result := &dtos.BatchUserResult{Index: change.Index, Op: change.Op, UID: change.UID, Status: http.StatusOK}
+
+	switch change.Op {
+	case dtos.BatchOpCreate:
+		user, errResp := createUser(tx, *change.Create)
+		if errResp != nil {
+			return nil, errResp
+		}
+		if err := syncDynamicGroups(tx, user.ID); err != nil {
+			return nil, models.InternalServerError("Failed to update dynamic groups: " + err.Error())
+		}
+		result.UID = user.UID.String()
+		result.Status = http.StatusCreated
+
+	case dtos.BatchOpUpdate:
+		user, ok := users[change.UID]
+		if !ok {
+			return nil, models.NotFound("User not found")
+		}
+		if errResp := updateUser(tx, user, change.Update); errResp != nil {
+			return nil, errResp
+		}
+
+	case dtos.BatchOpDelete:
+		user, ok := users[change.UID]
+		if !ok {
+			return nil, models.NotFound("User not found")
+		}
+		if errResp := deleteUser(tx, user); errResp != nil {
+			return nil, errResp
+		}
+
+	default:
+		return nil, models.BadRequest("Unknown operation: " + change.Op)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,360 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/gorm"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// maxImportErrors caps the row errors returned by an import. Failed still
+// counts every rejected row.
+const maxImportErrors = 1000
+
+const exportUsersQuery = `
+SELECT u.uid::text, u.name, u.email, u.status, COALESCE(r.name, ''),
+       COALESCE(string_agg(g.name, ';' ORDER BY g.name), '')
+FROM users u
+LEFT JOIN roles r ON r.id = u.role_id AND r.deleted_at IS NULL
+LEFT JOIN groups_users_maps m ON m.users_id = u.id
+  AND (m.valid_from IS NULL OR m.valid_from <= now()) AND (m.valid_until IS NULL OR m.valid_until > now())
+LEFT JOIN groups g ON g.id = m.groups_id AND g.deleted_at IS NULL
+WHERE u.deleted_at IS NULL
+GROUP BY u.id, r.name
+ORDER BY u.id`
+
+// StreamUsers hands users to fn one row at a time as they are read from the
+// database, so the table is never held in memory.
+func (r *userRepository) StreamUsers(fn func(row *dtos.UserExportRow) error, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	rows, err := db.WithContext(ctx).Raw(exportUsersQuery).Rows()
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row dtos.UserExportRow
+		var groups string
+		if err := rows.Scan(&row.UID, &row.Name, &row.Email, &row.Status, &row.Role, &groups); err != nil {
+			return models.InternalServerError(err.Error())
+		}
+		if groups != "" {
+			row.Groups = strings.Split(groups, dtos.UserCSVGroupSeparator)
+		}
+
+		if err := fn(&row); err != nil {
+			return models.InternalServerError(err.Error())
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+// ImportUsers copies the rows returned by next into a temporary staging table
+// with COPY, validates them there and upserts the valid rows by email, all in
+// one transaction. A dry run reports the same result and rolls back.
+func (r *userRepository) ImportUsers(next func() (*dtos.UserImportRow, error), dryRun bool, ctx *gin.Context) (*dtos.UserImportResult, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, models.InternalServerError("Failed to get database connection")
+	}
+	defer conn.Close()
+
+	var result *dtos.UserImportResult
+	if err := conn.Raw(func(driverConn any) error {
+		var importErr error
+		result, importErr = importUsers(ctx, driverConn.(*stdlib.Conn).Conn(), next, dryRun)
+		return importErr
+	}); err != nil {
+		if isUniqueViolation(err) {
+			return nil, models.Conflict("A user with one of the imported emails was created concurrently, retry the import")
+		}
+		return nil, toErrorResponse(err)
+	}
+
+	// The import runs on its own connection, so dynamic groups are evaluated
+	// for every user once it is committed
+	if !dryRun {
+		if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			return evaluateDynamicGroups(tx, nil)
+		}); err != nil {
+			return nil, models.InternalServerError("Users were imported, but updating dynamic groups failed: " + err.Error())
+		}
+	}
+
+	return result, nil
+}
+
+func importUsers(ctx context.Context, conn *pgx.Conn, next func() (*dtos.UserImportRow, error), dryRun bool) (*dtos.UserImportResult, error) {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `CREATE TEMP TABLE import_users (
+		line int PRIMARY KEY,
+		name text NOT NULL,
+		email text NOT NULL,
+		email_normalized text NOT NULL,
+		status text NOT NULL,
+		role text NOT NULL,
+		groups text[] NOT NULL,
+		error text
+	) ON COMMIT DROP`); err != nil {
+		return nil, err
+	}
+
+	staged, err := tx.CopyFrom(ctx, pgx.Identifier{"import_users"},
+		[]string{"line", "name", "email", "email_normalized", "status", "role", "groups"},
+		pgx.CopyFromFunc(func() ([]any, error) {
+			row, err := next()
+			if err != nil || row == nil {
+				return nil, err
+			}
+			groups := row.Groups
+			if groups == nil {
+				groups = []string{}
+			}
+			return []any{row.Line, row.Name, row.Email, models.NormalizeEmail(row.Email), row.Status, row.Role, groups}, nil
+		}))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, statement := range importValidations {
+		if _, err := tx.Exec(ctx, statement); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateImportTransitions(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	result := &dtos.UserImportResult{DryRun: dryRun, Total: int(staged), Errors: []dtos.UserImportError{}}
+
+	if err := tx.QueryRow(ctx, `
+		SELECT count(*) FILTER (WHERE u.id IS NULL), count(*) FILTER (WHERE u.id IS NOT NULL)
+		FROM import_users s
+		LEFT JOIN users u ON u.email_normalized = s.email_normalized AND u.deleted_at IS NULL
+		WHERE s.error IS NULL`).Scan(&result.Created, &result.Updated); err != nil {
+		return nil, err
+	}
+
+	if err := tx.QueryRow(ctx, `SELECT count(*) FROM import_users WHERE error IS NOT NULL`).Scan(&result.Failed); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, `SELECT line, error FROM import_users WHERE error IS NOT NULL ORDER BY line LIMIT $1`, maxImportErrors)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var rowErr dtos.UserImportError
+		if err := rows.Scan(&rowErr.Line, &rowErr.Error); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		result.Errors = append(result.Errors, rowErr)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	for _, statement := range importMerge {
+		if _, err := tx.Exec(ctx, statement); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// importValidations mark staged rows that cannot be applied. Each statement
+// only looks at rows without an error yet, so every row reports its first
+// problem.
+var importValidations = []string{
+	`UPDATE import_users s SET error = 'Duplicate email, first seen on line ' || d.first_line
+	 FROM (SELECT email_normalized, min(line) AS first_line FROM import_users GROUP BY email_normalized) d
+	 WHERE s.email_normalized = d.email_normalized AND s.line <> d.first_line AND s.error IS NULL`,
+
+	`UPDATE import_users s SET error = 'Email is an alias of an existing user'
+	 WHERE s.error IS NULL
+	   AND EXISTS (SELECT 1 FROM email_aliases a WHERE a.email_normalized = s.email_normalized)`,
+
+	`UPDATE import_users s SET error = 'Role not found: ' || s.role
+	 WHERE s.error IS NULL AND s.role <> ''
+	   AND NOT EXISTS (SELECT 1 FROM roles r WHERE r.name = s.role AND r.deleted_at IS NULL)`,
+
+	`UPDATE import_users s SET error = 'Role name is ambiguous: ' || s.role
+	 WHERE s.error IS NULL AND s.role <> ''
+	   AND (SELECT count(*) FROM roles r WHERE r.name = s.role AND r.deleted_at IS NULL) > 1`,
+
+	`UPDATE import_users s SET error = 'Group not found: ' || m.name
+	 FROM (SELECT DISTINCT ON (s2.line) s2.line, g.name
+	       FROM import_users s2 CROSS JOIN LATERAL unnest(s2.groups) AS g(name)
+	       WHERE NOT EXISTS (SELECT 1 FROM groups gr WHERE gr.name = g.name AND gr.deleted_at IS NULL)
+	       ORDER BY s2.line) m
+	 WHERE s.line = m.line AND s.error IS NULL`,
+
+	`UPDATE import_users s SET error = 'Members of dynamic group ' || m.name || ' are managed by its rule'
+	 FROM (SELECT DISTINCT ON (s2.line) s2.line, g.name
+	       FROM import_users s2 CROSS JOIN LATERAL unnest(s2.groups) AS g(name)
+	       WHERE EXISTS (SELECT 1 FROM groups gr WHERE gr.name = g.name AND gr.deleted_at IS NULL AND gr.type = 'dynamic')
+	       ORDER BY s2.line) m
+	 WHERE s.line = m.line AND s.error IS NULL`,
+
+	`UPDATE import_users s SET error = 'New users must be either invited or active'
+	 WHERE s.error IS NULL AND s.status NOT IN ('', 'active', 'invited')
+	   AND NOT EXISTS (SELECT 1 FROM users u WHERE u.email_normalized = s.email_normalized AND u.deleted_at IS NULL)`,
+
+	`UPDATE import_users s SET error = 'Name is required for new users'
+	 WHERE s.error IS NULL AND s.name = ''
+	   AND NOT EXISTS (SELECT 1 FROM users u WHERE u.email_normalized = s.email_normalized AND u.deleted_at IS NULL)`,
+}
+
+// auditRoleChanges records an audit entry like recordRoleAudit for every
+// user_id and role_id pair the statement's changed CTE returns.
+func auditRoleChanges(action string) string {
+	return `INSERT INTO audit_entries (uid, action, target_type, target_uid, details, created_at)
+	 SELECT gen_random_uuid(), '` + action + `', '` + models.AuditTargetUser + `', u.uid,
+	        jsonb_build_object('role_id', r.uid, 'role_name', r.name), now()
+	 FROM changed c
+	 JOIN users u ON u.id = c.user_id
+	 JOIN roles r ON r.id = c.role_id`
+}
+
+// importMerge applies the valid rows. Existing users are updated before new
+// ones are inserted, and groups are only ever added. The role column sets the
+// primary role, replacing the previous primary role's assignment; both are
+// audited.
+var importMerge = []string{
+	`WITH changed AS (
+	   DELETE FROM user_roles ur
+	   USING import_users s, users u
+	   WHERE u.email_normalized = s.email_normalized AND u.deleted_at IS NULL AND s.error IS NULL AND s.role <> ''
+	     AND ur.user_id = u.id AND ur.role_id = u.role_id
+	     AND u.role_id IS DISTINCT FROM (SELECT r.id FROM roles r WHERE r.name = s.role AND r.deleted_at IS NULL)
+	   RETURNING ur.user_id, ur.role_id)
+	 ` + auditRoleChanges(models.AuditUserRoleRemoved),
+
+	`UPDATE users u SET
+	     name = CASE WHEN s.name <> '' THEN s.name ELSE u.name END,
+	     status = CASE WHEN s.status <> '' THEN s.status ELSE u.status END,
+	     role_id = CASE WHEN s.role <> '' THEN (SELECT r.id FROM roles r WHERE r.name = s.role AND r.deleted_at IS NULL) ELSE u.role_id END
+	 FROM import_users s
+	 WHERE u.email_normalized = s.email_normalized AND u.deleted_at IS NULL AND s.error IS NULL`,
+
+	`INSERT INTO users (uid, name, email, email_normalized, status, attributes, role_id)
+	 SELECT gen_random_uuid(), s.name, s.email, s.email_normalized,
+	        CASE WHEN s.status <> '' THEN s.status ELSE 'active' END, '{}',
+	        (SELECT r.id FROM roles r WHERE r.name = s.role AND r.deleted_at IS NULL)
+	 FROM import_users s
+	 WHERE s.error IS NULL
+	   AND NOT EXISTS (SELECT 1 FROM users u WHERE u.email_normalized = s.email_normalized AND u.deleted_at IS NULL)
+	 ORDER BY s.line`,
+
+	`WITH changed AS (
+	   INSERT INTO user_roles (user_id, role_id, created_at)
+	   SELECT u.id, u.role_id, now()
+	   FROM import_users s
+	   JOIN users u ON u.email_normalized = s.email_normalized AND u.deleted_at IS NULL
+	   WHERE s.error IS NULL AND u.role_id IS NOT NULL
+	   ON CONFLICT DO NOTHING
+	   RETURNING user_id, role_id)
+	 ` + auditRoleChanges(models.AuditUserRoleAssigned),
+
+	`INSERT INTO groups_users_maps (users_id, groups_id)
+	 SELECT u.id, gr.id
+	 FROM import_users s
+	 JOIN users u ON u.email_normalized = s.email_normalized AND u.deleted_at IS NULL
+	 CROSS JOIN LATERAL unnest(s.groups) AS g(name)
+	 JOIN groups gr ON gr.name = g.name AND gr.deleted_at IS NULL
+	 WHERE s.error IS NULL
+	 ON CONFLICT DO NOTHING`,
+}
+
+// validateImportTransitions applies the user status transition rules, which
+// live in Go, to existing users whose status the import changes. The users are
+// locked until the import commits, so their status cannot change in between.
+func validateImportTransitions(ctx context.Context, tx pgx.Tx) error {
+	rows, err := tx.Query(ctx, `
+		SELECT s.line, u.status, s.status
+		FROM import_users s
+		JOIN users u ON u.email_normalized = s.email_normalized AND u.deleted_at IS NULL
+		WHERE s.error IS NULL AND s.status <> ''
+		ORDER BY u.id
+		FOR UPDATE OF u`)
+	if err != nil {
+		return err
+	}
+
+	var lines []int
+	var messages []string
+	for rows.Next() {
+		var line int
+		var current, next string
+		if err := rows.Scan(&line, &current, &next); err != nil {
+			rows.Close()
+			return err
+		}
+		if current == next {
+			continue
+		}
+		if errResp := models.UserStatus(current).CheckTransition(models.UserStatus(next)); errResp != nil {
+			lines = append(lines, line)
+			messages = append(messages, errResp.Message)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if len(lines) == 0 {
+		return nil
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE import_users s SET error = e.message
+		FROM unnest($1::int[], $2::text[]) AS e(line, message)
+		WHERE s.line = e.line`, lines, messages)
+	return err
+}
@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// maxHierarchyDepth bounds the recursive queries in case a cycle slipped in
+// through data written outside the API.
+const maxHierarchyDepth = 100
+
+const reportsQuery = `
+WITH RECURSIVE reports AS (
+	SELECT u.id, u.manager_id, 1 AS depth FROM users u
+	WHERE u.manager_id = ? AND u.deleted_at IS NULL
+	UNION ALL
+	SELECT u.id, u.manager_id, r.depth + 1 FROM users u
+	JOIN reports r ON u.manager_id = r.id
+	WHERE u.deleted_at IS NULL AND r.depth < ?
+)
+SELECT u.uid::text AS uid, u.name, u.email, u.title, m.uid::text AS manager_uid, r.depth
+FROM reports r
+JOIN users u ON u.id = r.id
+JOIN users m ON m.id = r.manager_id
+WHERE r.depth <= ?
+ORDER BY r.depth, u.name, u.id`
+
+const chainQuery = `
+WITH RECURSIVE chain AS (
+	SELECT u.manager_id AS id, 1 AS depth FROM users u WHERE u.id = ?
+	UNION ALL
+	SELECT u.manager_id, c.depth + 1 FROM users u
+	JOIN chain c ON u.id = c.id
+	WHERE u.manager_id IS NOT NULL AND c.depth < ?
+)
+SELECT u.uid::text AS uid, u.name, u.email, u.title, m.uid::text AS manager_uid, c.depth
+FROM chain c
+JOIN users u ON u.id = c.id AND u.deleted_at IS NULL
+LEFT JOIN users m ON m.id = u.manager_id AND m.deleted_at IS NULL
+ORDER BY c.depth`
+
+type reportRow struct {
+	UID        string
+	Name       string
+	Email      string
+	Title      string
+	ManagerUID *string
+	Depth      int
+}
+
+func toReportResponses(rows []reportRow) []*dtos.UserReportResponse {
+	var result []*dtos.UserReportResponse
+	for _, row := range rows {
+		report := &dtos.UserReportResponse{
+			UID:   row.UID,
+			Name:  row.Name,
+			Email: row.Email,
+			Title: row.Title,
+			Depth: row.Depth,
+		}
+		if row.ManagerUID != nil {
+			report.ManagerUID = *row.ManagerUID
+		}
+		result = append(result, report)
+	}
+	return result
+}
+
+func userReference(user *models.User) *dtos.UserReference {
+	if user == nil {
+		return nil
+	}
+	return &dtos.UserReference{
+		UID:   user.UID.String(),
+		Name:  user.Name,
+		Email: user.Email,
+	}
+}
+
+// assignManager sets or, with an empty managerUID, clears the manager of a
+// user. It refuses a manager that already reports to the user, directly or
+// not, since that would close a cycle.
+func assignManager(tx *gorm.DB, user *models.User, managerUID string) *models.ErrorResponse {
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", hierarchyLockKey).Error; err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if managerUID == "" {
+		if err := tx.Model(user).Update("manager_id", nil).Error; err != nil {
+			return models.InternalServerError("Failed to update manager: " + err.Error())
+		}
+		user.ManagerID = nil
+		return nil
+	}
+
+	var manager models.User
+	if err := tx.Select("id").Where("uid = ?", managerUID).First(&manager).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.NotFound("Manager not found")
+		}
+		return models.InternalServerError(err.Error())
+	}
+
+	if manager.ID == user.ID {
+		return models.Conflict("A user cannot be their own manager")
+	}
+
+	var reports []reportRow
+	if err := tx.Raw(reportsQuery, user.ID, maxHierarchyDepth, maxHierarchyDepth).Scan(&reports).Error; err != nil {
+		return models.InternalServerError(err.Error())
+	}
+	for _, report := range reports {
+		if report.UID == managerUID {
+			return models.Conflict("The manager reports to this user, which would create a cycle")
+		}
+	}
+
+	if err := tx.Model(user).Update("manager_id", manager.ID).Error; err != nil {
+		return models.InternalServerError("Failed to update manager: " + err.Error())
+	}
+	user.ManagerID = &manager.ID
+	return nil
+}
+
+// detachReports leaves the direct reports of a user that is being deleted
+// without a manager.
+func detachReports(tx *gorm.DB, userID int) error {
+	var reportIDs []int
+	if err := tx.Model(&models.User{}).Where("manager_id = ?", userID).Pluck("id", &reportIDs).Error; err != nil {
+		return err
+	}
+	if err := tx.Model(&models.User{}).Where("manager_id = ?", userID).Update("manager_id", nil).Error; err != nil {
+		return err
+	}
+	return syncDynamicGroups(tx, reportIDs...)
+}
+
+// GetReports lists the users reporting to uid, down to maxDepth levels.
+func (r *userRepository) GetReports(uid string, maxDepth int, ctx *gin.Context) ([]*dtos.UserReportResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	userID, errResp := findUserID(db.WithContext(ctx), uid)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	var rows []reportRow
+	if err := db.WithContext(ctx).Raw(reportsQuery, userID, maxDepth, maxDepth).Scan(&rows).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return toReportResponses(rows), nil
+}
+
+// GetManagementChain lists the managers above uid, starting with the direct
+// manager. The chain stops at a deleted manager.
+func (r *userRepository) GetManagementChain(uid string, ctx *gin.Context) ([]*dtos.UserReportResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	userID, errResp := findUserID(db.WithContext(ctx), uid)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	var rows []reportRow
+	if err := db.WithContext(ctx).Raw(chainQuery, userID, maxHierarchyDepth).Scan(&rows).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	// Cut the chain at the first gap left by a deleted manager
+	for i, row := range rows {
+		if row.Depth != i+1 {
+			rows = rows[:i]
+			break
+		}
+	}
+
+	return toReportResponses(rows), nil
+}
+
+// IsManagerOf reports whether the actor is the user or someone the user
+// reports to, directly or not. Only active users whose token was issued after
+// their last credential change count.
+func (r *userRepository) IsManagerOf(uid string, actor models.Actor, ctx *gin.Context) (bool, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return false, models.InternalServerError(err.Error())
+	}
+
+	manager, err := actingUser(db.WithContext(ctx), actor)
+	if err != nil {
+		return false, models.InternalServerError(err.Error())
+	}
+	if manager == nil {
+		return false, nil
+	}
+	if manager.UID.String() == uid {
+		return true, nil
+	}
+
+	chain, errResp := r.GetManagementChain(uid, ctx)
+	if errResp != nil {
+		if errResp.Code == http.StatusNotFound {
+			return false, nil
+		}
+		return false, errResp
+	}
+	for _, row := range chain {
+		if row.UID == manager.UID.String() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// MergeUsers folds the source user into the target in one transaction. The
+// target keeps its own fields unless overridden, gains the source's groups
+// and the groups it owns, and takes over the source's email addresses as aliases. The source is soft
+// deleted without a trash entry and its UID redirects to the target.
+func (r *userRepository) MergeUsers(targetUID string, req dtos.UserMergeRequest, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock both rows in id order so concurrent merges cannot deadlock
+		var users []models.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("uid IN ?", []string{targetUID, req.SourceID}).
+			Order("id").
+			Find(&users).Error; err != nil {
+			return err
+		}
+
+		var target, source *models.User
+		for i := range users {
+			switch users[i].UID.String() {
+			case targetUID:
+				target = &users[i]
+			case req.SourceID:
+				source = &users[i]
+			}
+		}
+		if target == nil {
+			return models.NotFound("User not found")
+		}
+		if source == nil {
+			return models.NotFound("Source user not found")
+		}
+
+		// Roles of the source the target lacks would grant the survivor new
+		// rights, so they only carry over when the caller asks for it
+		var missingRoleIDs []int
+		if err := tx.Model(&models.UserRole{}).
+			Where("user_id = ? AND role_id NOT IN (SELECT role_id FROM user_roles WHERE user_id = ?)", source.ID, target.ID).
+			Order("role_id").
+			Pluck("role_id", &missingRoleIDs).Error; err != nil {
+			return err
+		}
+		if len(missingRoleIDs) > 0 && req.RoleConflict == dtos.MergeRoleFail {
+			return models.Conflict("The source user holds roles the target does not")
+		}
+
+		overrides := req.Overrides
+		if overrides.Name != nil {
+			target.Name = *overrides.Name
+		}
+		if overrides.Attributes != nil {
+			target.Attributes = overrides.Attributes
+		}
+		updateUserProfile(target, overrides.UserProfileUpdate)
+		target.LastLoginAt = latest(target.LastLoginAt, source.LastLoginAt)
+		target.LastSeenAt = latest(target.LastSeenAt, source.LastSeenAt)
+
+		// A target reporting to the source moves up to the source's manager
+		if target.ManagerID != nil && *target.ManagerID == source.ID {
+			target.ManagerID = source.ManagerID
+		}
+
+		if err := tx.Omit(clause.Associations).Save(target).Error; err != nil {
+			return err
+		}
+
+		if err := moveReports(tx, source, target); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.SignIn{}).Where("user_id = ?", source.ID).
+			Update("user_id", target.ID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec(`INSERT INTO groups_users_maps (users_id, groups_id, valid_from, valid_until)
+			SELECT ?, groups_id, valid_from, valid_until FROM groups_users_maps WHERE users_id = ?
+			ON CONFLICT DO NOTHING`, target.ID, source.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec(`DELETE FROM groups_users_maps WHERE users_id = ?`, source.ID).Error; err != nil {
+			return err
+		}
+		if err := transferOwnerships(tx, source, target); err != nil {
+			return err
+		}
+
+		if req.RoleConflict == dtos.MergeRoleKeepSource {
+			if err := takeOverRoles(tx, source, target); err != nil {
+				return err
+			}
+		}
+		sourceRoleIDs, err := detachRoles(tx, source.ID)
+		if err != nil {
+			return err
+		}
+		for _, roleID := range sourceRoleIDs {
+			if err := recordRoleAudit(tx, models.AuditUserRoleRemoved, source.UID, roleID); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Model(&models.EmailAlias{}).Where("user_id = ?", source.ID).Update("user_id", target.ID).Error; err != nil {
+			return err
+		}
+
+		// Pending invitations and reset links of the source must not revive it
+		now := time.Now()
+		if err := tx.Model(&models.Invitation{}).
+			Where("user_id = ? AND accepted_at IS NULL AND revoked_at IS NULL", source.ID).
+			Update("revoked_at", now).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.PasswordReset{}).
+			Where("user_id = ? AND used_at IS NULL", source.ID).
+			Update("used_at", now).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&models.User{}, source.ID).Error; err != nil {
+			return err
+		}
+
+		// The target and the reports it took over may now match other rules
+		var changedIDs []int
+		if err := tx.Model(&models.User{}).Where("id = ? OR manager_id = ?", target.ID, target.ID).
+			Pluck("id", &changedIDs).Error; err != nil {
+			return err
+		}
+		if err := syncDynamicGroups(tx, changedIDs...); err != nil {
+			return err
+		}
+
+		// The source's email is free once it is deleted, so it can become an
+		// alias of the target
+		if err := tx.Create(&models.EmailAlias{
+			UID:        uuid.New(),
+			UserID:     target.ID,
+			Email:      source.Email,
+			VerifiedAt: source.EmailVerifiedAt,
+		}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.UserRedirect{}).Where("to_user_id = ?", source.ID).Update("to_user_id", target.ID).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.UserRedirect{
+			FromUID:  source.UID,
+			ToUserID: target.ID,
+			MergedAt: now,
+		}).Error
+	}); err != nil {
+		if isUniqueViolation(err) {
+			return models.Conflict("The source user's email is already in use")
+		}
+		return toErrorResponse(err)
+	}
+
+	return nil
+}
+
+// GetUserRedirect returns the UID of the user a merged user was folded into.
+func (r *userRepository) GetUserRedirect(uid string, ctx *gin.Context) (string, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return "", models.InternalServerError(err.Error())
+	}
+
+	var redirect models.UserRedirect
+	if err := db.WithContext(ctx).Preload("ToUser").Where("from_uid = ?", uid).First(&redirect).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return "", models.NotFound("User not found")
+		}
+		return "", models.InternalServerError(err.Error())
+	}
+	if redirect.ToUser.ID == 0 {
+		return "", models.NotFound("User not found")
+	}
+
+	return redirect.ToUser.UID.String(), nil
+}
+
+// takeOverRoles gives the target every role of the source, with the same
+// validity period, and makes the source's primary role the target's.
+func takeOverRoles(tx *gorm.DB, source *models.User, target *models.User) error {
+	if source.RoleID != nil && (target.RoleID == nil || *target.RoleID != *source.RoleID) {
+		if err := setPrimaryRole(tx, target, source.RoleID); err != nil {
+			return err
+		}
+	}
+
+	var roleIDs []int
+	if err := tx.Raw(`INSERT INTO user_roles (user_id, role_id, starts_at, expires_at, created_at)
+		SELECT ?, role_id, starts_at, expires_at, created_at FROM user_roles WHERE user_id = ?
+		ON CONFLICT DO NOTHING
+		RETURNING role_id`, target.ID, source.ID).Scan(&roleIDs).Error; err != nil {
+		return err
+	}
+	for _, roleID := range roleIDs {
+		if err := recordRoleAudit(tx, models.AuditUserRoleAssigned, target.UID, roleID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveReports hands the source's direct reports to the target. That closes a
+// cycle when the target's manager was among them, in which case the target
+// loses its manager.
+func moveReports(tx *gorm.DB, source *models.User, target *models.User) error {
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", hierarchyLockKey).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Model(&models.User{}).
+		Where("manager_id = ? AND id <> ?", source.ID, target.ID).
+		Update("manager_id", target.ID).Error; err != nil {
+		return err
+	}
+
+	if target.ManagerID == nil {
+		return nil
+	}
+
+	var reports []reportRow
+	if err := tx.Raw(reportsQuery, target.ID, maxHierarchyDepth, maxHierarchyDepth).Scan(&reports).Error; err != nil {
+		return err
+	}
+	var manager models.User
+	if err := tx.Unscoped().Select("uid").First(&manager, *target.ManagerID).Error; err != nil {
+		return err
+	}
+	for _, report := range reports {
+		if report.UID == manager.UID.String() {
+			return tx.Model(target).Update("manager_id", nil).Error
+		}
+	}
+	return nil
+}
+
+func latest(a, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.After(*a)) {
+		return b
+	}
+	return a
+}
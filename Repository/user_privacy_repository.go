@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// erasedName replaces the name of an erased user. The email becomes an
+// address under the reserved .invalid domain that stays unique per user.
+const erasedName = "Erased user"
+
+func erasedEmail(user *models.User) string {
+	return "erased-" + user.UID.String() + "@erased.invalid"
+}
+
+// ExportUser collects everything stored about a user, including a user in the
+// trash, and audits that it was exported.
+func (r *userRepository) ExportUser(uid string, ctx *gin.Context) (*dtos.UserDataExport, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	export := &dtos.UserDataExport{ExportedAt: time.Now()}
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		if err := tx.Unscoped().
+			Preload("Role").
+			Preload("Manager").
+			Preload("EmailAliases", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+			Where("uid = ?", uid).
+			First(&user).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return models.NotFound("User not found")
+			}
+			return models.InternalServerError(err.Error())
+		}
+
+		profile, err := userResponseSingle(tx, &user)
+		if err != nil {
+			return models.InternalServerError(err.Error())
+		}
+		export.Profile = *profile
+
+		export.Memberships = []dtos.GroupResponse{}
+		if err := tx.Table("groups").
+			Select("groups.uid::text AS uid, groups.name, groups_users_maps.valid_from, groups_users_maps.valid_until").
+			Joins("JOIN groups_users_maps ON groups_users_maps.groups_id = groups.id").
+			Where("groups_users_maps.users_id = ?", user.ID).
+			Order("groups.id").
+			Scan(&export.Memberships).Error; err != nil {
+			return models.InternalServerError(err.Error())
+		}
+
+		// Unlike the profile, this includes assignments that already expired
+		if err := tx.Preload("Role", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+			Where("user_id = ?", user.ID).
+			Order("created_at, role_id").
+			Find(&user.RoleAssignments).Error; err != nil {
+			return models.InternalServerError(err.Error())
+		}
+		export.Roles = userRoles(&user)
+
+		var signIns []models.SignIn
+		if err := tx.Where("user_id = ? OR (user_id IS NULL AND lower(trim(email)) IN ?)", user.ID, userEmails(&user)).
+			Order("created_at, id").
+			Find(&signIns).Error; err != nil {
+			return models.InternalServerError(err.Error())
+		}
+		export.SignIns = []dtos.SignInResponse{}
+		for _, signIn := range signIns {
+			export.SignIns = append(export.SignIns, dtos.SignInResponse{
+				Email:     signIn.Email,
+				IPAddress: signIn.IPAddress,
+				UserAgent: signIn.UserAgent,
+				Outcome:   signIn.Outcome,
+				CreatedAt: signIn.CreatedAt,
+			})
+		}
+
+		var invitations []models.Invitation
+		if err := tx.Where("user_id = ?", user.ID).Order("created_at, id").Find(&invitations).Error; err != nil {
+			return models.InternalServerError(err.Error())
+		}
+		export.Invitations = []dtos.InvitationResponse{}
+		for _, invitation := range invitations {
+			export.Invitations = append(export.Invitations, dtos.InvitationResponse{
+				UID:        invitation.UID.String(),
+				UserUID:    user.UID.String(),
+				Email:      invitation.Email,
+				Status:     string(invitation.Status(export.ExportedAt)),
+				ExpiresAt:  invitation.ExpiresAt,
+				AcceptedAt: invitation.AcceptedAt,
+				RevokedAt:  invitation.RevokedAt,
+				CreatedAt:  invitation.CreatedAt,
+			})
+		}
+
+		if export.AuditEntries, err = auditEntries(tx, models.AuditTargetUser, user.UID); err != nil {
+			return models.InternalServerError(err.Error())
+		}
+
+		if err := recordAudit(tx, models.AuditUserExported, models.AuditTargetUser, user.UID, nil); err != nil {
+			return models.InternalServerError("Failed to audit export: " + err.Error())
+		}
+		return nil
+	}); err != nil {
+		return nil, toErrorResponse(err)
+	}
+
+	return export, nil
+}
+
+// EraseUser irreversibly removes the personal data of a user, including a
+// user in the trash and the users merged into it. The row stays, so groups,
+// roles, reports and the audit log keep pointing at it, but it no longer says
+// who the user was. Sign-in attempts are kept without address, IP and user
+// agent. Email aliases, invitations and reset links are deleted.
+func (r *userRepository) EraseUser(uid string, ctx *gin.Context) (*dtos.ErasedUser, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var erased dtos.ErasedUser
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("EmailAliases").
+			Where("uid = ?", uid).
+			First(&user).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return models.NotFound("User not found")
+			}
+			return models.InternalServerError(err.Error())
+		}
+		if user.ErasedAt != nil {
+			return models.Conflict("User has already been erased")
+		}
+
+		// Users merged into this one are deleted but still hold their own data
+		var merged []models.User
+		if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("EmailAliases").
+			Where("uid IN (?) AND erased_at IS NULL",
+				tx.Model(&models.UserRedirect{}).Select("from_uid").Where("to_user_id = ?", user.ID)).
+			Order("id").
+			Find(&merged).Error; err != nil {
+			return models.InternalServerError(err.Error())
+		}
+
+		now := time.Now()
+		for _, erasable := range append([]models.User{user}, merged...) {
+			if erasable.AvatarKey != "" {
+				erased.AvatarKeys = append(erased.AvatarKeys, erasable.AvatarKey)
+			}
+			if err := eraseUserData(tx, &erasable, now); err != nil {
+				return models.InternalServerError("Failed to erase user: " + err.Error())
+			}
+		}
+
+		if err := recordAudit(tx, models.AuditUserErased, models.AuditTargetUser, user.UID, nil); err != nil {
+			return models.InternalServerError("Failed to audit erasure: " + err.Error())
+		}
+		if err := syncDynamicGroups(tx, user.ID); err != nil {
+			return models.InternalServerError("Failed to update dynamic groups: " + err.Error())
+		}
+		return nil
+	}); err != nil {
+		return nil, toErrorResponse(err)
+	}
+
+	return &erased, nil
+}
+
+// eraseUserData removes the personal data of a user loaded with its email
+// aliases, along with its aliases, invitations and reset links, and strips its
+// sign-in attempts.
+func eraseUserData(tx *gorm.DB, user *models.User, now time.Time) error {
+	if err := tx.Model(&models.SignIn{}).
+		Where("user_id = ? OR (user_id IS NULL AND lower(trim(email)) IN ?)", user.ID, userEmails(user)).
+		Updates(map[string]any{"email": "", "ip_address": "", "user_agent": ""}).Error; err != nil {
+		return err
+	}
+
+	for _, model := range []any{&models.EmailAlias{}, &models.Invitation{}, &models.PasswordReset{}} {
+		if err := tx.Where("user_id = ?", user.ID).Delete(model).Error; err != nil {
+			return err
+		}
+	}
+
+	email := erasedEmail(user)
+	if err := tx.Unscoped().Model(&models.User{}).Where("id = ?", user.ID).Updates(map[string]any{
+		"name":                   erasedName,
+		"email":                  email,
+		"email_normalized":       models.NormalizeEmail(email),
+		"status":                 models.UserStatusDeprovisioned,
+		"given_name":             "",
+		"family_name":            "",
+		"display_name":           "",
+		"phone_number":           "",
+		"locale":                 "",
+		"timezone":               "",
+		"title":                  "",
+		"avatar_key":             "",
+		"avatar_content_type":    "",
+		"email_verified_at":      nil,
+		"password_hash":          "",
+		"credentials_changed_at": now,
+		"last_login_at":          nil,
+		"last_seen_at":           nil,
+		"attributes":             "{}",
+		"erased_at":              now,
+	}).Error; err != nil {
+		return err
+	}
+
+	return tx.Model(&models.TrashEntry{}).
+		Where("entity_type = ? AND entity_id = ?", models.TrashTypeUser, user.ID).
+		Update("name", erasedName).Error
+}
+
+// userEmails lists the normalized addresses of a user loaded with its email
+// aliases.
+func userEmails(user *models.User) []string {
+	emails := []string{models.NormalizeEmail(user.Email)}
+	for _, alias := range user.EmailAliases {
+		emails = append(emails, alias.EmailNormalized)
+	}
+	return emails
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+func userProfile(user *models.User) dtos.UserProfile {
+	return dtos.UserProfile{
+		GivenName:   user.GivenName,
+		FamilyName:  user.FamilyName,
+		DisplayName: user.DisplayName,
+		PhoneNumber: user.PhoneNumber,
+		Locale:      user.Locale,
+		Timezone:    user.Timezone,
+		Title:       user.Title,
+	}
+}
+
+func setUserProfile(user *models.User, profile dtos.UserProfile) {
+	user.GivenName = profile.GivenName
+	user.FamilyName = profile.FamilyName
+	user.DisplayName = profile.DisplayName
+	user.PhoneNumber = profile.PhoneNumber
+	user.Locale = profile.Locale
+	user.Timezone = profile.Timezone
+	user.Title = profile.Title
+}
+
+func updateUserProfile(user *models.User, update dtos.UserProfileUpdate) {
+	fields := []struct {
+		value  *string
+		target *string
+	}{
+		{update.GivenName, &user.GivenName},
+		{update.FamilyName, &user.FamilyName},
+		{update.DisplayName, &user.DisplayName},
+		{update.PhoneNumber, &user.PhoneNumber},
+		{update.Locale, &user.Locale},
+		{update.Timezone, &user.Timezone},
+		{update.Title, &user.Title},
+	}
+	for _, field := range fields {
+		if field.value != nil {
+			*field.target = *field.value
+		}
+	}
+}
+
+func filterProfile(query *gorm.DB, profile dtos.UserProfile) *gorm.DB {
+	filters := map[string]string{
+		"given_name":   profile.GivenName,
+		"family_name":  profile.FamilyName,
+		"display_name": profile.DisplayName,
+		"phone_number": profile.PhoneNumber,
+		"locale":       profile.Locale,
+		"timezone":     profile.Timezone,
+		"title":        profile.Title,
+	}
+	for column, value := range filters {
+		if value != "" {
+			query = query.Where(column+" = ?", value)
+		}
+	}
+	return query
+}
+
+func avatarURL(user *models.User) string {
+	if user.AvatarKey == "" {
+		return ""
+	}
+	return "/users/" + user.UID.String() + "/avatar"
+}
+
+func (r *userRepository) GetUserAvatar(uid string, ctx *gin.Context) (*dtos.UserAvatar, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var user models.User
+	if err := db.WithContext(ctx).Select("avatar_key", "avatar_content_type").Where("uid = ?", uid).First(&user).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, models.NotFound("User not found")
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return &dtos.UserAvatar{Key: user.AvatarKey, ContentType: user.AvatarContentType}, nil
+}
+
+// SetUserAvatar points the user at a new avatar, or at none when avatar is
+// nil, and returns the one it replaced so its blob can be removed.
+func (r *userRepository) SetUserAvatar(uid string, avatar *dtos.UserAvatar, ctx *gin.Context) (*dtos.UserAvatar, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	if avatar == nil {
+		avatar = &dtos.UserAvatar{}
+	}
+
+	var previous dtos.UserAvatar
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("uid = ?", uid).First(&user).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return models.NotFound("User not found")
+			}
+			return err
+		}
+		previous = dtos.UserAvatar{Key: user.AvatarKey, ContentType: user.AvatarContentType}
+
+		return tx.Model(&user).Updates(map[string]interface{}{
+			"avatar_key":          avatar.Key,
+			"avatar_content_type": avatar.ContentType,
+		}).Error
+	}); err != nil {
+		return nil, toErrorResponse(err)
+	}
+
+	return &previous, nil
+}
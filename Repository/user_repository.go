@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"strings"
+	"time"
+
 	"github.com/gin-gonic/gin"
 	dtos "github.com/google-run-code/Domain/Dtos"
 	interfaces "github.com/google-run-code/Domain/Interfaces"
@@ -8,6 +11,7 @@ import (
 	"github.com/google-run-code/config"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type userRepository struct {
@@ -21,9 +25,14 @@ func NewUserRepository(dbConfig *config.PostgresConfig) interfaces.UserRepositor
 }
 
 func (r *userRepository) getDB(ctx *gin.Context) (*gorm.DB, error) {
-	dbName := ctx.GetString("dbName")
-	db, ok := r.dbConfig.GetDB(dbName)
-	if ok != nil {
+	value, _ := ctx.Get(models.TenantContextKey)
+	tenant, ok := value.(*models.Tenant)
+	if !ok {
+		return nil, models.InternalServerError("Tenant missing from request context")
+	}
+
+	db, err := r.dbConfig.GetDB(tenant.Name)
+	if err != nil {
 		return nil, models.InternalServerError("Failed to get database connection")
 	}
 
@@ -38,7 +47,7 @@ func (r *userRepository) GetAllUsers(ctx *gin.Context) ([]*dtos.UserResponseAll,
 	}
 
 	var users []*models.User
-	if err := db.WithContext(ctx).Preload("Groups").Preload("Role").Find(&users).Error; err != nil {
+	if err := db.WithContext(ctx).Preload("Groups").Preload("Role").Preload("Manager").Find(&users).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return []*dtos.UserResponseAll{}, nil
 		}
@@ -56,11 +65,17 @@ func (r *userRepository) GetAllUsers(ctx *gin.Context) ([]*dtos.UserResponseAll,
 		}
 
 		result = append(result, &dtos.UserResponseAll{
-			UID:    user.UID.String(),
-			Name:   user.Name,
-			Email:  user.Email,
-			Status: user.Status,
-			Role:   roleResponse,
+			UID:         user.UID.String(),
+			Name:        user.Name,
+			Email:       user.Email,
+			Status:      string(user.Status),
+			Attributes:  user.Attributes,
+			Role:        roleResponse,
+			Manager:     userReference(user.Manager),
+			AvatarURL:   avatarURL(user),
+			LastLoginAt: user.LastLoginAt,
+			LastSeenAt:  user.LastSeenAt,
+			UserProfile: userProfile(user),
 		})
 	}
 
@@ -76,8 +91,9 @@ func (r *userRepository) GetUserById(uid string, ctx *gin.Context) (*dtos.UserRe
 
 	var user models.User
 	if err := db.WithContext(ctx).
-		Preload("Groups").
 		Preload("Role").
+		Preload("Manager").
+		Preload("EmailAliases", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
 		Where("uid = ?", uid).
 		First(&user).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -86,6 +102,21 @@ func (r *userRepository) GetUserById(uid string, ctx *gin.Context) (*dtos.UserRe
 		return nil, models.InternalServerError(err.Error())
 	}
 
+	result, err := userResponseSingle(db.WithContext(ctx), &user)
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+// userResponseSingle describes a user loaded with its role, manager and email
+// aliases, together with its roles and groups.
+func userResponseSingle(db *gorm.DB, user *models.User) (*dtos.UserResponseSingle, error) {
+	if err := loadRoles(db, user); err != nil {
+		return nil, err
+	}
+
 	var roleRes *dtos.RoleResponse
 	if user.Role != nil {
 		roleRes = &dtos.RoleResponse{
@@ -95,19 +126,37 @@ func (r *userRepository) GetUserById(uid string, ctx *gin.Context) (*dtos.UserRe
 		}
 	}
 
+	roles, err := effectiveRoles(db, user)
+	if err != nil {
+		return nil, err
+	}
+
 	var result dtos.UserResponseSingle
 	result.UID = user.UID.String()
 	result.Name = user.Name
 	result.Email = user.Email
-	result.Status = user.Status
+	result.EmailVerified = user.EmailVerifiedAt != nil
+	result.EmailVerifiedAt = user.EmailVerifiedAt
+	result.Status = string(user.Status)
+	result.Attributes = user.Attributes
 	result.Role = roleRes
-
-	for _, group := range user.Groups {
-		result.Groups = append(result.Groups, dtos.GroupResponse{
-			UID:  group.UID.String(),
-			Name: group.Name,
-		})
+	result.Roles = roles
+	result.Rights = userRights(user.Status, roles)
+	result.Manager = userReference(user.Manager)
+	result.AvatarURL = avatarURL(user)
+	result.LastLoginAt = user.LastLoginAt
+	result.LastSeenAt = user.LastSeenAt
+	result.ErasedAt = user.ErasedAt
+	for _, alias := range user.EmailAliases {
+		result.EmailAliases = append(result.EmailAliases, emailAliasResponse(&alias))
+	}
+	result.UserProfile = userProfile(user)
+
+	groups, err := userGroups(db, user.ID)
+	if err != nil {
+		return nil, err
 	}
+	result.Groups = groups
 
 	return &result, nil
 }
@@ -120,7 +169,10 @@ func (r *userRepository) GetUserByEmail(email string, ctx *gin.Context) (*models
 	}
 
 	var user models.User
-	if err := db.WithContext(ctx).Preload("Groups").Preload("Role").Where("email = ?", email).First(&user).Error; err != nil {
+	normalized := models.NormalizeEmail(email)
+	if err := db.WithContext(ctx).Preload("Groups").Preload("Role").
+		Where("(email_normalized = ? OR id IN (SELECT user_id FROM email_aliases WHERE email_normalized = ? AND verified_at IS NOT NULL))", normalized, normalized).
+		First(&user).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, models.NotFound("User not found")
 		}
@@ -130,47 +182,32 @@ func (r *userRepository) GetUserByEmail(email string, ctx *gin.Context) (*models
 	return &user, nil
 }
 
-func (r *userRepository) GetUsersGroups(uid string, ctx *gin.Context) ([]*dtos.GroupResponse, *models.ErrorResponse) {
-	var user models.User
-	db, err := r.getDB(ctx)
+func (repo *userRepository) SearchUsers(searchFields dtos.SearchFields, ctx *gin.Context) ([]*dtos.UserResponseAll, *models.ErrorResponse) {
+	var users []*models.User
+	db, err := repo.getDB(ctx)
 
 	if err != nil {
 		return nil, models.InternalServerError(err.Error())
 	}
 
-	if err := db.WithContext(ctx).
-		Preload("Groups").
-		Where("uid = ?", uid).
-		First(&user).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, models.NotFound("User not found")
-		}
-		return nil, models.InternalServerError(err.Error())
-	}
+	pattern := "%" + searchFields.Name + "%"
+	query := db.Preload("Role").Preload("Manager").Where("name ILIKE ? OR display_name ILIKE ? OR given_name ILIKE ? OR family_name ILIKE ?", pattern, pattern, pattern, pattern)
+	query = filterProfile(query, searchFields.Profile)
 
-	var groups []*dtos.GroupResponse
-	for _, group := range user.Groups {
-		groups = append(groups, &dtos.GroupResponse{
-			UID:  group.UID.String(),
-			Name: group.Name,
-		})
+	for key, value := range searchFields.Attributes {
+		query = query.Where("attributes ->> ? = ?", key, value)
 	}
 
-	return groups, nil
-}
-
-func (repo *userRepository) SearchUsers(searchFields dtos.SearchFields, ctx *gin.Context) ([]*dtos.UserResponseAll, *models.ErrorResponse) {
-	var users []*models.User
-	db, err := repo.getDB(ctx)
-
-	if err != nil {
-		return nil, models.InternalServerError(err.Error())
+	if searchFields.InactiveDays > 0 {
+		query = query.Where("last_login_at IS NULL OR last_login_at < ?", time.Now().AddDate(0, 0, -searchFields.InactiveDays))
 	}
 
-	query := db.Preload("Role").Where("name ILIKE ?", "%"+searchFields.Name+"%")
-
-	if searchFields.OrderBy != "" {
-		query = query.Order(searchFields.OrderBy)
+	// Only columns from the allow-list ever reach the query
+	if column, ok := dtos.UserOrderColumns[strings.TrimPrefix(searchFields.OrderBy, "-")]; ok {
+		query = query.Order(clause.OrderByColumn{
+			Column: clause.Column{Name: column},
+			Desc:   strings.HasPrefix(searchFields.OrderBy, "-"),
+		})
 	}
 
 	if searchFields.Limit > 0 {
@@ -194,17 +231,65 @@ func (repo *userRepository) SearchUsers(searchFields dtos.SearchFields, ctx *gin
 		}
 
 		result = append(result, &dtos.UserResponseAll{
-			UID:    user.UID.String(),
-			Name:   user.Name,
-			Email:  user.Email,
-			Status: user.Status,
-			Role:   roleResponse,
+			UID:         user.UID.String(),
+			Name:        user.Name,
+			Email:       user.Email,
+			Status:      string(user.Status),
+			Attributes:  user.Attributes,
+			Role:        roleResponse,
+			Manager:     userReference(user.Manager),
+			AvatarURL:   avatarURL(user),
+			LastLoginAt: user.LastLoginAt,
+			LastSeenAt:  user.LastSeenAt,
+			UserProfile: userProfile(user),
 		})
 	}
 
 	return result, nil
 }
 
+// createUser inserts a new user together with their primary role and manager.
+// Dynamic groups are left to the caller, which may add more to the user first.
+func createUser(tx *gorm.DB, req dtos.UserCreateRequest) (*models.User, *models.ErrorResponse) {
+	user := models.User{
+		UID:        uuid.New(),
+		Name:       req.Name,
+		Email:      req.Email,
+		Status:     models.UserStatus(req.Status),
+		Attributes: req.Attributes,
+	}
+	setUserProfile(&user, req.UserProfile)
+	if errResp := checkAliasConflict(tx, req.Email); errResp != nil {
+		return nil, errResp
+	}
+
+	if req.RoleId != "" {
+		role, errResp := findRole(tx, req.RoleId)
+		if errResp != nil {
+			return nil, errResp
+		}
+		user.RoleID = &role.ID
+	}
+
+	if err := tx.Omit(clause.Associations).Create(&user).Error; err != nil {
+		return nil, userWriteError(err)
+	}
+	if user.RoleID != nil {
+		if err := assignRole(tx, user.ID, *user.RoleID); err != nil {
+			return nil, models.InternalServerError("Failed to assign role: " + err.Error())
+		}
+		if err := recordRoleAudit(tx, models.AuditUserRoleAssigned, user.UID, *user.RoleID); err != nil {
+			return nil, models.InternalServerError("Failed to audit role assignment: " + err.Error())
+		}
+	}
+	if req.ManagerId != "" {
+		if errResp := assignManager(tx, &user, req.ManagerId); errResp != nil {
+			return nil, errResp
+		}
+	}
+	return &user, nil
+}
+
 func (r *userRepository) CreateUser(user dtos.UserCreateRequest, ctx *gin.Context) (*dtos.UserResponse, *models.ErrorResponse) {
 	db, err := r.getDB(ctx)
 
@@ -212,24 +297,101 @@ func (r *userRepository) CreateUser(user dtos.UserCreateRequest, ctx *gin.Contex
 		return nil, models.InternalServerError(err.Error())
 	}
 
-	newUser := models.User{
-		UID:    uuid.New(),
-		Name:   user.Name,
-		Email:  user.Email,
-		Status: user.Status,
-	}
-
-	if err := db.WithContext(ctx).Create(&newUser).Error; err != nil {
-		return nil, models.InternalServerError(err.Error())
+	var newUser *models.User
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		created, errResp := createUser(tx, user)
+		if errResp != nil {
+			return errResp
+		}
+		newUser = created
+		if err := syncDynamicGroups(tx, newUser.ID); err != nil {
+			return models.InternalServerError("Failed to update dynamic groups: " + err.Error())
+		}
+		return nil
+	}); err != nil {
+		return nil, toErrorResponse(err)
 	}
 	return &dtos.UserResponse{
 		UID:    newUser.UID.String(),
 		Name:   newUser.Name,
 		Email:  newUser.Email,
-		Status: newUser.Status,
+		Status: string(newUser.Status),
 	}, nil
 }
 
+func userWriteError(err error) *models.ErrorResponse {
+	if isUniqueViolation(err) {
+		return models.Conflict("User with this email already exists")
+	}
+	return models.InternalServerError(err.Error())
+}
+
+// userDetailColumns are the columns written when a user's details are updated.
+// The password, role, manager and activity columns have their own writers and
+// are left alone.
+var userDetailColumns = []string{
+	"name", "email", "email_normalized", "email_verified_at", "status", "attributes",
+	"given_name", "family_name", "display_name", "phone_number", "locale", "timezone", "title",
+}
+
+// updateUser applies the update to a user the caller has locked. Fields that
+// are not set keep their value.
+func updateUser(tx *gorm.DB, user *models.User, req *dtos.UserUpdateRequest) *models.ErrorResponse {
+	if user.ErasedAt != nil {
+		return models.Conflict("An erased user cannot be updated")
+	}
+
+	if req.Name != nil {
+		user.Name = *req.Name
+	}
+	if req.Email != nil {
+		if models.NormalizeEmail(*req.Email) != models.NormalizeEmail(user.Email) {
+			if errResp := checkAliasConflict(tx, *req.Email); errResp != nil {
+				return errResp
+			}
+			user.EmailVerifiedAt = nil
+		}
+		user.Email = *req.Email
+	}
+	// The status may have changed since the caller read it
+	if req.Status != nil && models.UserStatus(*req.Status) != user.Status {
+		if errResp := user.Status.CheckTransition(models.UserStatus(*req.Status)); errResp != nil {
+			return errResp
+		}
+		user.Status = models.UserStatus(*req.Status)
+	}
+	if req.Attributes != nil {
+		user.Attributes = req.Attributes
+	}
+	updateUserProfile(user, req.UserProfileUpdate)
+
+	if err := tx.Model(user).Select(userDetailColumns).Updates(user).Error; err != nil {
+		return userWriteError(err)
+	}
+	if req.RoleId != nil {
+		var roleID *int
+		if *req.RoleId != "" {
+			role, errResp := findRole(tx, *req.RoleId)
+			if errResp != nil {
+				return errResp
+			}
+			roleID = &role.ID
+		}
+		if err := setPrimaryRole(tx, user, roleID); err != nil {
+			return models.InternalServerError("Failed to update user role: " + err.Error())
+		}
+	}
+	if req.ManagerId != nil {
+		if errResp := assignManager(tx, user, *req.ManagerId); errResp != nil {
+			return errResp
+		}
+	}
+	if err := syncDynamicGroups(tx, user.ID); err != nil {
+		return models.InternalServerError("Failed to update dynamic groups: " + err.Error())
+	}
+	return nil
+}
+
 func (r *userRepository) UpdateUser(uid string, user *dtos.UserUpdateRequest, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
 	var existingUser models.User
 	db, err := r.getDB(ctx)
@@ -242,29 +404,45 @@ func (r *userRepository) UpdateUser(uid string, user *dtos.UserUpdateRequest, ct
 		return nil, models.InternalServerError("Invalid UUID format for UID")
 	}
 
-	if err := db.WithContext(ctx).
-		Where("uid = ?", uId).
-		Preload("Role").
-		First(&existingUser).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, models.NotFound("User not found")
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		locked, errResp := lockUser(tx, uId.String())
+		if errResp != nil {
+			return errResp
 		}
-		return nil, models.InternalServerError(err.Error())
+		existingUser = *locked
+		if errResp := updateUser(tx, &existingUser, user); errResp != nil {
+			return errResp
+		}
+		return nil
+	}); err != nil {
+		return nil, toErrorResponse(err)
 	}
 
-	existingUser.Name = *user.Name
-	existingUser.Email = *user.Email
-	existingUser.Status = *user.Status
+	if err := db.WithContext(ctx).Preload("Role").Preload("Manager").First(&existingUser, existingUser.ID).Error; err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
 
-	if err := db.WithContext(ctx).Save(&existingUser).Error; err != nil {
-		return nil, models.InternalServerError("Failed to update user: " + err.Error())
+	if err := loadRoles(db.WithContext(ctx), &existingUser); err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+	roles, err := effectiveRoles(db.WithContext(ctx), &existingUser)
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
 	}
 
 	res := &dtos.UserResponseSingle{
-		UID:    existingUser.UID.String(),
-		Name:   existingUser.Name,
-		Email:  existingUser.Email,
-		Status: existingUser.Status,
+		UID:             existingUser.UID.String(),
+		Name:            existingUser.Name,
+		Email:           existingUser.Email,
+		EmailVerified:   existingUser.EmailVerifiedAt != nil,
+		EmailVerifiedAt: existingUser.EmailVerifiedAt,
+		Status:          string(existingUser.Status),
+		Attributes:      existingUser.Attributes,
+		Roles:           roles,
+		Rights:          userRights(existingUser.Status, roles),
+		Manager:         userReference(existingUser.Manager),
+		AvatarURL:       avatarURL(&existingUser),
+		UserProfile:     userProfile(&existingUser),
 	}
 
 	if existingUser.Role != nil {
@@ -278,104 +456,145 @@ func (r *userRepository) UpdateUser(uid string, user *dtos.UserUpdateRequest, ct
 	return res, nil
 }
 
-func (r *userRepository) DeleteUser(uid string, ctx *gin.Context) *models.ErrorResponse {
-	var existingUser models.User
-	db, err := r.getDB(ctx)
+// deleteUser soft deletes a user the caller has locked. Their roles, groups
+// and owned groups are recorded in the trash so a restore can give them back.
+func deleteUser(tx *gorm.DB, user *models.User) *models.ErrorResponse {
+	snapshot := models.TrashSnapshot{RoleID: user.RoleID}
 
+	roleIDs, err := detachRoles(tx, user.ID)
 	if err != nil {
-		return models.InternalServerError(err.Error())
+		return models.InternalServerError("Failed to dissociate user from roles: " + err.Error())
 	}
+	snapshot.RoleIDs = roleIDs
 
-	if err := db.WithContext(ctx).
-		Where("uid = ?", uid).
-		First(&existingUser).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return models.NotFound("User not found")
-		}
-		return models.InternalServerError(err.Error())
+	memberships, err := detachMemberships(tx, "users_id", user.ID)
+	if err != nil {
+		return models.InternalServerError("Failed to dissociate user from groups: " + err.Error())
 	}
+	snapshot.Memberships = memberships
 
-	if err := db.WithContext(ctx).
-		Model(&existingUser).
-		Association("Groups").Clear(); err != nil {
-		return models.InternalServerError("Failed to dissociate user from groups: " + err.Error())
+	ownedGroupIDs, errResp := releaseOwnerships(tx, user)
+	if errResp != nil {
+		return errResp
 	}
+	snapshot.OwnedGroupIDs = ownedGroupIDs
 
-	// Delete the user
-	if err := db.WithContext(ctx).
-		Delete(&models.User{}, existingUser.ID).Error; err != nil {
-		return models.InternalServerError("Failed to delete user: " + err.Error())
+	if err := moveToTrash(tx, models.TrashTypeUser, user.ID, user.UID, user.Name, snapshot); err != nil {
+		return models.InternalServerError("Failed to record deleted user: " + err.Error())
+	}
+
+	if err := detachReports(tx, user.ID); err != nil {
+		return models.InternalServerError("Failed to detach reports: " + err.Error())
 	}
 
+	// Soft delete the user
+	if err := tx.Delete(&models.User{}, user.ID).Error; err != nil {
+		return models.InternalServerError("Failed to delete user: " + err.Error())
+	}
 	return nil
 }
 
-func (r *userRepository) AddUserToGroup(req dtos.AddUserToGroupRequest, ctx *gin.Context) *models.ErrorResponse {
+func (r *userRepository) DeleteUser(uid string, ctx *gin.Context) *models.ErrorResponse {
 	db, err := r.getDB(ctx)
 
 	if err != nil {
 		return models.InternalServerError(err.Error())
 	}
 
-	var user models.User
-	if err := db.WithContext(ctx).
-		Where("uid = ?", req.UserUID).
-		First(&user).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return models.NotFound("User not found")
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		user, errResp := lockUser(tx, uid)
+		if errResp != nil {
+			return errResp
 		}
-		return models.InternalServerError(err.Error())
-	}
-
-	var groups []models.Group
-	if err := db.WithContext(ctx).
-		Where("uid IN ?", req.GroupIds).
-		Find(&groups).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return models.NotFound("One or more groups not found")
+		if errResp := deleteUser(tx, user); errResp != nil {
+			return errResp
 		}
-		return models.InternalServerError(err.Error())
-	}
-
-	if err := db.WithContext(ctx).Model(&user).Association("Groups").Append(groups); err != nil {
-		return models.InternalServerError(err.Error())
+		return nil
+	}); err != nil {
+		return toErrorResponse(err)
 	}
 
 	return nil
 }
 
-func (repo *userRepository) AddUserToRole(req dtos.AddUserToRoleRequest, ctx *gin.Context) *models.ErrorResponse {
-	var user models.User
-	var role models.Role
-
-	db, err := repo.getDB(ctx)
+func (r *userRepository) RestoreUser(uid string, ctx *gin.Context) *models.ErrorResponse {
+	var deletedUser models.User
+	db, err := r.getDB(ctx)
 
 	if err != nil {
 		return models.InternalServerError(err.Error())
 	}
 
-	if err := db.WithContext(ctx).
-		Where("uid = ?", req.RoleId).
-		First(&role).Error; err != nil {
+	if err := db.WithContext(ctx).Unscoped().
+		Where("uid = ? AND deleted_at IS NOT NULL", uid).
+		First(&deletedUser).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &models.ErrorResponse{Message: "Role not found", Code: 404}
+			return models.NotFound("Deleted user not found")
 		}
-		return &models.ErrorResponse{Message: "Failed to fetch role: " + err.Error(), Code: 500}
+		return models.InternalServerError(err.Error())
 	}
 
-	if err := db.WithContext(ctx).
-		Where("uid = ?", req.UserUID).
-		First(&user).Error; err != nil {
-		return &models.ErrorResponse{Message: "User not found", Code: 404}
+	var count int64
+	if err := db.WithContext(ctx).Model(&models.UserRedirect{}).
+		Where("from_uid = ?", deletedUser.UID).
+		Count(&count).Error; err != nil {
+		return models.InternalServerError(err.Error())
+	}
+	if count > 0 {
+		return models.Conflict("The user was merged into another user and cannot be restored")
 	}
 
-	if user.RoleID != nil && *user.RoleID == role.ID {
-		return &models.ErrorResponse{Message: "User already has the specified role", Code: 400}
+	if err := db.WithContext(ctx).Model(&models.User{}).
+		Where("email_normalized = ?", models.NormalizeEmail(deletedUser.Email)).
+		Count(&count).Error; err != nil {
+		return models.InternalServerError(err.Error())
+	}
+	if count > 0 {
+		return models.Conflict("Another user with this email already exists")
 	}
+	if errResp := checkAliasConflict(db.WithContext(ctx), deletedUser.Email); errResp != nil {
+		return errResp
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		snapshot, err := takeFromTrash(tx, models.TrashTypeUser, deletedUser.ID)
+		if err != nil {
+			return models.InternalServerError("Failed to read deleted user snapshot: " + err.Error())
+		}
 
-	user.RoleID = &role.ID
-	if err := db.WithContext(ctx).Save(&user).Error; err != nil {
-		return &models.ErrorResponse{Message: "Failed to update user role: " + err.Error(), Code: 500}
+		if err := tx.Unscoped().Model(&deletedUser).Update("deleted_at", nil).Error; err != nil {
+			return models.InternalServerError("Failed to restore user: " + err.Error())
+		}
+
+		// The manager may have been deleted in the meantime
+		if deletedUser.ManagerID != nil {
+			if err := tx.Model(&deletedUser).
+				Where("NOT EXISTS (SELECT 1 FROM users m WHERE m.id = ? AND m.deleted_at IS NULL)", *deletedUser.ManagerID).
+				Update("manager_id", nil).Error; err != nil {
+				return models.InternalServerError("Failed to restore user manager: " + err.Error())
+			}
+		}
+
+		// Only relationships whose group or role still exists are restored
+		if err := restoreMemberships(tx, snapshotMemberships(snapshot, deletedUser.ID, 0)); err != nil {
+			return models.InternalServerError("Failed to restore group memberships: " + err.Error())
+		}
+
+		if err := restoreRoles(tx, deletedUser.ID, snapshot); err != nil {
+			return models.InternalServerError("Failed to restore user roles: " + err.Error())
+		}
+
+		if err := restoreOwnerships(tx, &deletedUser, snapshot); err != nil {
+			return models.InternalServerError("Failed to restore group ownerships: " + err.Error())
+		}
+
+		// Memberships of dynamic groups follow their rules rather than the snapshot
+		if err := syncDynamicGroups(tx, deletedUser.ID); err != nil {
+			return models.InternalServerError("Failed to update dynamic groups: " + err.Error())
+		}
+		return nil
+	}); err != nil {
+		return toErrorResponse(err)
 	}
 
 	return nil
@@ -410,17 +629,54 @@ func (repo *userRepository) RemoveUserFromGroups(userUID string, groupUIDs []str
 	return nil
 }
 
-func (r *userRepository) RemoveUserRole(userID string, ctx *gin.Context) *models.ErrorResponse {
+func (r *userRepository) UpdateUserStatus(uid string, status models.UserStatus, ctx *gin.Context) *models.ErrorResponse {
 	db, err := r.getDB(ctx)
 
 	if err != nil {
 		return models.InternalServerError(err.Error())
 	}
 
-	if err := db.WithContext(ctx).Model(&models.User{}).
-		Where("uid = ?", userID).
-		Update("role_id", nil).Error; err != nil {
-		return models.InternalServerError("Failed to set user's role to NULL: " + err.Error())
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		user, errResp := lockUser(tx, uid)
+		if errResp != nil {
+			return errResp
+		}
+		if user.Status == status {
+			return models.Conflict("User is already " + string(status))
+		}
+		if errResp := user.Status.CheckTransition(status); errResp != nil {
+			return errResp
+		}
+		if err := tx.Model(user).Update("status", status).Error; err != nil {
+			return models.InternalServerError("Failed to update user status: " + err.Error())
+		}
+		if err := syncDynamicGroups(tx, user.ID); err != nil {
+			return models.InternalServerError("Failed to update dynamic groups: " + err.Error())
+		}
+		return nil
+	}); err != nil {
+		return toErrorResponse(err)
+	}
+
+	return nil
+}
+
+// MarkEmailVerified only succeeds while the user still has the verified address.
+func (r *userRepository) MarkEmailVerified(uid string, email string, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	result := db.WithContext(ctx).Model(&models.User{}).
+		Where("uid = ? AND email_normalized = ?", uid, models.NormalizeEmail(email)).
+		Update("email_verified_at", time.Now())
+	if result.Error != nil {
+		return models.InternalServerError("Failed to verify email: " + result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return models.Conflict("The email address has changed since the link was sent")
 	}
 
 	return nil
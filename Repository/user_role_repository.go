@@ -0,0 +1,354 @@
+package repository
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// expiryBatchSize caps the assignments one sweep removes per transaction.
+const expiryBatchSize = 1000
+
+// loadRoles loads the roles the user holds, in assignment order. Expired
+// assignments the sweeper has not removed yet are left out.
+func loadRoles(db *gorm.DB, user *models.User) error {
+	return db.Preload("Role").
+		Where("user_id = ? AND (expires_at IS NULL OR expires_at > now())", user.ID).
+		Order("created_at, role_id").
+		Find(&user.RoleAssignments).Error
+}
+
+// userRoles lists the roles of a user loaded with loadRoles, primary role
+// first.
+func userRoles(user *models.User) []dtos.UserRoleResponse {
+	now := time.Now()
+	roles := []dtos.UserRoleResponse{}
+	for _, assignment := range user.RoleAssignments {
+		if assignment.Role == nil {
+			continue
+		}
+		roles = append(roles, dtos.UserRoleResponse{
+			UID:        assignment.Role.UID.String(),
+			Name:       assignment.Role.Name,
+			Rights:     assignment.Role.Rights,
+			Primary:    user.RoleID != nil && *user.RoleID == assignment.RoleID,
+			Active:     assignment.ActiveAt(now),
+			StartsAt:   assignment.StartsAt,
+			ExpiresAt:  assignment.ExpiresAt,
+			AssignedAt: assignment.CreatedAt,
+			Source:     models.RoleSourceDirect,
+		})
+	}
+	sort.SliceStable(roles, func(i, j int) bool { return roles[i].Primary && !roles[j].Primary })
+	return roles
+}
+
+// userRights combines the rights of the roles that are active now. A user
+// whose status does not grant access has no rights, whatever their roles.
+func userRights(status models.UserStatus, roles []dtos.UserRoleResponse) json.RawMessage {
+	if !status.GrantsAccess() {
+		return nil
+	}
+	var rights []json.RawMessage
+	for _, role := range roles {
+		if role.Active {
+			rights = append(rights, role.Rights)
+		}
+	}
+	return models.MergeRights(rights...)
+}
+
+// assignRole gives the user the role. Holding it already is not an error.
+func assignRole(tx *gorm.DB, userID int, roleID int) error {
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.UserRole{UserID: userID, RoleID: roleID}).Error
+}
+
+// setPrimaryRole makes roleID the user's primary role and replaces the
+// assignment of the previous primary role. The user keeps their other roles.
+// A nil roleID only removes the primary role.
+func setPrimaryRole(tx *gorm.DB, user *models.User, roleID *int) error {
+	if user.RoleID != nil && (roleID == nil || *roleID != *user.RoleID) {
+		if err := tx.Where("user_id = ? AND role_id = ?", user.ID, *user.RoleID).
+			Delete(&models.UserRole{}).Error; err != nil {
+			return err
+		}
+		if err := recordRoleAudit(tx, models.AuditUserRoleRemoved, user.UID, *user.RoleID); err != nil {
+			return err
+		}
+	}
+	if roleID != nil {
+		if err := assignRole(tx, user.ID, *roleID); err != nil {
+			return err
+		}
+		if user.RoleID == nil || *roleID != *user.RoleID {
+			if err := recordRoleAudit(tx, models.AuditUserRoleAssigned, user.UID, *roleID); err != nil {
+				return err
+			}
+		}
+	}
+
+	user.RoleID = roleID
+	return tx.Model(&models.User{}).Where("id = ?", user.ID).Update("role_id", roleID).Error
+}
+
+// detachRoles removes every role of a user and returns the roles it held.
+func detachRoles(tx *gorm.DB, userID int) ([]int, error) {
+	var roleIDs []int
+	if err := tx.Model(&models.UserRole{}).Where("user_id = ?", userID).Order("role_id").
+		Pluck("role_id", &roleIDs).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Where("user_id = ?", userID).Delete(&models.UserRole{}).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Model(&models.User{}).Where("id = ?", userID).Update("role_id", nil).Error; err != nil {
+		return nil, err
+	}
+	return roleIDs, nil
+}
+
+// restoreRoles gives a restored user back the roles recorded in the snapshot
+// that still exist. Snapshots taken before users could hold several roles
+// only have the primary role.
+func restoreRoles(tx *gorm.DB, userID int, snapshot *models.TrashSnapshot) error {
+	roleIDs := snapshot.RoleIDs
+	if snapshot.RoleID != nil {
+		roleIDs = append(roleIDs, *snapshot.RoleID)
+	}
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	var existing []int
+	if err := tx.Model(&models.Role{}).Where("id IN ?", roleIDs).Pluck("id", &existing).Error; err != nil {
+		return err
+	}
+	for _, roleID := range existing {
+		if err := assignRole(tx, userID, roleID); err != nil {
+			return err
+		}
+		if snapshot.RoleID != nil && *snapshot.RoleID == roleID {
+			if err := tx.Model(&models.User{}).Where("id = ?", userID).Update("role_id", roleID).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func findRole(tx *gorm.DB, roleUID string) (*models.Role, *models.ErrorResponse) {
+	var role models.Role
+	if err := tx.Where("uid = ?", roleUID).First(&role).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, models.NotFound("Role not found")
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+	return &role, nil
+}
+
+func lockUser(tx *gorm.DB, userUID string) (*models.User, *models.ErrorResponse) {
+	var user models.User
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("uid = ?", userUID).First(&user).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, models.NotFound("User not found")
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+	return &user, nil
+}
+
+func (r *userRepository) GetUserRoles(uid string, ctx *gin.Context) ([]dtos.UserRoleResponse, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var user models.User
+	if err := db.WithContext(ctx).Where("uid = ?", uid).First(&user).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, models.NotFound("User not found")
+		}
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	if err := loadRoles(db.WithContext(ctx), &user); err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	return userRoles(&user), nil
+}
+
+// AddUserRole gives the user another role, or changes the bounds of a role
+// they already hold. With primary set the role also becomes the primary role,
+// and the previous primary role stays assigned.
+func (r *userRepository) AddUserRole(uid string, req dtos.UserRoleAssignRequest, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		user, errResp := lockUser(tx, uid)
+		if errResp != nil {
+			return errResp
+		}
+		role, errResp := findRole(tx, req.RoleId)
+		if errResp != nil {
+			return errResp
+		}
+
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "role_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"starts_at", "expires_at"}),
+		}).Create(&models.UserRole{
+			UserID:    user.ID,
+			RoleID:    role.ID,
+			StartsAt:  req.StartsAt,
+			ExpiresAt: req.ExpiresAt,
+		}).Error; err != nil {
+			return models.InternalServerError("Failed to assign role: " + err.Error())
+		}
+		if err := recordRoleAudit(tx, models.AuditUserRoleAssigned, user.UID, role.ID); err != nil {
+			return models.InternalServerError("Failed to audit role assignment: " + err.Error())
+		}
+
+		if req.Primary {
+			if err := tx.Model(user).Update("role_id", role.ID).Error; err != nil {
+				return models.InternalServerError("Failed to update user role: " + err.Error())
+			}
+		}
+		if err := syncDynamicGroups(tx, user.ID); err != nil {
+			return models.InternalServerError("Failed to update dynamic groups: " + err.Error())
+		}
+		return nil
+	}); err != nil {
+		return toErrorResponse(err)
+	}
+
+	return nil
+}
+
+// RemoveUserRole takes a role away from the user. Removing the primary role
+// leaves the user without one.
+func (r *userRepository) RemoveUserRole(uid string, roleUID string, ctx *gin.Context) *models.ErrorResponse {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return models.InternalServerError(err.Error())
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		user, errResp := lockUser(tx, uid)
+		if errResp != nil {
+			return errResp
+		}
+		role, errResp := findRole(tx, roleUID)
+		if errResp != nil {
+			return errResp
+		}
+
+		result := tx.Where("user_id = ? AND role_id = ?", user.ID, role.ID).Delete(&models.UserRole{})
+		if result.Error != nil {
+			return models.InternalServerError("Failed to remove role: " + result.Error.Error())
+		}
+		if result.RowsAffected == 0 {
+			return models.NotFound("User does not have the specified role")
+		}
+		if err := recordRoleAudit(tx, models.AuditUserRoleRemoved, user.UID, role.ID); err != nil {
+			return models.InternalServerError("Failed to audit role removal: " + err.Error())
+		}
+
+		if user.RoleID != nil && *user.RoleID == role.ID {
+			if err := tx.Model(user).Update("role_id", nil).Error; err != nil {
+				return models.InternalServerError("Failed to update user role: " + err.Error())
+			}
+		}
+		if err := syncDynamicGroups(tx, user.ID); err != nil {
+			return models.InternalServerError("Failed to update dynamic groups: " + err.Error())
+		}
+		return nil
+	}); err != nil {
+		return toErrorResponse(err)
+	}
+
+	return nil
+}
+
+// ExpireRoleAssignments removes up to expiryBatchSize assignments that expired
+// before now and returns them. Users lose their primary role when it expires.
+// Concurrent sweeps skip each other's rows.
+func (r *userRepository) ExpireRoleAssignments(now time.Time, ctx *gin.Context) ([]dtos.ExpiredRoleAssignment, *models.ErrorResponse) {
+	db, err := r.getDB(ctx)
+
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+
+	var expired []dtos.ExpiredRoleAssignment
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Raw(`
+			WITH expired AS (
+				DELETE FROM user_roles
+				WHERE (user_id, role_id) IN (
+					SELECT user_id, role_id FROM user_roles
+					WHERE expires_at <= ?
+					ORDER BY expires_at
+					LIMIT ?
+					FOR UPDATE SKIP LOCKED)
+				RETURNING user_id, role_id, expires_at
+			), cleared AS (
+				UPDATE users u SET role_id = NULL
+				FROM expired e
+				WHERE u.id = e.user_id AND u.role_id = e.role_id
+				RETURNING u.id, e.role_id
+			)
+			SELECT u.uid::text AS user_uid, r.uid::text AS role_uid, r.name AS role_name,
+			       c.id IS NOT NULL AS "primary", e.expires_at
+			FROM expired e
+			JOIN users u ON u.id = e.user_id
+			JOIN roles r ON r.id = e.role_id
+			LEFT JOIN cleared c ON c.id = e.user_id AND c.role_id = e.role_id
+			ORDER BY e.expires_at`, now, expiryBatchSize).
+			Scan(&expired).Error; err != nil {
+			return models.InternalServerError("Failed to expire role assignments: " + err.Error())
+		}
+		var userUIDs []string
+		for _, assignment := range expired {
+			if err := recordAudit(tx, models.AuditUserRoleExpired, models.AuditTargetUser, uuid.MustParse(assignment.UserUID), map[string]any{
+				"role_id":    assignment.RoleUID,
+				"role_name":  assignment.RoleName,
+				"expires_at": assignment.ExpiresAt,
+			}); err != nil {
+				return models.InternalServerError("Failed to audit role expiry: " + err.Error())
+			}
+			userUIDs = append(userUIDs, assignment.UserUID)
+		}
+
+		if len(userUIDs) > 0 {
+			var userIDs []int
+			if err := tx.Model(&models.User{}).Where("uid IN ?", userUIDs).Pluck("id", &userIDs).Error; err != nil {
+				return models.InternalServerError(err.Error())
+			}
+			if err := syncDynamicGroups(tx, userIDs...); err != nil {
+				return models.InternalServerError("Failed to update dynamic groups: " + err.Error())
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, toErrorResponse(err)
+	}
+
+	return expired, nil
+}
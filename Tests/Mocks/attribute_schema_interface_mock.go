@@ -0,0 +1,259 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: Domain/Interfaces/attribute_schema_interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	json "encoding/json"
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// MockAttributeValidator is a mock of AttributeValidator interface.
+type MockAttributeValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttributeValidatorMockRecorder
+}
+
+// MockAttributeValidatorMockRecorder is the mock recorder for MockAttributeValidator.
+type MockAttributeValidatorMockRecorder struct {
+	mock *MockAttributeValidator
+}
+
+// NewMockAttributeValidator creates a new mock instance.
+func NewMockAttributeValidator(ctrl *gomock.Controller) *MockAttributeValidator {
+	mock := &MockAttributeValidator{ctrl: ctrl}
+	mock.recorder = &MockAttributeValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttributeValidator) EXPECT() *MockAttributeValidatorMockRecorder {
+	return m.recorder
+}
+
+// CheckSchema mocks base method.
+func (m *MockAttributeValidator) CheckSchema(schema json.RawMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSchema", schema)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckSchema indicates an expected call of CheckSchema.
+func (mr *MockAttributeValidatorMockRecorder) CheckSchema(schema interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSchema", reflect.TypeOf((*MockAttributeValidator)(nil).CheckSchema), schema)
+}
+
+// Validate mocks base method.
+func (m *MockAttributeValidator) Validate(schema, attributes json.RawMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", schema, attributes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockAttributeValidatorMockRecorder) Validate(schema, attributes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockAttributeValidator)(nil).Validate), schema, attributes)
+}
+
+// MockAttributeSchemaController is a mock of AttributeSchemaController interface.
+type MockAttributeSchemaController struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttributeSchemaControllerMockRecorder
+}
+
+// MockAttributeSchemaControllerMockRecorder is the mock recorder for MockAttributeSchemaController.
+type MockAttributeSchemaControllerMockRecorder struct {
+	mock *MockAttributeSchemaController
+}
+
+// NewMockAttributeSchemaController creates a new mock instance.
+func NewMockAttributeSchemaController(ctrl *gomock.Controller) *MockAttributeSchemaController {
+	mock := &MockAttributeSchemaController{ctrl: ctrl}
+	mock.recorder = &MockAttributeSchemaControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttributeSchemaController) EXPECT() *MockAttributeSchemaControllerMockRecorder {
+	return m.recorder
+}
+
+// DeleteAttributeSchema mocks base method.
+func (m *MockAttributeSchemaController) DeleteAttributeSchema(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteAttributeSchema", c)
+}
+
+// DeleteAttributeSchema indicates an expected call of DeleteAttributeSchema.
+func (mr *MockAttributeSchemaControllerMockRecorder) DeleteAttributeSchema(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttributeSchema", reflect.TypeOf((*MockAttributeSchemaController)(nil).DeleteAttributeSchema), c)
+}
+
+// GetAttributeSchema mocks base method.
+func (m *MockAttributeSchemaController) GetAttributeSchema(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetAttributeSchema", c)
+}
+
+// GetAttributeSchema indicates an expected call of GetAttributeSchema.
+func (mr *MockAttributeSchemaControllerMockRecorder) GetAttributeSchema(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributeSchema", reflect.TypeOf((*MockAttributeSchemaController)(nil).GetAttributeSchema), c)
+}
+
+// SaveAttributeSchema mocks base method.
+func (m *MockAttributeSchemaController) SaveAttributeSchema(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SaveAttributeSchema", c)
+}
+
+// SaveAttributeSchema indicates an expected call of SaveAttributeSchema.
+func (mr *MockAttributeSchemaControllerMockRecorder) SaveAttributeSchema(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAttributeSchema", reflect.TypeOf((*MockAttributeSchemaController)(nil).SaveAttributeSchema), c)
+}
+
+// MockAttributeSchemaUseCase is a mock of AttributeSchemaUseCase interface.
+type MockAttributeSchemaUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttributeSchemaUseCaseMockRecorder
+}
+
+// MockAttributeSchemaUseCaseMockRecorder is the mock recorder for MockAttributeSchemaUseCase.
+type MockAttributeSchemaUseCaseMockRecorder struct {
+	mock *MockAttributeSchemaUseCase
+}
+
+// NewMockAttributeSchemaUseCase creates a new mock instance.
+func NewMockAttributeSchemaUseCase(ctrl *gomock.Controller) *MockAttributeSchemaUseCase {
+	mock := &MockAttributeSchemaUseCase{ctrl: ctrl}
+	mock.recorder = &MockAttributeSchemaUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttributeSchemaUseCase) EXPECT() *MockAttributeSchemaUseCaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteAttributeSchema mocks base method.
+func (m *MockAttributeSchemaUseCase) DeleteAttributeSchema(ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttributeSchema", ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// DeleteAttributeSchema indicates an expected call of DeleteAttributeSchema.
+func (mr *MockAttributeSchemaUseCaseMockRecorder) DeleteAttributeSchema(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttributeSchema", reflect.TypeOf((*MockAttributeSchemaUseCase)(nil).DeleteAttributeSchema), ctx)
+}
+
+// GetAttributeSchema mocks base method.
+func (m *MockAttributeSchemaUseCase) GetAttributeSchema(ctx *gin.Context) (*dtos.AttributeSchemaResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttributeSchema", ctx)
+	ret0, _ := ret[0].(*dtos.AttributeSchemaResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetAttributeSchema indicates an expected call of GetAttributeSchema.
+func (mr *MockAttributeSchemaUseCaseMockRecorder) GetAttributeSchema(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributeSchema", reflect.TypeOf((*MockAttributeSchemaUseCase)(nil).GetAttributeSchema), ctx)
+}
+
+// SaveAttributeSchema mocks base method.
+func (m *MockAttributeSchemaUseCase) SaveAttributeSchema(req dtos.AttributeSchemaRequest, ctx *gin.Context) (*dtos.AttributeSchemaResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAttributeSchema", req, ctx)
+	ret0, _ := ret[0].(*dtos.AttributeSchemaResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// SaveAttributeSchema indicates an expected call of SaveAttributeSchema.
+func (mr *MockAttributeSchemaUseCaseMockRecorder) SaveAttributeSchema(req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAttributeSchema", reflect.TypeOf((*MockAttributeSchemaUseCase)(nil).SaveAttributeSchema), req, ctx)
+}
+
+// MockAttributeSchemaRepository is a mock of AttributeSchemaRepository interface.
+type MockAttributeSchemaRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttributeSchemaRepositoryMockRecorder
+}
+
+// MockAttributeSchemaRepositoryMockRecorder is the mock recorder for MockAttributeSchemaRepository.
+type MockAttributeSchemaRepositoryMockRecorder struct {
+	mock *MockAttributeSchemaRepository
+}
+
+// NewMockAttributeSchemaRepository creates a new mock instance.
+func NewMockAttributeSchemaRepository(ctrl *gomock.Controller) *MockAttributeSchemaRepository {
+	mock := &MockAttributeSchemaRepository{ctrl: ctrl}
+	mock.recorder = &MockAttributeSchemaRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttributeSchemaRepository) EXPECT() *MockAttributeSchemaRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteAttributeSchema mocks base method.
+func (m *MockAttributeSchemaRepository) DeleteAttributeSchema(ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttributeSchema", ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// DeleteAttributeSchema indicates an expected call of DeleteAttributeSchema.
+func (mr *MockAttributeSchemaRepositoryMockRecorder) DeleteAttributeSchema(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttributeSchema", reflect.TypeOf((*MockAttributeSchemaRepository)(nil).DeleteAttributeSchema), ctx)
+}
+
+// GetAttributeSchema mocks base method.
+func (m *MockAttributeSchemaRepository) GetAttributeSchema(ctx *gin.Context) (*dtos.AttributeSchemaResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttributeSchema", ctx)
+	ret0, _ := ret[0].(*dtos.AttributeSchemaResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetAttributeSchema indicates an expected call of GetAttributeSchema.
+func (mr *MockAttributeSchemaRepositoryMockRecorder) GetAttributeSchema(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributeSchema", reflect.TypeOf((*MockAttributeSchemaRepository)(nil).GetAttributeSchema), ctx)
+}
+
+// SaveAttributeSchema mocks base method.
+func (m *MockAttributeSchemaRepository) SaveAttributeSchema(schema json.RawMessage, ctx *gin.Context) (*dtos.AttributeSchemaResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAttributeSchema", schema, ctx)
+	ret0, _ := ret[0].(*dtos.AttributeSchemaResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// SaveAttributeSchema indicates an expected call of SaveAttributeSchema.
+func (mr *MockAttributeSchemaRepositoryMockRecorder) SaveAttributeSchema(schema, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAttributeSchema", reflect.TypeOf((*MockAttributeSchemaRepository)(nil).SaveAttributeSchema), schema, ctx)
+}
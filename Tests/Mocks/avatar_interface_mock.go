@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: Domain/Interfaces/avatar_interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	io "io"
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// MockAvatarController is a mock of AvatarController interface.
+type MockAvatarController struct {
+	ctrl     *gomock.Controller
+	recorder *MockAvatarControllerMockRecorder
+}
+
+// MockAvatarControllerMockRecorder is the mock recorder for MockAvatarController.
+type MockAvatarControllerMockRecorder struct {
+	mock *MockAvatarController
+}
+
+// NewMockAvatarController creates a new mock instance.
+func NewMockAvatarController(ctrl *gomock.Controller) *MockAvatarController {
+	mock := &MockAvatarController{ctrl: ctrl}
+	mock.recorder = &MockAvatarControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAvatarController) EXPECT() *MockAvatarControllerMockRecorder {
+	return m.recorder
+}
+
+// DeleteAvatar mocks base method.
+func (m *MockAvatarController) DeleteAvatar(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteAvatar", c)
+}
+
+// DeleteAvatar indicates an expected call of DeleteAvatar.
+func (mr *MockAvatarControllerMockRecorder) DeleteAvatar(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAvatar", reflect.TypeOf((*MockAvatarController)(nil).DeleteAvatar), c)
+}
+
+// GetAvatar mocks base method.
+func (m *MockAvatarController) GetAvatar(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetAvatar", c)
+}
+
+// GetAvatar indicates an expected call of GetAvatar.
+func (mr *MockAvatarControllerMockRecorder) GetAvatar(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvatar", reflect.TypeOf((*MockAvatarController)(nil).GetAvatar), c)
+}
+
+// UploadAvatar mocks base method.
+func (m *MockAvatarController) UploadAvatar(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UploadAvatar", c)
+}
+
+// UploadAvatar indicates an expected call of UploadAvatar.
+func (mr *MockAvatarControllerMockRecorder) UploadAvatar(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAvatar", reflect.TypeOf((*MockAvatarController)(nil).UploadAvatar), c)
+}
+
+// MockAvatarUseCase is a mock of AvatarUseCase interface.
+type MockAvatarUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAvatarUseCaseMockRecorder
+}
+
+// MockAvatarUseCaseMockRecorder is the mock recorder for MockAvatarUseCase.
+type MockAvatarUseCaseMockRecorder struct {
+	mock *MockAvatarUseCase
+}
+
+// NewMockAvatarUseCase creates a new mock instance.
+func NewMockAvatarUseCase(ctrl *gomock.Controller) *MockAvatarUseCase {
+	mock := &MockAvatarUseCase{ctrl: ctrl}
+	mock.recorder = &MockAvatarUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAvatarUseCase) EXPECT() *MockAvatarUseCaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteAvatar mocks base method.
+func (m *MockAvatarUseCase) DeleteAvatar(userUID string, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAvatar", userUID, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// DeleteAvatar indicates an expected call of DeleteAvatar.
+func (mr *MockAvatarUseCaseMockRecorder) DeleteAvatar(userUID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAvatar", reflect.TypeOf((*MockAvatarUseCase)(nil).DeleteAvatar), userUID, ctx)
+}
+
+// GetAvatar mocks base method.
+func (m *MockAvatarUseCase) GetAvatar(userUID string, ctx *gin.Context) (io.ReadCloser, string, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvatar", userUID, ctx)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(*models.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// GetAvatar indicates an expected call of GetAvatar.
+func (mr *MockAvatarUseCaseMockRecorder) GetAvatar(userUID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvatar", reflect.TypeOf((*MockAvatarUseCase)(nil).GetAvatar), userUID, ctx)
+}
+
+// UploadAvatar mocks base method.
+func (m *MockAvatarUseCase) UploadAvatar(userUID string, content io.Reader, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAvatar", userUID, content, ctx)
+	ret0, _ := ret[0].(*dtos.UserResponseSingle)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// UploadAvatar indicates an expected call of UploadAvatar.
+func (mr *MockAvatarUseCaseMockRecorder) UploadAvatar(userUID, content, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAvatar", reflect.TypeOf((*MockAvatarUseCase)(nil).UploadAvatar), userUID, content, ctx)
+}
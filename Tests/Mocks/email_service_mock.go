@@ -6,8 +6,10 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	models "github.com/google-run-code/Domain/Models"
 )
 
 // MockEmailService is a mock of EmailService interface.
@@ -46,3 +48,97 @@ func (mr *MockEmailServiceMockRecorder) IsValidEmail(email interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsValidEmail", reflect.TypeOf((*MockEmailService)(nil).IsValidEmail), email)
 }
+
+// ParseVerificationToken mocks base method.
+func (m *MockEmailService) ParseVerificationToken(token string) (*models.EmailVerificationClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseVerificationToken", token)
+	ret0, _ := ret[0].(*models.EmailVerificationClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseVerificationToken indicates an expected call of ParseVerificationToken.
+func (mr *MockEmailServiceMockRecorder) ParseVerificationToken(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseVerificationToken", reflect.TypeOf((*MockEmailService)(nil).ParseVerificationToken), token)
+}
+
+// SendInvitationEmail mocks base method.
+func (m *MockEmailService) SendInvitationEmail(tenant, name, email, token string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendInvitationEmail", tenant, name, email, token, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendInvitationEmail indicates an expected call of SendInvitationEmail.
+func (mr *MockEmailServiceMockRecorder) SendInvitationEmail(tenant, name, email, token, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendInvitationEmail", reflect.TypeOf((*MockEmailService)(nil).SendInvitationEmail), tenant, name, email, token, expiresAt)
+}
+
+// SendPasswordResetEmail mocks base method.
+func (m *MockEmailService) SendPasswordResetEmail(tenant, name, email, token string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPasswordResetEmail", tenant, name, email, token, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendPasswordResetEmail indicates an expected call of SendPasswordResetEmail.
+func (mr *MockEmailServiceMockRecorder) SendPasswordResetEmail(tenant, name, email, token, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPasswordResetEmail", reflect.TypeOf((*MockEmailService)(nil).SendPasswordResetEmail), tenant, name, email, token, expiresAt)
+}
+
+// SendVerificationEmail mocks base method.
+func (m *MockEmailService) SendVerificationEmail(tenant, userUID, name, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendVerificationEmail", tenant, userUID, name, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendVerificationEmail indicates an expected call of SendVerificationEmail.
+func (mr *MockEmailServiceMockRecorder) SendVerificationEmail(tenant, userUID, name, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendVerificationEmail", reflect.TypeOf((*MockEmailService)(nil).SendVerificationEmail), tenant, userUID, name, email)
+}
+
+// MockMailSender is a mock of MailSender interface.
+type MockMailSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockMailSenderMockRecorder
+}
+
+// MockMailSenderMockRecorder is the mock recorder for MockMailSender.
+type MockMailSenderMockRecorder struct {
+	mock *MockMailSender
+}
+
+// NewMockMailSender creates a new mock instance.
+func NewMockMailSender(ctrl *gomock.Controller) *MockMailSender {
+	mock := &MockMailSender{ctrl: ctrl}
+	mock.recorder = &MockMailSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMailSender) EXPECT() *MockMailSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockMailSender) Send(message models.MailMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockMailSenderMockRecorder) Send(message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockMailSender)(nil).Send), message)
+}
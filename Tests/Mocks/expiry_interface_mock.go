@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: Domain/Interfaces/expiry_interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// MockExpiryUseCase is a mock of ExpiryUseCase interface.
+type MockExpiryUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockExpiryUseCaseMockRecorder
+}
+
+// MockExpiryUseCaseMockRecorder is the mock recorder for MockExpiryUseCase.
+type MockExpiryUseCaseMockRecorder struct {
+	mock *MockExpiryUseCase
+}
+
+// NewMockExpiryUseCase creates a new mock instance.
+func NewMockExpiryUseCase(ctrl *gomock.Controller) *MockExpiryUseCase {
+	mock := &MockExpiryUseCase{ctrl: ctrl}
+	mock.recorder = &MockExpiryUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExpiryUseCase) EXPECT() *MockExpiryUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ExpireGroupMemberships mocks base method.
+func (m *MockExpiryUseCase) ExpireGroupMemberships(ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireGroupMemberships", ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// ExpireGroupMemberships indicates an expected call of ExpireGroupMemberships.
+func (mr *MockExpiryUseCaseMockRecorder) ExpireGroupMemberships(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireGroupMemberships", reflect.TypeOf((*MockExpiryUseCase)(nil).ExpireGroupMemberships), ctx)
+}
+
+// ExpireRoleAssignments mocks base method.
+func (m *MockExpiryUseCase) ExpireRoleAssignments(ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireRoleAssignments", ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// ExpireRoleAssignments indicates an expected call of ExpireRoleAssignments.
+func (mr *MockExpiryUseCaseMockRecorder) ExpireRoleAssignments(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireRoleAssignments", reflect.TypeOf((*MockExpiryUseCase)(nil).ExpireRoleAssignments), ctx)
+}
@@ -36,6 +36,54 @@ func (m *MockGroupController) EXPECT() *MockGroupControllerMockRecorder {
 	return m.recorder
 }
 
+// AddGroupMember mocks base method.
+func (m *MockGroupController) AddGroupMember(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddGroupMember", c)
+}
+
+// AddGroupMember indicates an expected call of AddGroupMember.
+func (mr *MockGroupControllerMockRecorder) AddGroupMember(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupMember", reflect.TypeOf((*MockGroupController)(nil).AddGroupMember), c)
+}
+
+// AddGroupMembers mocks base method.
+func (m *MockGroupController) AddGroupMembers(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddGroupMembers", c)
+}
+
+// AddGroupMembers indicates an expected call of AddGroupMembers.
+func (mr *MockGroupControllerMockRecorder) AddGroupMembers(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupMembers", reflect.TypeOf((*MockGroupController)(nil).AddGroupMembers), c)
+}
+
+// AddGroupOwner mocks base method.
+func (m *MockGroupController) AddGroupOwner(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddGroupOwner", c)
+}
+
+// AddGroupOwner indicates an expected call of AddGroupOwner.
+func (mr *MockGroupControllerMockRecorder) AddGroupOwner(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupOwner", reflect.TypeOf((*MockGroupController)(nil).AddGroupOwner), c)
+}
+
+// AddGroupRole mocks base method.
+func (m *MockGroupController) AddGroupRole(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddGroupRole", c)
+}
+
+// AddGroupRole indicates an expected call of AddGroupRole.
+func (mr *MockGroupControllerMockRecorder) AddGroupRole(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupRole", reflect.TypeOf((*MockGroupController)(nil).AddGroupRole), c)
+}
+
 // CreateGroup mocks base method.
 func (m *MockGroupController) CreateGroup(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -84,6 +132,30 @@ func (mr *MockGroupControllerMockRecorder) GetGroupById(c interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupById", reflect.TypeOf((*MockGroupController)(nil).GetGroupById), c)
 }
 
+// GetGroupOwners mocks base method.
+func (m *MockGroupController) GetGroupOwners(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetGroupOwners", c)
+}
+
+// GetGroupOwners indicates an expected call of GetGroupOwners.
+func (mr *MockGroupControllerMockRecorder) GetGroupOwners(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupOwners", reflect.TypeOf((*MockGroupController)(nil).GetGroupOwners), c)
+}
+
+// GetGroupRoles mocks base method.
+func (m *MockGroupController) GetGroupRoles(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetGroupRoles", c)
+}
+
+// GetGroupRoles indicates an expected call of GetGroupRoles.
+func (mr *MockGroupControllerMockRecorder) GetGroupRoles(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupRoles", reflect.TypeOf((*MockGroupController)(nil).GetGroupRoles), c)
+}
+
 // GetGroupUsers mocks base method.
 func (m *MockGroupController) GetGroupUsers(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -96,6 +168,90 @@ func (mr *MockGroupControllerMockRecorder) GetGroupUsers(c interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupUsers", reflect.TypeOf((*MockGroupController)(nil).GetGroupUsers), c)
 }
 
+// PreviewGroupRule mocks base method.
+func (m *MockGroupController) PreviewGroupRule(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PreviewGroupRule", c)
+}
+
+// PreviewGroupRule indicates an expected call of PreviewGroupRule.
+func (mr *MockGroupControllerMockRecorder) PreviewGroupRule(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewGroupRule", reflect.TypeOf((*MockGroupController)(nil).PreviewGroupRule), c)
+}
+
+// RemoveGroupMember mocks base method.
+func (m *MockGroupController) RemoveGroupMember(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveGroupMember", c)
+}
+
+// RemoveGroupMember indicates an expected call of RemoveGroupMember.
+func (mr *MockGroupControllerMockRecorder) RemoveGroupMember(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroupMember", reflect.TypeOf((*MockGroupController)(nil).RemoveGroupMember), c)
+}
+
+// RemoveGroupMembers mocks base method.
+func (m *MockGroupController) RemoveGroupMembers(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveGroupMembers", c)
+}
+
+// RemoveGroupMembers indicates an expected call of RemoveGroupMembers.
+func (mr *MockGroupControllerMockRecorder) RemoveGroupMembers(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroupMembers", reflect.TypeOf((*MockGroupController)(nil).RemoveGroupMembers), c)
+}
+
+// RemoveGroupOwner mocks base method.
+func (m *MockGroupController) RemoveGroupOwner(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveGroupOwner", c)
+}
+
+// RemoveGroupOwner indicates an expected call of RemoveGroupOwner.
+func (mr *MockGroupControllerMockRecorder) RemoveGroupOwner(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroupOwner", reflect.TypeOf((*MockGroupController)(nil).RemoveGroupOwner), c)
+}
+
+// RemoveGroupRole mocks base method.
+func (m *MockGroupController) RemoveGroupRole(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveGroupRole", c)
+}
+
+// RemoveGroupRole indicates an expected call of RemoveGroupRole.
+func (mr *MockGroupControllerMockRecorder) RemoveGroupRole(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroupRole", reflect.TypeOf((*MockGroupController)(nil).RemoveGroupRole), c)
+}
+
+// RestoreGroup mocks base method.
+func (m *MockGroupController) RestoreGroup(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RestoreGroup", c)
+}
+
+// RestoreGroup indicates an expected call of RestoreGroup.
+func (mr *MockGroupControllerMockRecorder) RestoreGroup(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreGroup", reflect.TypeOf((*MockGroupController)(nil).RestoreGroup), c)
+}
+
+// SetGroupMembers mocks base method.
+func (m *MockGroupController) SetGroupMembers(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetGroupMembers", c)
+}
+
+// SetGroupMembers indicates an expected call of SetGroupMembers.
+func (mr *MockGroupControllerMockRecorder) SetGroupMembers(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGroupMembers", reflect.TypeOf((*MockGroupController)(nil).SetGroupMembers), c)
+}
+
 // UpdateGroup mocks base method.
 func (m *MockGroupController) UpdateGroup(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -131,6 +287,65 @@ func (m *MockGroupUseCase) EXPECT() *MockGroupUseCaseMockRecorder {
 	return m.recorder
 }
 
+// AddGroupMember mocks base method.
+func (m *MockGroupUseCase) AddGroupMember(id, userId string, req Dtos.GroupMemberRequest, ctx *gin.Context) *Models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroupMember", id, userId, req, ctx)
+	ret0, _ := ret[0].(*Models.ErrorResponse)
+	return ret0
+}
+
+// AddGroupMember indicates an expected call of AddGroupMember.
+func (mr *MockGroupUseCaseMockRecorder) AddGroupMember(id, userId, req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupMember", reflect.TypeOf((*MockGroupUseCase)(nil).AddGroupMember), id, userId, req, ctx)
+}
+
+// AddGroupMembers mocks base method.
+func (m *MockGroupUseCase) AddGroupMembers(id string, req Dtos.GroupMembersRequest, ctx *gin.Context) (*Dtos.GroupMembersDiff, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroupMembers", id, req, ctx)
+	ret0, _ := ret[0].(*Dtos.GroupMembersDiff)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// AddGroupMembers indicates an expected call of AddGroupMembers.
+func (mr *MockGroupUseCaseMockRecorder) AddGroupMembers(id, req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupMembers", reflect.TypeOf((*MockGroupUseCase)(nil).AddGroupMembers), id, req, ctx)
+}
+
+// AddGroupOwner mocks base method.
+func (m *MockGroupUseCase) AddGroupOwner(id string, req Dtos.GroupOwnerRequest, ctx *gin.Context) ([]Dtos.UserResponse, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroupOwner", id, req, ctx)
+	ret0, _ := ret[0].([]Dtos.UserResponse)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// AddGroupOwner indicates an expected call of AddGroupOwner.
+func (mr *MockGroupUseCaseMockRecorder) AddGroupOwner(id, req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupOwner", reflect.TypeOf((*MockGroupUseCase)(nil).AddGroupOwner), id, req, ctx)
+}
+
+// AddGroupRole mocks base method.
+func (m *MockGroupUseCase) AddGroupRole(id string, req Dtos.GroupRoleRequest, ctx *gin.Context) ([]Dtos.RoleResponse, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroupRole", id, req, ctx)
+	ret0, _ := ret[0].([]Dtos.RoleResponse)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// AddGroupRole indicates an expected call of AddGroupRole.
+func (mr *MockGroupUseCaseMockRecorder) AddGroupRole(id, req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupRole", reflect.TypeOf((*MockGroupUseCase)(nil).AddGroupRole), id, req, ctx)
+}
+
 // CreateGroup mocks base method.
 func (m *MockGroupUseCase) CreateGroup(group Dtos.GroupCreateRequest, ctx *gin.Context) (*Dtos.GroupResponse, *Models.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -160,6 +375,20 @@ func (mr *MockGroupUseCaseMockRecorder) DeleteGroup(id, ctx interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockGroupUseCase)(nil).DeleteGroup), id, ctx)
 }
 
+// EvaluateDynamicGroups mocks base method.
+func (m *MockGroupUseCase) EvaluateDynamicGroups(ctx *gin.Context) *Models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateDynamicGroups", ctx)
+	ret0, _ := ret[0].(*Models.ErrorResponse)
+	return ret0
+}
+
+// EvaluateDynamicGroups indicates an expected call of EvaluateDynamicGroups.
+func (mr *MockGroupUseCaseMockRecorder) EvaluateDynamicGroups(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateDynamicGroups", reflect.TypeOf((*MockGroupUseCase)(nil).EvaluateDynamicGroups), ctx)
+}
+
 // GetAllGroups mocks base method.
 func (m *MockGroupUseCase) GetAllGroups(ctx *gin.Context) ([]*Dtos.GroupResponse, *Models.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -190,19 +419,151 @@ func (mr *MockGroupUseCaseMockRecorder) GetGroupById(id, ctx interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupById", reflect.TypeOf((*MockGroupUseCase)(nil).GetGroupById), id, ctx)
 }
 
+// GetGroupOwners mocks base method.
+func (m *MockGroupUseCase) GetGroupOwners(id string, ctx *gin.Context) ([]Dtos.UserResponse, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupOwners", id, ctx)
+	ret0, _ := ret[0].([]Dtos.UserResponse)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetGroupOwners indicates an expected call of GetGroupOwners.
+func (mr *MockGroupUseCaseMockRecorder) GetGroupOwners(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupOwners", reflect.TypeOf((*MockGroupUseCase)(nil).GetGroupOwners), id, ctx)
+}
+
+// GetGroupRoles mocks base method.
+func (m *MockGroupUseCase) GetGroupRoles(id string, ctx *gin.Context) ([]Dtos.RoleResponse, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupRoles", id, ctx)
+	ret0, _ := ret[0].([]Dtos.RoleResponse)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetGroupRoles indicates an expected call of GetGroupRoles.
+func (mr *MockGroupUseCaseMockRecorder) GetGroupRoles(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupRoles", reflect.TypeOf((*MockGroupUseCase)(nil).GetGroupRoles), id, ctx)
+}
+
 // GetGroupUsers mocks base method.
-func (m *MockGroupUseCase) GetGroupUsers(id string, ctx *gin.Context) ([]Dtos.UserResponse, *Models.ErrorResponse) {
+func (m *MockGroupUseCase) GetGroupUsers(id string, filter Dtos.MembershipFilter, ctx *gin.Context) ([]Dtos.UserResponse, *Models.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupUsers", id, ctx)
+	ret := m.ctrl.Call(m, "GetGroupUsers", id, filter, ctx)
 	ret0, _ := ret[0].([]Dtos.UserResponse)
 	ret1, _ := ret[1].(*Models.ErrorResponse)
 	return ret0, ret1
 }
 
 // GetGroupUsers indicates an expected call of GetGroupUsers.
-func (mr *MockGroupUseCaseMockRecorder) GetGroupUsers(id, ctx interface{}) *gomock.Call {
+func (mr *MockGroupUseCaseMockRecorder) GetGroupUsers(id, filter, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupUsers", reflect.TypeOf((*MockGroupUseCase)(nil).GetGroupUsers), id, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupUsers", reflect.TypeOf((*MockGroupUseCase)(nil).GetGroupUsers), id, filter, ctx)
+}
+
+// PreviewGroupRule mocks base method.
+func (m *MockGroupUseCase) PreviewGroupRule(req Dtos.GroupRulePreviewRequest, ctx *gin.Context) (*Dtos.GroupRulePreview, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewGroupRule", req, ctx)
+	ret0, _ := ret[0].(*Dtos.GroupRulePreview)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// PreviewGroupRule indicates an expected call of PreviewGroupRule.
+func (mr *MockGroupUseCaseMockRecorder) PreviewGroupRule(req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewGroupRule", reflect.TypeOf((*MockGroupUseCase)(nil).PreviewGroupRule), req, ctx)
+}
+
+// RemoveGroupMember mocks base method.
+func (m *MockGroupUseCase) RemoveGroupMember(id, userId string, ctx *gin.Context) *Models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGroupMember", id, userId, ctx)
+	ret0, _ := ret[0].(*Models.ErrorResponse)
+	return ret0
+}
+
+// RemoveGroupMember indicates an expected call of RemoveGroupMember.
+func (mr *MockGroupUseCaseMockRecorder) RemoveGroupMember(id, userId, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroupMember", reflect.TypeOf((*MockGroupUseCase)(nil).RemoveGroupMember), id, userId, ctx)
+}
+
+// RemoveGroupMembers mocks base method.
+func (m *MockGroupUseCase) RemoveGroupMembers(id string, req Dtos.GroupMembersRequest, ctx *gin.Context) (*Dtos.GroupMembersDiff, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGroupMembers", id, req, ctx)
+	ret0, _ := ret[0].(*Dtos.GroupMembersDiff)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// RemoveGroupMembers indicates an expected call of RemoveGroupMembers.
+func (mr *MockGroupUseCaseMockRecorder) RemoveGroupMembers(id, req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroupMembers", reflect.TypeOf((*MockGroupUseCase)(nil).RemoveGroupMembers), id, req, ctx)
+}
+
+// RemoveGroupOwner mocks base method.
+func (m *MockGroupUseCase) RemoveGroupOwner(id, userId string, ctx *gin.Context) *Models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGroupOwner", id, userId, ctx)
+	ret0, _ := ret[0].(*Models.ErrorResponse)
+	return ret0
+}
+
+// RemoveGroupOwner indicates an expected call of RemoveGroupOwner.
+func (mr *MockGroupUseCaseMockRecorder) RemoveGroupOwner(id, userId, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroupOwner", reflect.TypeOf((*MockGroupUseCase)(nil).RemoveGroupOwner), id, userId, ctx)
+}
+
+// RemoveGroupRole mocks base method.
+func (m *MockGroupUseCase) RemoveGroupRole(id, roleId string, ctx *gin.Context) *Models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGroupRole", id, roleId, ctx)
+	ret0, _ := ret[0].(*Models.ErrorResponse)
+	return ret0
+}
+
+// RemoveGroupRole indicates an expected call of RemoveGroupRole.
+func (mr *MockGroupUseCaseMockRecorder) RemoveGroupRole(id, roleId, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroupRole", reflect.TypeOf((*MockGroupUseCase)(nil).RemoveGroupRole), id, roleId, ctx)
+}
+
+// RestoreGroup mocks base method.
+func (m *MockGroupUseCase) RestoreGroup(id string, ctx *gin.Context) (*Dtos.GroupResponse, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreGroup", id, ctx)
+	ret0, _ := ret[0].(*Dtos.GroupResponse)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// RestoreGroup indicates an expected call of RestoreGroup.
+func (mr *MockGroupUseCaseMockRecorder) RestoreGroup(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreGroup", reflect.TypeOf((*MockGroupUseCase)(nil).RestoreGroup), id, ctx)
+}
+
+// SetGroupMembers mocks base method.
+func (m *MockGroupUseCase) SetGroupMembers(id string, req Dtos.GroupMembersRequest, ctx *gin.Context) (*Dtos.GroupMembersDiff, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGroupMembers", id, req, ctx)
+	ret0, _ := ret[0].(*Dtos.GroupMembersDiff)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// SetGroupMembers indicates an expected call of SetGroupMembers.
+func (mr *MockGroupUseCaseMockRecorder) SetGroupMembers(id, req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGroupMembers", reflect.TypeOf((*MockGroupUseCase)(nil).SetGroupMembers), id, req, ctx)
 }
 
 // UpdateGroup mocks base method.
@@ -243,6 +604,63 @@ func (m *MockGroupRepository) EXPECT() *MockGroupRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddGroupMember mocks base method.
+func (m *MockGroupRepository) AddGroupMember(id, userUID string, req Dtos.GroupMemberRequest, ctx *gin.Context) *Models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroupMember", id, userUID, req, ctx)
+	ret0, _ := ret[0].(*Models.ErrorResponse)
+	return ret0
+}
+
+// AddGroupMember indicates an expected call of AddGroupMember.
+func (mr *MockGroupRepositoryMockRecorder) AddGroupMember(id, userUID, req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupMember", reflect.TypeOf((*MockGroupRepository)(nil).AddGroupMember), id, userUID, req, ctx)
+}
+
+// AddGroupMembers mocks base method.
+func (m *MockGroupRepository) AddGroupMembers(id string, req Dtos.GroupMembersRequest, ctx *gin.Context) (*Dtos.GroupMembersDiff, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroupMembers", id, req, ctx)
+	ret0, _ := ret[0].(*Dtos.GroupMembersDiff)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// AddGroupMembers indicates an expected call of AddGroupMembers.
+func (mr *MockGroupRepositoryMockRecorder) AddGroupMembers(id, req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupMembers", reflect.TypeOf((*MockGroupRepository)(nil).AddGroupMembers), id, req, ctx)
+}
+
+// AddGroupOwner mocks base method.
+func (m *MockGroupRepository) AddGroupOwner(id, userUID string, ctx *gin.Context) *Models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroupOwner", id, userUID, ctx)
+	ret0, _ := ret[0].(*Models.ErrorResponse)
+	return ret0
+}
+
+// AddGroupOwner indicates an expected call of AddGroupOwner.
+func (mr *MockGroupRepositoryMockRecorder) AddGroupOwner(id, userUID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupOwner", reflect.TypeOf((*MockGroupRepository)(nil).AddGroupOwner), id, userUID, ctx)
+}
+
+// AddGroupRole mocks base method.
+func (m *MockGroupRepository) AddGroupRole(id, roleUID string, ctx *gin.Context) *Models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroupRole", id, roleUID, ctx)
+	ret0, _ := ret[0].(*Models.ErrorResponse)
+	return ret0
+}
+
+// AddGroupRole indicates an expected call of AddGroupRole.
+func (mr *MockGroupRepositoryMockRecorder) AddGroupRole(id, roleUID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupRole", reflect.TypeOf((*MockGroupRepository)(nil).AddGroupRole), id, roleUID, ctx)
+}
+
 // CreateGroup mocks base method.
 func (m *MockGroupRepository) CreateGroup(group Dtos.GroupCreateRequest, ctx *gin.Context) (*Dtos.GroupResponse, *Models.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -272,6 +690,20 @@ func (mr *MockGroupRepositoryMockRecorder) DeleteGroup(id, ctx interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockGroupRepository)(nil).DeleteGroup), id, ctx)
 }
 
+// EvaluateDynamicGroups mocks base method.
+func (m *MockGroupRepository) EvaluateDynamicGroups(ctx *gin.Context) *Models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateDynamicGroups", ctx)
+	ret0, _ := ret[0].(*Models.ErrorResponse)
+	return ret0
+}
+
+// EvaluateDynamicGroups indicates an expected call of EvaluateDynamicGroups.
+func (mr *MockGroupRepositoryMockRecorder) EvaluateDynamicGroups(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateDynamicGroups", reflect.TypeOf((*MockGroupRepository)(nil).EvaluateDynamicGroups), ctx)
+}
+
 // GetAllGroups mocks base method.
 func (m *MockGroupRepository) GetAllGroups(ctx *gin.Context) ([]*Dtos.GroupResponse, *Models.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -317,6 +749,36 @@ func (mr *MockGroupRepositoryMockRecorder) GetGroupByName(name, ctx interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupByName", reflect.TypeOf((*MockGroupRepository)(nil).GetGroupByName), name, ctx)
 }
 
+// GetGroupOwners mocks base method.
+func (m *MockGroupRepository) GetGroupOwners(id string, ctx *gin.Context) ([]Dtos.UserResponse, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupOwners", id, ctx)
+	ret0, _ := ret[0].([]Dtos.UserResponse)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetGroupOwners indicates an expected call of GetGroupOwners.
+func (mr *MockGroupRepositoryMockRecorder) GetGroupOwners(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupOwners", reflect.TypeOf((*MockGroupRepository)(nil).GetGroupOwners), id, ctx)
+}
+
+// GetGroupRoles mocks base method.
+func (m *MockGroupRepository) GetGroupRoles(id string, ctx *gin.Context) ([]Dtos.RoleResponse, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupRoles", id, ctx)
+	ret0, _ := ret[0].([]Dtos.RoleResponse)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetGroupRoles indicates an expected call of GetGroupRoles.
+func (mr *MockGroupRepositoryMockRecorder) GetGroupRoles(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupRoles", reflect.TypeOf((*MockGroupRepository)(nil).GetGroupRoles), id, ctx)
+}
+
 // GetGroupUsers mocks base method.
 func (m *MockGroupRepository) GetGroupUsers(id string, ctx *gin.Context) ([]Dtos.UserResponse, *Models.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -332,6 +794,153 @@ func (mr *MockGroupRepositoryMockRecorder) GetGroupUsers(id, ctx interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupUsers", reflect.TypeOf((*MockGroupRepository)(nil).GetGroupUsers), id, ctx)
 }
 
+// GetGroupUsersTransitive mocks base method.
+func (m *MockGroupRepository) GetGroupUsersTransitive(id string, ctx *gin.Context) ([]Dtos.UserResponse, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupUsersTransitive", id, ctx)
+	ret0, _ := ret[0].([]Dtos.UserResponse)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetGroupUsersTransitive indicates an expected call of GetGroupUsersTransitive.
+func (mr *MockGroupRepositoryMockRecorder) GetGroupUsersTransitive(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupUsersTransitive", reflect.TypeOf((*MockGroupRepository)(nil).GetGroupUsersTransitive), id, ctx)
+}
+
+// GroupGrantsRoles mocks base method.
+func (m *MockGroupRepository) GroupGrantsRoles(id string, ctx *gin.Context) (bool, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupGrantsRoles", id, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GroupGrantsRoles indicates an expected call of GroupGrantsRoles.
+func (mr *MockGroupRepositoryMockRecorder) GroupGrantsRoles(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupGrantsRoles", reflect.TypeOf((*MockGroupRepository)(nil).GroupGrantsRoles), id, ctx)
+}
+
+// IsGroupOwner mocks base method.
+func (m *MockGroupRepository) IsGroupOwner(id string, actor Models.Actor, ctx *gin.Context) (bool, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsGroupOwner", id, actor, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// IsGroupOwner indicates an expected call of IsGroupOwner.
+func (mr *MockGroupRepositoryMockRecorder) IsGroupOwner(id, actor, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsGroupOwner", reflect.TypeOf((*MockGroupRepository)(nil).IsGroupOwner), id, actor, ctx)
+}
+
+// PreviewGroupRule mocks base method.
+func (m *MockGroupRepository) PreviewGroupRule(rule *Models.GroupRule, ctx *gin.Context) (*Dtos.GroupRulePreview, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewGroupRule", rule, ctx)
+	ret0, _ := ret[0].(*Dtos.GroupRulePreview)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// PreviewGroupRule indicates an expected call of PreviewGroupRule.
+func (mr *MockGroupRepositoryMockRecorder) PreviewGroupRule(rule, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewGroupRule", reflect.TypeOf((*MockGroupRepository)(nil).PreviewGroupRule), rule, ctx)
+}
+
+// RemoveGroupMember mocks base method.
+func (m *MockGroupRepository) RemoveGroupMember(id, userUID string, ctx *gin.Context) *Models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGroupMember", id, userUID, ctx)
+	ret0, _ := ret[0].(*Models.ErrorResponse)
+	return ret0
+}
+
+// RemoveGroupMember indicates an expected call of RemoveGroupMember.
+func (mr *MockGroupRepositoryMockRecorder) RemoveGroupMember(id, userUID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroupMember", reflect.TypeOf((*MockGroupRepository)(nil).RemoveGroupMember), id, userUID, ctx)
+}
+
+// RemoveGroupMembers mocks base method.
+func (m *MockGroupRepository) RemoveGroupMembers(id string, req Dtos.GroupMembersRequest, ctx *gin.Context) (*Dtos.GroupMembersDiff, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGroupMembers", id, req, ctx)
+	ret0, _ := ret[0].(*Dtos.GroupMembersDiff)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// RemoveGroupMembers indicates an expected call of RemoveGroupMembers.
+func (mr *MockGroupRepositoryMockRecorder) RemoveGroupMembers(id, req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroupMembers", reflect.TypeOf((*MockGroupRepository)(nil).RemoveGroupMembers), id, req, ctx)
+}
+
+// RemoveGroupOwner mocks base method.
+func (m *MockGroupRepository) RemoveGroupOwner(id, userUID string, ctx *gin.Context) *Models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGroupOwner", id, userUID, ctx)
+	ret0, _ := ret[0].(*Models.ErrorResponse)
+	return ret0
+}
+
+// RemoveGroupOwner indicates an expected call of RemoveGroupOwner.
+func (mr *MockGroupRepositoryMockRecorder) RemoveGroupOwner(id, userUID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroupOwner", reflect.TypeOf((*MockGroupRepository)(nil).RemoveGroupOwner), id, userUID, ctx)
+}
+
+// RemoveGroupRole mocks base method.
+func (m *MockGroupRepository) RemoveGroupRole(id, roleUID string, ctx *gin.Context) *Models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGroupRole", id, roleUID, ctx)
+	ret0, _ := ret[0].(*Models.ErrorResponse)
+	return ret0
+}
+
+// RemoveGroupRole indicates an expected call of RemoveGroupRole.
+func (mr *MockGroupRepositoryMockRecorder) RemoveGroupRole(id, roleUID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroupRole", reflect.TypeOf((*MockGroupRepository)(nil).RemoveGroupRole), id, roleUID, ctx)
+}
+
+// RestoreGroup mocks base method.
+func (m *MockGroupRepository) RestoreGroup(id string, ctx *gin.Context) (*Dtos.GroupResponse, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreGroup", id, ctx)
+	ret0, _ := ret[0].(*Dtos.GroupResponse)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// RestoreGroup indicates an expected call of RestoreGroup.
+func (mr *MockGroupRepositoryMockRecorder) RestoreGroup(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreGroup", reflect.TypeOf((*MockGroupRepository)(nil).RestoreGroup), id, ctx)
+}
+
+// SetGroupMembers mocks base method.
+func (m *MockGroupRepository) SetGroupMembers(id string, req Dtos.GroupMembersRequest, ctx *gin.Context) (*Dtos.GroupMembersDiff, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGroupMembers", id, req, ctx)
+	ret0, _ := ret[0].(*Dtos.GroupMembersDiff)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// SetGroupMembers indicates an expected call of SetGroupMembers.
+func (mr *MockGroupRepositoryMockRecorder) SetGroupMembers(id, req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGroupMembers", reflect.TypeOf((*MockGroupRepository)(nil).SetGroupMembers), id, req, ctx)
+}
+
 // UpdateGroup mocks base method.
 func (m *MockGroupRepository) UpdateGroup(id string, group Dtos.GroupUpdateRequest, ctx *gin.Context) (*Dtos.GroupResponse, *Models.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,322 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: Domain/Interfaces/invitation_interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	json "encoding/json"
+	reflect "reflect"
+	time "time"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// MockInvitationController is a mock of InvitationController interface.
+type MockInvitationController struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvitationControllerMockRecorder
+}
+
+// MockInvitationControllerMockRecorder is the mock recorder for MockInvitationController.
+type MockInvitationControllerMockRecorder struct {
+	mock *MockInvitationController
+}
+
+// NewMockInvitationController creates a new mock instance.
+func NewMockInvitationController(ctrl *gomock.Controller) *MockInvitationController {
+	mock := &MockInvitationController{ctrl: ctrl}
+	mock.recorder = &MockInvitationControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvitationController) EXPECT() *MockInvitationControllerMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvitation mocks base method.
+func (m *MockInvitationController) AcceptInvitation(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AcceptInvitation", c)
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockInvitationControllerMockRecorder) AcceptInvitation(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockInvitationController)(nil).AcceptInvitation), c)
+}
+
+// CreateInvitation mocks base method.
+func (m *MockInvitationController) CreateInvitation(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateInvitation", c)
+}
+
+// CreateInvitation indicates an expected call of CreateInvitation.
+func (mr *MockInvitationControllerMockRecorder) CreateInvitation(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitation", reflect.TypeOf((*MockInvitationController)(nil).CreateInvitation), c)
+}
+
+// GetInvitations mocks base method.
+func (m *MockInvitationController) GetInvitations(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetInvitations", c)
+}
+
+// GetInvitations indicates an expected call of GetInvitations.
+func (mr *MockInvitationControllerMockRecorder) GetInvitations(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitations", reflect.TypeOf((*MockInvitationController)(nil).GetInvitations), c)
+}
+
+// ResendInvitation mocks base method.
+func (m *MockInvitationController) ResendInvitation(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResendInvitation", c)
+}
+
+// ResendInvitation indicates an expected call of ResendInvitation.
+func (mr *MockInvitationControllerMockRecorder) ResendInvitation(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendInvitation", reflect.TypeOf((*MockInvitationController)(nil).ResendInvitation), c)
+}
+
+// RevokeInvitation mocks base method.
+func (m *MockInvitationController) RevokeInvitation(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RevokeInvitation", c)
+}
+
+// RevokeInvitation indicates an expected call of RevokeInvitation.
+func (mr *MockInvitationControllerMockRecorder) RevokeInvitation(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvitation", reflect.TypeOf((*MockInvitationController)(nil).RevokeInvitation), c)
+}
+
+// MockInvitationUseCase is a mock of InvitationUseCase interface.
+type MockInvitationUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvitationUseCaseMockRecorder
+}
+
+// MockInvitationUseCaseMockRecorder is the mock recorder for MockInvitationUseCase.
+type MockInvitationUseCaseMockRecorder struct {
+	mock *MockInvitationUseCase
+}
+
+// NewMockInvitationUseCase creates a new mock instance.
+func NewMockInvitationUseCase(ctrl *gomock.Controller) *MockInvitationUseCase {
+	mock := &MockInvitationUseCase{ctrl: ctrl}
+	mock.recorder = &MockInvitationUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvitationUseCase) EXPECT() *MockInvitationUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvitation mocks base method.
+func (m *MockInvitationUseCase) AcceptInvitation(req dtos.InvitationAcceptRequest, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", req, ctx)
+	ret0, _ := ret[0].(*dtos.UserResponseSingle)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockInvitationUseCaseMockRecorder) AcceptInvitation(req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockInvitationUseCase)(nil).AcceptInvitation), req, ctx)
+}
+
+// CreateInvitation mocks base method.
+func (m *MockInvitationUseCase) CreateInvitation(req dtos.InvitationCreateRequest, ctx *gin.Context) (*dtos.InvitationResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvitation", req, ctx)
+	ret0, _ := ret[0].(*dtos.InvitationResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// CreateInvitation indicates an expected call of CreateInvitation.
+func (mr *MockInvitationUseCaseMockRecorder) CreateInvitation(req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitation", reflect.TypeOf((*MockInvitationUseCase)(nil).CreateInvitation), req, ctx)
+}
+
+// GetInvitations mocks base method.
+func (m *MockInvitationUseCase) GetInvitations(status string, ctx *gin.Context) ([]*dtos.InvitationResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitations", status, ctx)
+	ret0, _ := ret[0].([]*dtos.InvitationResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetInvitations indicates an expected call of GetInvitations.
+func (mr *MockInvitationUseCaseMockRecorder) GetInvitations(status, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitations", reflect.TypeOf((*MockInvitationUseCase)(nil).GetInvitations), status, ctx)
+}
+
+// ResendInvitation mocks base method.
+func (m *MockInvitationUseCase) ResendInvitation(id string, ctx *gin.Context) (*dtos.InvitationResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendInvitation", id, ctx)
+	ret0, _ := ret[0].(*dtos.InvitationResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// ResendInvitation indicates an expected call of ResendInvitation.
+func (mr *MockInvitationUseCaseMockRecorder) ResendInvitation(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendInvitation", reflect.TypeOf((*MockInvitationUseCase)(nil).ResendInvitation), id, ctx)
+}
+
+// RevokeInvitation mocks base method.
+func (m *MockInvitationUseCase) RevokeInvitation(id string, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInvitation", id, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// RevokeInvitation indicates an expected call of RevokeInvitation.
+func (mr *MockInvitationUseCaseMockRecorder) RevokeInvitation(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvitation", reflect.TypeOf((*MockInvitationUseCase)(nil).RevokeInvitation), id, ctx)
+}
+
+// MockInvitationRepository is a mock of InvitationRepository interface.
+type MockInvitationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvitationRepositoryMockRecorder
+}
+
+// MockInvitationRepositoryMockRecorder is the mock recorder for MockInvitationRepository.
+type MockInvitationRepositoryMockRecorder struct {
+	mock *MockInvitationRepository
+}
+
+// NewMockInvitationRepository creates a new mock instance.
+func NewMockInvitationRepository(ctrl *gomock.Controller) *MockInvitationRepository {
+	mock := &MockInvitationRepository{ctrl: ctrl}
+	mock.recorder = &MockInvitationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvitationRepository) EXPECT() *MockInvitationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvitation mocks base method.
+func (m *MockInvitationRepository) AcceptInvitation(uid string, name *string, attributes json.RawMessage, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", uid, name, attributes, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockInvitationRepositoryMockRecorder) AcceptInvitation(uid, name, attributes, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockInvitationRepository)(nil).AcceptInvitation), uid, name, attributes, ctx)
+}
+
+// CreateInvitation mocks base method.
+func (m *MockInvitationRepository) CreateInvitation(user dtos.UserCreateRequest, groupUIDs []string, tokenHash string, expiresAt time.Time, ctx *gin.Context) (*models.Invitation, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvitation", user, groupUIDs, tokenHash, expiresAt, ctx)
+	ret0, _ := ret[0].(*models.Invitation)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// CreateInvitation indicates an expected call of CreateInvitation.
+func (mr *MockInvitationRepositoryMockRecorder) CreateInvitation(user, groupUIDs, tokenHash, expiresAt, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitation", reflect.TypeOf((*MockInvitationRepository)(nil).CreateInvitation), user, groupUIDs, tokenHash, expiresAt, ctx)
+}
+
+// GetInvitationById mocks base method.
+func (m *MockInvitationRepository) GetInvitationById(uid string, ctx *gin.Context) (*models.Invitation, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitationById", uid, ctx)
+	ret0, _ := ret[0].(*models.Invitation)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetInvitationById indicates an expected call of GetInvitationById.
+func (mr *MockInvitationRepositoryMockRecorder) GetInvitationById(uid, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitationById", reflect.TypeOf((*MockInvitationRepository)(nil).GetInvitationById), uid, ctx)
+}
+
+// GetInvitationByTokenHash mocks base method.
+func (m *MockInvitationRepository) GetInvitationByTokenHash(tokenHash string, ctx *gin.Context) (*models.Invitation, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitationByTokenHash", tokenHash, ctx)
+	ret0, _ := ret[0].(*models.Invitation)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetInvitationByTokenHash indicates an expected call of GetInvitationByTokenHash.
+func (mr *MockInvitationRepositoryMockRecorder) GetInvitationByTokenHash(tokenHash, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitationByTokenHash", reflect.TypeOf((*MockInvitationRepository)(nil).GetInvitationByTokenHash), tokenHash, ctx)
+}
+
+// GetInvitations mocks base method.
+func (m *MockInvitationRepository) GetInvitations(ctx *gin.Context) ([]*models.Invitation, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitations", ctx)
+	ret0, _ := ret[0].([]*models.Invitation)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetInvitations indicates an expected call of GetInvitations.
+func (mr *MockInvitationRepositoryMockRecorder) GetInvitations(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitations", reflect.TypeOf((*MockInvitationRepository)(nil).GetInvitations), ctx)
+}
+
+// RenewInvitation mocks base method.
+func (m *MockInvitationRepository) RenewInvitation(uid, tokenHash string, expiresAt time.Time, ctx *gin.Context) (*models.Invitation, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewInvitation", uid, tokenHash, expiresAt, ctx)
+	ret0, _ := ret[0].(*models.Invitation)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// RenewInvitation indicates an expected call of RenewInvitation.
+func (mr *MockInvitationRepositoryMockRecorder) RenewInvitation(uid, tokenHash, expiresAt, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewInvitation", reflect.TypeOf((*MockInvitationRepository)(nil).RenewInvitation), uid, tokenHash, expiresAt, ctx)
+}
+
+// RevokeInvitation mocks base method.
+func (m *MockInvitationRepository) RevokeInvitation(uid string, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInvitation", uid, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// RevokeInvitation indicates an expected call of RevokeInvitation.
+func (mr *MockInvitationRepositoryMockRecorder) RevokeInvitation(uid, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvitation", reflect.TypeOf((*MockInvitationRepository)(nil).RevokeInvitation), uid, ctx)
+}
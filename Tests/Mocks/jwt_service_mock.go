@@ -49,6 +49,21 @@ func (mr *MockJwtServiceMockRecorder) GenerateToken(database interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateToken", reflect.TypeOf((*MockJwtService)(nil).GenerateToken), database)
 }
 
+// GenerateUserToken mocks base method.
+func (m *MockJwtService) GenerateUserToken(database, userUID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateUserToken", database, userUID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateUserToken indicates an expected call of GenerateUserToken.
+func (mr *MockJwtServiceMockRecorder) GenerateUserToken(database, userUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateUserToken", reflect.TypeOf((*MockJwtService)(nil).GenerateUserToken), database, userUID)
+}
+
 // ValidateAuthHeader mocks base method.
 func (m *MockJwtService) ValidateAuthHeader(authHeader string) ([]string, error) {
 	m.ctrl.T.Helper()
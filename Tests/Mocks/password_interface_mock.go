@@ -0,0 +1,316 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: Domain/Interfaces/password_interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// MockPasswordHasher is a mock of PasswordHasher interface.
+type MockPasswordHasher struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordHasherMockRecorder
+}
+
+// MockPasswordHasherMockRecorder is the mock recorder for MockPasswordHasher.
+type MockPasswordHasherMockRecorder struct {
+	mock *MockPasswordHasher
+}
+
+// NewMockPasswordHasher creates a new mock instance.
+func NewMockPasswordHasher(ctrl *gomock.Controller) *MockPasswordHasher {
+	mock := &MockPasswordHasher{ctrl: ctrl}
+	mock.recorder = &MockPasswordHasherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordHasher) EXPECT() *MockPasswordHasherMockRecorder {
+	return m.recorder
+}
+
+// Compare mocks base method.
+func (m *MockPasswordHasher) Compare(hash, password string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compare", hash, password)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Compare indicates an expected call of Compare.
+func (mr *MockPasswordHasherMockRecorder) Compare(hash, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compare", reflect.TypeOf((*MockPasswordHasher)(nil).Compare), hash, password)
+}
+
+// Hash mocks base method.
+func (m *MockPasswordHasher) Hash(password string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hash", password)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hash indicates an expected call of Hash.
+func (mr *MockPasswordHasherMockRecorder) Hash(password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hash", reflect.TypeOf((*MockPasswordHasher)(nil).Hash), password)
+}
+
+// MockPasswordController is a mock of PasswordController interface.
+type MockPasswordController struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordControllerMockRecorder
+}
+
+// MockPasswordControllerMockRecorder is the mock recorder for MockPasswordController.
+type MockPasswordControllerMockRecorder struct {
+	mock *MockPasswordController
+}
+
+// NewMockPasswordController creates a new mock instance.
+func NewMockPasswordController(ctrl *gomock.Controller) *MockPasswordController {
+	mock := &MockPasswordController{ctrl: ctrl}
+	mock.recorder = &MockPasswordControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordController) EXPECT() *MockPasswordControllerMockRecorder {
+	return m.recorder
+}
+
+// ForgotPassword mocks base method.
+func (m *MockPasswordController) ForgotPassword(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ForgotPassword", c)
+}
+
+// ForgotPassword indicates an expected call of ForgotPassword.
+func (mr *MockPasswordControllerMockRecorder) ForgotPassword(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgotPassword", reflect.TypeOf((*MockPasswordController)(nil).ForgotPassword), c)
+}
+
+// GetPasswordPolicy mocks base method.
+func (m *MockPasswordController) GetPasswordPolicy(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetPasswordPolicy", c)
+}
+
+// GetPasswordPolicy indicates an expected call of GetPasswordPolicy.
+func (mr *MockPasswordControllerMockRecorder) GetPasswordPolicy(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasswordPolicy", reflect.TypeOf((*MockPasswordController)(nil).GetPasswordPolicy), c)
+}
+
+// ResetPassword mocks base method.
+func (m *MockPasswordController) ResetPassword(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetPassword", c)
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockPasswordControllerMockRecorder) ResetPassword(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockPasswordController)(nil).ResetPassword), c)
+}
+
+// SavePasswordPolicy mocks base method.
+func (m *MockPasswordController) SavePasswordPolicy(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SavePasswordPolicy", c)
+}
+
+// SavePasswordPolicy indicates an expected call of SavePasswordPolicy.
+func (mr *MockPasswordControllerMockRecorder) SavePasswordPolicy(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePasswordPolicy", reflect.TypeOf((*MockPasswordController)(nil).SavePasswordPolicy), c)
+}
+
+// MockPasswordUseCase is a mock of PasswordUseCase interface.
+type MockPasswordUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordUseCaseMockRecorder
+}
+
+// MockPasswordUseCaseMockRecorder is the mock recorder for MockPasswordUseCase.
+type MockPasswordUseCaseMockRecorder struct {
+	mock *MockPasswordUseCase
+}
+
+// NewMockPasswordUseCase creates a new mock instance.
+func NewMockPasswordUseCase(ctrl *gomock.Controller) *MockPasswordUseCase {
+	mock := &MockPasswordUseCase{ctrl: ctrl}
+	mock.recorder = &MockPasswordUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordUseCase) EXPECT() *MockPasswordUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ForgotPassword mocks base method.
+func (m *MockPasswordUseCase) ForgotPassword(req dtos.ForgotPasswordRequest, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgotPassword", req, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// ForgotPassword indicates an expected call of ForgotPassword.
+func (mr *MockPasswordUseCaseMockRecorder) ForgotPassword(req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgotPassword", reflect.TypeOf((*MockPasswordUseCase)(nil).ForgotPassword), req, ctx)
+}
+
+// GetPasswordPolicy mocks base method.
+func (m *MockPasswordUseCase) GetPasswordPolicy(ctx *gin.Context) (*dtos.PasswordPolicyResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPasswordPolicy", ctx)
+	ret0, _ := ret[0].(*dtos.PasswordPolicyResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetPasswordPolicy indicates an expected call of GetPasswordPolicy.
+func (mr *MockPasswordUseCaseMockRecorder) GetPasswordPolicy(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasswordPolicy", reflect.TypeOf((*MockPasswordUseCase)(nil).GetPasswordPolicy), ctx)
+}
+
+// ResetPassword mocks base method.
+func (m *MockPasswordUseCase) ResetPassword(req dtos.ResetPasswordRequest, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", req, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockPasswordUseCaseMockRecorder) ResetPassword(req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockPasswordUseCase)(nil).ResetPassword), req, ctx)
+}
+
+// SavePasswordPolicy mocks base method.
+func (m *MockPasswordUseCase) SavePasswordPolicy(req dtos.PasswordPolicyRequest, ctx *gin.Context) (*dtos.PasswordPolicyResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePasswordPolicy", req, ctx)
+	ret0, _ := ret[0].(*dtos.PasswordPolicyResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// SavePasswordPolicy indicates an expected call of SavePasswordPolicy.
+func (mr *MockPasswordUseCaseMockRecorder) SavePasswordPolicy(req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePasswordPolicy", reflect.TypeOf((*MockPasswordUseCase)(nil).SavePasswordPolicy), req, ctx)
+}
+
+// MockPasswordRepository is a mock of PasswordRepository interface.
+type MockPasswordRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordRepositoryMockRecorder
+}
+
+// MockPasswordRepositoryMockRecorder is the mock recorder for MockPasswordRepository.
+type MockPasswordRepositoryMockRecorder struct {
+	mock *MockPasswordRepository
+}
+
+// NewMockPasswordRepository creates a new mock instance.
+func NewMockPasswordRepository(ctrl *gomock.Controller) *MockPasswordRepository {
+	mock := &MockPasswordRepository{ctrl: ctrl}
+	mock.recorder = &MockPasswordRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordRepository) EXPECT() *MockPasswordRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePasswordReset mocks base method.
+func (m *MockPasswordRepository) CreatePasswordReset(userID int, tokenHash string, expiresAt, since time.Time, limit int, ctx *gin.Context) (bool, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordReset", userID, tokenHash, expiresAt, since, limit, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// CreatePasswordReset indicates an expected call of CreatePasswordReset.
+func (mr *MockPasswordRepositoryMockRecorder) CreatePasswordReset(userID, tokenHash, expiresAt, since, limit, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordReset", reflect.TypeOf((*MockPasswordRepository)(nil).CreatePasswordReset), userID, tokenHash, expiresAt, since, limit, ctx)
+}
+
+// GetPasswordPolicy mocks base method.
+func (m *MockPasswordRepository) GetPasswordPolicy(ctx *gin.Context) (*models.PasswordPolicy, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPasswordPolicy", ctx)
+	ret0, _ := ret[0].(*models.PasswordPolicy)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetPasswordPolicy indicates an expected call of GetPasswordPolicy.
+func (mr *MockPasswordRepositoryMockRecorder) GetPasswordPolicy(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasswordPolicy", reflect.TypeOf((*MockPasswordRepository)(nil).GetPasswordPolicy), ctx)
+}
+
+// GetPasswordReset mocks base method.
+func (m *MockPasswordRepository) GetPasswordReset(tokenHash string, ctx *gin.Context) (*models.PasswordReset, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPasswordReset", tokenHash, ctx)
+	ret0, _ := ret[0].(*models.PasswordReset)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetPasswordReset indicates an expected call of GetPasswordReset.
+func (mr *MockPasswordRepositoryMockRecorder) GetPasswordReset(tokenHash, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasswordReset", reflect.TypeOf((*MockPasswordRepository)(nil).GetPasswordReset), tokenHash, ctx)
+}
+
+// ResetPassword mocks base method.
+func (m *MockPasswordRepository) ResetPassword(tokenHash, passwordHash string, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", tokenHash, passwordHash, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockPasswordRepositoryMockRecorder) ResetPassword(tokenHash, passwordHash, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockPasswordRepository)(nil).ResetPassword), tokenHash, passwordHash, ctx)
+}
+
+// SavePasswordPolicy mocks base method.
+func (m *MockPasswordRepository) SavePasswordPolicy(policy models.PasswordPolicy, ctx *gin.Context) (*models.PasswordPolicy, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePasswordPolicy", policy, ctx)
+	ret0, _ := ret[0].(*models.PasswordPolicy)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// SavePasswordPolicy indicates an expected call of SavePasswordPolicy.
+func (mr *MockPasswordRepositoryMockRecorder) SavePasswordPolicy(policy, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePasswordPolicy", reflect.TypeOf((*MockPasswordRepository)(nil).SavePasswordPolicy), policy, ctx)
+}
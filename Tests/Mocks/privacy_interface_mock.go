@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: Domain/Interfaces/privacy_interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// MockPrivacyController is a mock of PrivacyController interface.
+type MockPrivacyController struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivacyControllerMockRecorder
+}
+
+// MockPrivacyControllerMockRecorder is the mock recorder for MockPrivacyController.
+type MockPrivacyControllerMockRecorder struct {
+	mock *MockPrivacyController
+}
+
+// NewMockPrivacyController creates a new mock instance.
+func NewMockPrivacyController(ctrl *gomock.Controller) *MockPrivacyController {
+	mock := &MockPrivacyController{ctrl: ctrl}
+	mock.recorder = &MockPrivacyControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrivacyController) EXPECT() *MockPrivacyControllerMockRecorder {
+	return m.recorder
+}
+
+// EraseUser mocks base method.
+func (m *MockPrivacyController) EraseUser(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "EraseUser", c)
+}
+
+// EraseUser indicates an expected call of EraseUser.
+func (mr *MockPrivacyControllerMockRecorder) EraseUser(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseUser", reflect.TypeOf((*MockPrivacyController)(nil).EraseUser), c)
+}
+
+// ExportUser mocks base method.
+func (m *MockPrivacyController) ExportUser(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ExportUser", c)
+}
+
+// ExportUser indicates an expected call of ExportUser.
+func (mr *MockPrivacyControllerMockRecorder) ExportUser(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUser", reflect.TypeOf((*MockPrivacyController)(nil).ExportUser), c)
+}
+
+// MockPrivacyUseCase is a mock of PrivacyUseCase interface.
+type MockPrivacyUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivacyUseCaseMockRecorder
+}
+
+// MockPrivacyUseCaseMockRecorder is the mock recorder for MockPrivacyUseCase.
+type MockPrivacyUseCaseMockRecorder struct {
+	mock *MockPrivacyUseCase
+}
+
+// NewMockPrivacyUseCase creates a new mock instance.
+func NewMockPrivacyUseCase(ctrl *gomock.Controller) *MockPrivacyUseCase {
+	mock := &MockPrivacyUseCase{ctrl: ctrl}
+	mock.recorder = &MockPrivacyUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrivacyUseCase) EXPECT() *MockPrivacyUseCaseMockRecorder {
+	return m.recorder
+}
+
+// EraseUser mocks base method.
+func (m *MockPrivacyUseCase) EraseUser(userUID string, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseUser", userUID, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// EraseUser indicates an expected call of EraseUser.
+func (mr *MockPrivacyUseCaseMockRecorder) EraseUser(userUID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseUser", reflect.TypeOf((*MockPrivacyUseCase)(nil).EraseUser), userUID, ctx)
+}
+
+// ExportUser mocks base method.
+func (m *MockPrivacyUseCase) ExportUser(userUID string, ctx *gin.Context) (*dtos.UserDataExport, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUser", userUID, ctx)
+	ret0, _ := ret[0].(*dtos.UserDataExport)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// ExportUser indicates an expected call of ExportUser.
+func (mr *MockPrivacyUseCaseMockRecorder) ExportUser(userUID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUser", reflect.TypeOf((*MockPrivacyUseCase)(nil).ExportUser), userUID, ctx)
+}
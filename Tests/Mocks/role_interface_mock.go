@@ -96,6 +96,18 @@ func (mr *MockRoleControllerMockRecorder) GetRoleUsers(c interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleUsers", reflect.TypeOf((*MockRoleController)(nil).GetRoleUsers), c)
 }
 
+// RestoreRole mocks base method.
+func (m *MockRoleController) RestoreRole(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RestoreRole", c)
+}
+
+// RestoreRole indicates an expected call of RestoreRole.
+func (mr *MockRoleControllerMockRecorder) RestoreRole(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRole", reflect.TypeOf((*MockRoleController)(nil).RestoreRole), c)
+}
+
 // UpdateRole mocks base method.
 func (m *MockRoleController) UpdateRole(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -191,18 +203,33 @@ func (mr *MockRoleUseCaseMockRecorder) GetRoleById(id, ctx interface{}) *gomock.
 }
 
 // GetRoleUsers mocks base method.
-func (m *MockRoleUseCase) GetRoleUsers(id string, ctx *gin.Context) ([]*Dtos.UserResponse, *Models.ErrorResponse) {
+func (m *MockRoleUseCase) GetRoleUsers(id string, filter Dtos.MembershipFilter, ctx *gin.Context) ([]*Dtos.RoleUserResponse, *Models.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRoleUsers", id, ctx)
-	ret0, _ := ret[0].([]*Dtos.UserResponse)
+	ret := m.ctrl.Call(m, "GetRoleUsers", id, filter, ctx)
+	ret0, _ := ret[0].([]*Dtos.RoleUserResponse)
 	ret1, _ := ret[1].(*Models.ErrorResponse)
 	return ret0, ret1
 }
 
 // GetRoleUsers indicates an expected call of GetRoleUsers.
-func (mr *MockRoleUseCaseMockRecorder) GetRoleUsers(id, ctx interface{}) *gomock.Call {
+func (mr *MockRoleUseCaseMockRecorder) GetRoleUsers(id, filter, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleUsers", reflect.TypeOf((*MockRoleUseCase)(nil).GetRoleUsers), id, filter, ctx)
+}
+
+// RestoreRole mocks base method.
+func (m *MockRoleUseCase) RestoreRole(id string, ctx *gin.Context) (*Dtos.RoleResponse, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRole", id, ctx)
+	ret0, _ := ret[0].(*Dtos.RoleResponse)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// RestoreRole indicates an expected call of RestoreRole.
+func (mr *MockRoleUseCaseMockRecorder) RestoreRole(id, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleUsers", reflect.TypeOf((*MockRoleUseCase)(nil).GetRoleUsers), id, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRole", reflect.TypeOf((*MockRoleUseCase)(nil).RestoreRole), id, ctx)
 }
 
 // UpdateRole mocks base method.
@@ -318,10 +345,10 @@ func (mr *MockRoleRepositoryMockRecorder) GetRoleByNameAndRights(role, ctx inter
 }
 
 // GetRoleUsers mocks base method.
-func (m *MockRoleRepository) GetRoleUsers(role *Dtos.RoleResponse, ctx *gin.Context) ([]*Dtos.UserResponse, *Models.ErrorResponse) {
+func (m *MockRoleRepository) GetRoleUsers(role *Dtos.RoleResponse, ctx *gin.Context) ([]*Dtos.RoleUserResponse, *Models.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRoleUsers", role, ctx)
-	ret0, _ := ret[0].([]*Dtos.UserResponse)
+	ret0, _ := ret[0].([]*Dtos.RoleUserResponse)
 	ret1, _ := ret[1].(*Models.ErrorResponse)
 	return ret0, ret1
 }
@@ -332,6 +359,21 @@ func (mr *MockRoleRepositoryMockRecorder) GetRoleUsers(role, ctx interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleUsers", reflect.TypeOf((*MockRoleRepository)(nil).GetRoleUsers), role, ctx)
 }
 
+// RestoreRole mocks base method.
+func (m *MockRoleRepository) RestoreRole(id string, ctx *gin.Context) (*Dtos.RoleResponse, *Models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRole", id, ctx)
+	ret0, _ := ret[0].(*Dtos.RoleResponse)
+	ret1, _ := ret[1].(*Models.ErrorResponse)
+	return ret0, ret1
+}
+
+// RestoreRole indicates an expected call of RestoreRole.
+func (mr *MockRoleRepositoryMockRecorder) RestoreRole(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRole", reflect.TypeOf((*MockRoleRepository)(nil).RestoreRole), id, ctx)
+}
+
 // UpdateRole mocks base method.
 func (m *MockRoleRepository) UpdateRole(id string, role Dtos.RoleUpdateRequest, ctx *gin.Context) (*Dtos.RoleResponse, *Models.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,294 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: Domain/Interfaces/scheduled_change_interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// MockScheduledChangeController is a mock of ScheduledChangeController interface.
+type MockScheduledChangeController struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduledChangeControllerMockRecorder
+}
+
+// MockScheduledChangeControllerMockRecorder is the mock recorder for MockScheduledChangeController.
+type MockScheduledChangeControllerMockRecorder struct {
+	mock *MockScheduledChangeController
+}
+
+// NewMockScheduledChangeController creates a new mock instance.
+func NewMockScheduledChangeController(ctrl *gomock.Controller) *MockScheduledChangeController {
+	mock := &MockScheduledChangeController{ctrl: ctrl}
+	mock.recorder = &MockScheduledChangeControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduledChangeController) EXPECT() *MockScheduledChangeControllerMockRecorder {
+	return m.recorder
+}
+
+// CancelScheduledChange mocks base method.
+func (m *MockScheduledChangeController) CancelScheduledChange(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CancelScheduledChange", c)
+}
+
+// CancelScheduledChange indicates an expected call of CancelScheduledChange.
+func (mr *MockScheduledChangeControllerMockRecorder) CancelScheduledChange(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledChange", reflect.TypeOf((*MockScheduledChangeController)(nil).CancelScheduledChange), c)
+}
+
+// CreateScheduledChange mocks base method.
+func (m *MockScheduledChangeController) CreateScheduledChange(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateScheduledChange", c)
+}
+
+// CreateScheduledChange indicates an expected call of CreateScheduledChange.
+func (mr *MockScheduledChangeControllerMockRecorder) CreateScheduledChange(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledChange", reflect.TypeOf((*MockScheduledChangeController)(nil).CreateScheduledChange), c)
+}
+
+// GetScheduledChangeById mocks base method.
+func (m *MockScheduledChangeController) GetScheduledChangeById(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetScheduledChangeById", c)
+}
+
+// GetScheduledChangeById indicates an expected call of GetScheduledChangeById.
+func (mr *MockScheduledChangeControllerMockRecorder) GetScheduledChangeById(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledChangeById", reflect.TypeOf((*MockScheduledChangeController)(nil).GetScheduledChangeById), c)
+}
+
+// GetScheduledChanges mocks base method.
+func (m *MockScheduledChangeController) GetScheduledChanges(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetScheduledChanges", c)
+}
+
+// GetScheduledChanges indicates an expected call of GetScheduledChanges.
+func (mr *MockScheduledChangeControllerMockRecorder) GetScheduledChanges(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledChanges", reflect.TypeOf((*MockScheduledChangeController)(nil).GetScheduledChanges), c)
+}
+
+// MockScheduledChangeUseCase is a mock of ScheduledChangeUseCase interface.
+type MockScheduledChangeUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduledChangeUseCaseMockRecorder
+}
+
+// MockScheduledChangeUseCaseMockRecorder is the mock recorder for MockScheduledChangeUseCase.
+type MockScheduledChangeUseCaseMockRecorder struct {
+	mock *MockScheduledChangeUseCase
+}
+
+// NewMockScheduledChangeUseCase creates a new mock instance.
+func NewMockScheduledChangeUseCase(ctrl *gomock.Controller) *MockScheduledChangeUseCase {
+	mock := &MockScheduledChangeUseCase{ctrl: ctrl}
+	mock.recorder = &MockScheduledChangeUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduledChangeUseCase) EXPECT() *MockScheduledChangeUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ApplyDueChanges mocks base method.
+func (m *MockScheduledChangeUseCase) ApplyDueChanges(ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyDueChanges", ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// ApplyDueChanges indicates an expected call of ApplyDueChanges.
+func (mr *MockScheduledChangeUseCaseMockRecorder) ApplyDueChanges(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyDueChanges", reflect.TypeOf((*MockScheduledChangeUseCase)(nil).ApplyDueChanges), ctx)
+}
+
+// CancelScheduledChange mocks base method.
+func (m *MockScheduledChangeUseCase) CancelScheduledChange(id string, ctx *gin.Context) (*dtos.ScheduledChangeResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledChange", id, ctx)
+	ret0, _ := ret[0].(*dtos.ScheduledChangeResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// CancelScheduledChange indicates an expected call of CancelScheduledChange.
+func (mr *MockScheduledChangeUseCaseMockRecorder) CancelScheduledChange(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledChange", reflect.TypeOf((*MockScheduledChangeUseCase)(nil).CancelScheduledChange), id, ctx)
+}
+
+// CreateScheduledChange mocks base method.
+func (m *MockScheduledChangeUseCase) CreateScheduledChange(req dtos.ScheduledChangeRequest, ctx *gin.Context) (*dtos.ScheduledChangeResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledChange", req, ctx)
+	ret0, _ := ret[0].(*dtos.ScheduledChangeResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// CreateScheduledChange indicates an expected call of CreateScheduledChange.
+func (mr *MockScheduledChangeUseCaseMockRecorder) CreateScheduledChange(req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledChange", reflect.TypeOf((*MockScheduledChangeUseCase)(nil).CreateScheduledChange), req, ctx)
+}
+
+// GetScheduledChangeById mocks base method.
+func (m *MockScheduledChangeUseCase) GetScheduledChangeById(id string, ctx *gin.Context) (*dtos.ScheduledChangeResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledChangeById", id, ctx)
+	ret0, _ := ret[0].(*dtos.ScheduledChangeResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetScheduledChangeById indicates an expected call of GetScheduledChangeById.
+func (mr *MockScheduledChangeUseCaseMockRecorder) GetScheduledChangeById(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledChangeById", reflect.TypeOf((*MockScheduledChangeUseCase)(nil).GetScheduledChangeById), id, ctx)
+}
+
+// GetScheduledChanges mocks base method.
+func (m *MockScheduledChangeUseCase) GetScheduledChanges(filter dtos.ScheduledChangeFilter, ctx *gin.Context) ([]*dtos.ScheduledChangeResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledChanges", filter, ctx)
+	ret0, _ := ret[0].([]*dtos.ScheduledChangeResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetScheduledChanges indicates an expected call of GetScheduledChanges.
+func (mr *MockScheduledChangeUseCaseMockRecorder) GetScheduledChanges(filter, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledChanges", reflect.TypeOf((*MockScheduledChangeUseCase)(nil).GetScheduledChanges), filter, ctx)
+}
+
+// MockScheduledChangeRepository is a mock of ScheduledChangeRepository interface.
+type MockScheduledChangeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduledChangeRepositoryMockRecorder
+}
+
+// MockScheduledChangeRepositoryMockRecorder is the mock recorder for MockScheduledChangeRepository.
+type MockScheduledChangeRepositoryMockRecorder struct {
+	mock *MockScheduledChangeRepository
+}
+
+// NewMockScheduledChangeRepository creates a new mock instance.
+func NewMockScheduledChangeRepository(ctrl *gomock.Controller) *MockScheduledChangeRepository {
+	mock := &MockScheduledChangeRepository{ctrl: ctrl}
+	mock.recorder = &MockScheduledChangeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduledChangeRepository) EXPECT() *MockScheduledChangeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CancelScheduledChange mocks base method.
+func (m *MockScheduledChangeRepository) CancelScheduledChange(uid string, ctx *gin.Context) (*models.ScheduledChange, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledChange", uid, ctx)
+	ret0, _ := ret[0].(*models.ScheduledChange)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// CancelScheduledChange indicates an expected call of CancelScheduledChange.
+func (mr *MockScheduledChangeRepositoryMockRecorder) CancelScheduledChange(uid, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledChange", reflect.TypeOf((*MockScheduledChangeRepository)(nil).CancelScheduledChange), uid, ctx)
+}
+
+// ClaimDueChanges mocks base method.
+func (m *MockScheduledChangeRepository) ClaimDueChanges(now time.Time, limit int, ctx *gin.Context) ([]*models.ScheduledChange, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueChanges", now, limit, ctx)
+	ret0, _ := ret[0].([]*models.ScheduledChange)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// ClaimDueChanges indicates an expected call of ClaimDueChanges.
+func (mr *MockScheduledChangeRepositoryMockRecorder) ClaimDueChanges(now, limit, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueChanges", reflect.TypeOf((*MockScheduledChangeRepository)(nil).ClaimDueChanges), now, limit, ctx)
+}
+
+// CreateScheduledChange mocks base method.
+func (m *MockScheduledChangeRepository) CreateScheduledChange(change *models.ScheduledChange, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledChange", change, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// CreateScheduledChange indicates an expected call of CreateScheduledChange.
+func (mr *MockScheduledChangeRepositoryMockRecorder) CreateScheduledChange(change, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledChange", reflect.TypeOf((*MockScheduledChangeRepository)(nil).CreateScheduledChange), change, ctx)
+}
+
+// FinishScheduledChange mocks base method.
+func (m *MockScheduledChangeRepository) FinishScheduledChange(id int, status models.ScheduledChangeStatus, result string, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishScheduledChange", id, status, result, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// FinishScheduledChange indicates an expected call of FinishScheduledChange.
+func (mr *MockScheduledChangeRepositoryMockRecorder) FinishScheduledChange(id, status, result, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishScheduledChange", reflect.TypeOf((*MockScheduledChangeRepository)(nil).FinishScheduledChange), id, status, result, ctx)
+}
+
+// GetScheduledChangeById mocks base method.
+func (m *MockScheduledChangeRepository) GetScheduledChangeById(uid string, ctx *gin.Context) (*models.ScheduledChange, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledChangeById", uid, ctx)
+	ret0, _ := ret[0].(*models.ScheduledChange)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetScheduledChangeById indicates an expected call of GetScheduledChangeById.
+func (mr *MockScheduledChangeRepositoryMockRecorder) GetScheduledChangeById(uid, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledChangeById", reflect.TypeOf((*MockScheduledChangeRepository)(nil).GetScheduledChangeById), uid, ctx)
+}
+
+// GetScheduledChanges mocks base method.
+func (m *MockScheduledChangeRepository) GetScheduledChanges(filter dtos.ScheduledChangeFilter, ctx *gin.Context) ([]*models.ScheduledChange, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledChanges", filter, ctx)
+	ret0, _ := ret[0].([]*models.ScheduledChange)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetScheduledChanges indicates an expected call of GetScheduledChanges.
+func (mr *MockScheduledChangeRepositoryMockRecorder) GetScheduledChanges(filter, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledChanges", reflect.TypeOf((*MockScheduledChangeRepository)(nil).GetScheduledChanges), filter, ctx)
+}
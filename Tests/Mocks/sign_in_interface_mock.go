@@ -0,0 +1,215 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: Domain/Interfaces/sign_in_interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// MockSignInController is a mock of SignInController interface.
+type MockSignInController struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignInControllerMockRecorder
+}
+
+// MockSignInControllerMockRecorder is the mock recorder for MockSignInController.
+type MockSignInControllerMockRecorder struct {
+	mock *MockSignInController
+}
+
+// NewMockSignInController creates a new mock instance.
+func NewMockSignInController(ctrl *gomock.Controller) *MockSignInController {
+	mock := &MockSignInController{ctrl: ctrl}
+	mock.recorder = &MockSignInControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignInController) EXPECT() *MockSignInControllerMockRecorder {
+	return m.recorder
+}
+
+// GetUserSignIns mocks base method.
+func (m *MockSignInController) GetUserSignIns(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetUserSignIns", c)
+}
+
+// GetUserSignIns indicates an expected call of GetUserSignIns.
+func (mr *MockSignInControllerMockRecorder) GetUserSignIns(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSignIns", reflect.TypeOf((*MockSignInController)(nil).GetUserSignIns), c)
+}
+
+// SignIn mocks base method.
+func (m *MockSignInController) SignIn(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SignIn", c)
+}
+
+// SignIn indicates an expected call of SignIn.
+func (mr *MockSignInControllerMockRecorder) SignIn(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignIn", reflect.TypeOf((*MockSignInController)(nil).SignIn), c)
+}
+
+// MockSignInUseCase is a mock of SignInUseCase interface.
+type MockSignInUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignInUseCaseMockRecorder
+}
+
+// MockSignInUseCaseMockRecorder is the mock recorder for MockSignInUseCase.
+type MockSignInUseCaseMockRecorder struct {
+	mock *MockSignInUseCase
+}
+
+// NewMockSignInUseCase creates a new mock instance.
+func NewMockSignInUseCase(ctrl *gomock.Controller) *MockSignInUseCase {
+	mock := &MockSignInUseCase{ctrl: ctrl}
+	mock.recorder = &MockSignInUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignInUseCase) EXPECT() *MockSignInUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetUserSignIns mocks base method.
+func (m *MockSignInUseCase) GetUserSignIns(uid string, limit int, ctx *gin.Context) ([]dtos.SignInResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSignIns", uid, limit, ctx)
+	ret0, _ := ret[0].([]dtos.SignInResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetUserSignIns indicates an expected call of GetUserSignIns.
+func (mr *MockSignInUseCaseMockRecorder) GetUserSignIns(uid, limit, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSignIns", reflect.TypeOf((*MockSignInUseCase)(nil).GetUserSignIns), uid, limit, ctx)
+}
+
+// SignIn mocks base method.
+func (m *MockSignInUseCase) SignIn(req dtos.SignInRequest, ctx *gin.Context) (*dtos.SignInResult, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignIn", req, ctx)
+	ret0, _ := ret[0].(*dtos.SignInResult)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// SignIn indicates an expected call of SignIn.
+func (mr *MockSignInUseCaseMockRecorder) SignIn(req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignIn", reflect.TypeOf((*MockSignInUseCase)(nil).SignIn), req, ctx)
+}
+
+// MockSignInRepository is a mock of SignInRepository interface.
+type MockSignInRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignInRepositoryMockRecorder
+}
+
+// MockSignInRepositoryMockRecorder is the mock recorder for MockSignInRepository.
+type MockSignInRepositoryMockRecorder struct {
+	mock *MockSignInRepository
+}
+
+// NewMockSignInRepository creates a new mock instance.
+func NewMockSignInRepository(ctrl *gomock.Controller) *MockSignInRepository {
+	mock := &MockSignInRepository{ctrl: ctrl}
+	mock.recorder = &MockSignInRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignInRepository) EXPECT() *MockSignInRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateSignIn mocks base method.
+func (m *MockSignInRepository) CreateSignIn(signIn models.SignIn, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSignIn", signIn, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// CreateSignIn indicates an expected call of CreateSignIn.
+func (mr *MockSignInRepositoryMockRecorder) CreateSignIn(signIn, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSignIn", reflect.TypeOf((*MockSignInRepository)(nil).CreateSignIn), signIn, ctx)
+}
+
+// GetUserSignIns mocks base method.
+func (m *MockSignInRepository) GetUserSignIns(uid string, limit int, ctx *gin.Context) ([]dtos.SignInResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSignIns", uid, limit, ctx)
+	ret0, _ := ret[0].([]dtos.SignInResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetUserSignIns indicates an expected call of GetUserSignIns.
+func (mr *MockSignInRepositoryMockRecorder) GetUserSignIns(uid, limit, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSignIns", reflect.TypeOf((*MockSignInRepository)(nil).GetUserSignIns), uid, limit, ctx)
+}
+
+// RecordActivity mocks base method.
+func (m *MockSignInRepository) RecordActivity(activity []dtos.UserActivity, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordActivity", activity, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// RecordActivity indicates an expected call of RecordActivity.
+func (mr *MockSignInRepositoryMockRecorder) RecordActivity(activity, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordActivity", reflect.TypeOf((*MockSignInRepository)(nil).RecordActivity), activity, ctx)
+}
+
+// MockActivityTracker is a mock of ActivityTracker interface.
+type MockActivityTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityTrackerMockRecorder
+}
+
+// MockActivityTrackerMockRecorder is the mock recorder for MockActivityTracker.
+type MockActivityTrackerMockRecorder struct {
+	mock *MockActivityTracker
+}
+
+// NewMockActivityTracker creates a new mock instance.
+func NewMockActivityTracker(ctrl *gomock.Controller) *MockActivityTracker {
+	mock := &MockActivityTracker{ctrl: ctrl}
+	mock.recorder = &MockActivityTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityTracker) EXPECT() *MockActivityTrackerMockRecorder {
+	return m.recorder
+}
+
+// Touch mocks base method.
+func (m *MockActivityTracker) Touch(ctx *gin.Context, userUID string, login bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Touch", ctx, userUID, login)
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockActivityTrackerMockRecorder) Touch(ctx, userUID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockActivityTracker)(nil).Touch), ctx, userUID, login)
+}
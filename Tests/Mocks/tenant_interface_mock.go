@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: Domain/Interfaces/tenant_interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// MockTenantRegistry is a mock of TenantRegistry interface.
+type MockTenantRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantRegistryMockRecorder
+}
+
+// MockTenantRegistryMockRecorder is the mock recorder for MockTenantRegistry.
+type MockTenantRegistryMockRecorder struct {
+	mock *MockTenantRegistry
+}
+
+// NewMockTenantRegistry creates a new mock instance.
+func NewMockTenantRegistry(ctrl *gomock.Controller) *MockTenantRegistry {
+	mock := &MockTenantRegistry{ctrl: ctrl}
+	mock.recorder = &MockTenantRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantRegistry) EXPECT() *MockTenantRegistryMockRecorder {
+	return m.recorder
+}
+
+// ResolveTenant mocks base method.
+func (m *MockTenantRegistry) ResolveTenant(name string) (*models.Tenant, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveTenant", name)
+	ret0, _ := ret[0].(*models.Tenant)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// ResolveTenant indicates an expected call of ResolveTenant.
+func (mr *MockTenantRegistryMockRecorder) ResolveTenant(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveTenant", reflect.TypeOf((*MockTenantRegistry)(nil).ResolveTenant), name)
+}
+
+// StartupProgress mocks base method.
+func (m *MockTenantRegistry) StartupProgress() models.StartupProgress {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartupProgress")
+	ret0, _ := ret[0].(models.StartupProgress)
+	return ret0
+}
+
+// StartupProgress indicates an expected call of StartupProgress.
+func (mr *MockTenantRegistryMockRecorder) StartupProgress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartupProgress", reflect.TypeOf((*MockTenantRegistry)(nil).StartupProgress))
+}
+
+// MockHealthController is a mock of HealthController interface.
+type MockHealthController struct {
+	ctrl     *gomock.Controller
+	recorder *MockHealthControllerMockRecorder
+}
+
+// MockHealthControllerMockRecorder is the mock recorder for MockHealthController.
+type MockHealthControllerMockRecorder struct {
+	mock *MockHealthController
+}
+
+// NewMockHealthController creates a new mock instance.
+func NewMockHealthController(ctrl *gomock.Controller) *MockHealthController {
+	mock := &MockHealthController{ctrl: ctrl}
+	mock.recorder = &MockHealthControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHealthController) EXPECT() *MockHealthControllerMockRecorder {
+	return m.recorder
+}
+
+// Startup mocks base method.
+func (m *MockHealthController) Startup(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Startup", c)
+}
+
+// Startup indicates an expected call of Startup.
+func (mr *MockHealthControllerMockRecorder) Startup(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Startup", reflect.TypeOf((*MockHealthController)(nil).Startup), c)
+}
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: Domain/Interfaces/trash_interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// MockTrashController is a mock of TrashController interface.
+type MockTrashController struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashControllerMockRecorder
+}
+
+// MockTrashControllerMockRecorder is the mock recorder for MockTrashController.
+type MockTrashControllerMockRecorder struct {
+	mock *MockTrashController
+}
+
+// NewMockTrashController creates a new mock instance.
+func NewMockTrashController(ctrl *gomock.Controller) *MockTrashController {
+	mock := &MockTrashController{ctrl: ctrl}
+	mock.recorder = &MockTrashControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashController) EXPECT() *MockTrashControllerMockRecorder {
+	return m.recorder
+}
+
+// GetTrash mocks base method.
+func (m *MockTrashController) GetTrash(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetTrash", c)
+}
+
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockTrashControllerMockRecorder) GetTrash(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockTrashController)(nil).GetTrash), c)
+}
+
+// MockTrashUseCase is a mock of TrashUseCase interface.
+type MockTrashUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashUseCaseMockRecorder
+}
+
+// MockTrashUseCaseMockRecorder is the mock recorder for MockTrashUseCase.
+type MockTrashUseCaseMockRecorder struct {
+	mock *MockTrashUseCase
+}
+
+// NewMockTrashUseCase creates a new mock instance.
+func NewMockTrashUseCase(ctrl *gomock.Controller) *MockTrashUseCase {
+	mock := &MockTrashUseCase{ctrl: ctrl}
+	mock.recorder = &MockTrashUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashUseCase) EXPECT() *MockTrashUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetTrash mocks base method.
+func (m *MockTrashUseCase) GetTrash(entityType string, ctx *gin.Context) ([]*dtos.TrashEntryResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrash", entityType, ctx)
+	ret0, _ := ret[0].([]*dtos.TrashEntryResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockTrashUseCaseMockRecorder) GetTrash(entityType, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockTrashUseCase)(nil).GetTrash), entityType, ctx)
+}
+
+// MockTrashRepository is a mock of TrashRepository interface.
+type MockTrashRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashRepositoryMockRecorder
+}
+
+// MockTrashRepositoryMockRecorder is the mock recorder for MockTrashRepository.
+type MockTrashRepositoryMockRecorder struct {
+	mock *MockTrashRepository
+}
+
+// NewMockTrashRepository creates a new mock instance.
+func NewMockTrashRepository(ctrl *gomock.Controller) *MockTrashRepository {
+	mock := &MockTrashRepository{ctrl: ctrl}
+	mock.recorder = &MockTrashRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashRepository) EXPECT() *MockTrashRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetTrash mocks base method.
+func (m *MockTrashRepository) GetTrash(entityType string, ctx *gin.Context) ([]*dtos.TrashEntryResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrash", entityType, ctx)
+	ret0, _ := ret[0].([]*dtos.TrashEntryResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockTrashRepositoryMockRecorder) GetTrash(entityType, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockTrashRepository)(nil).GetTrash), entityType, ctx)
+}
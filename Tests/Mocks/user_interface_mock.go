@@ -5,7 +5,10 @@
 package mocks
 
 import (
+	json "encoding/json"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	gin "github.com/gin-gonic/gin"
 	gomock "github.com/golang/mock/gomock"
@@ -36,6 +39,42 @@ func (m *MockUserController) EXPECT() *MockUserControllerMockRecorder {
 	return m.recorder
 }
 
+// ActivateUser mocks base method.
+func (m *MockUserController) ActivateUser(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ActivateUser", c)
+}
+
+// ActivateUser indicates an expected call of ActivateUser.
+func (mr *MockUserControllerMockRecorder) ActivateUser(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateUser", reflect.TypeOf((*MockUserController)(nil).ActivateUser), c)
+}
+
+// AddEmailAlias mocks base method.
+func (m *MockUserController) AddEmailAlias(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddEmailAlias", c)
+}
+
+// AddEmailAlias indicates an expected call of AddEmailAlias.
+func (mr *MockUserControllerMockRecorder) AddEmailAlias(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEmailAlias", reflect.TypeOf((*MockUserController)(nil).AddEmailAlias), c)
+}
+
+// AddUserRole mocks base method.
+func (m *MockUserController) AddUserRole(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddUserRole", c)
+}
+
+// AddUserRole indicates an expected call of AddUserRole.
+func (mr *MockUserControllerMockRecorder) AddUserRole(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserRole", reflect.TypeOf((*MockUserController)(nil).AddUserRole), c)
+}
+
 // AddUserToGroup mocks base method.
 func (m *MockUserController) AddUserToGroup(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -48,6 +87,18 @@ func (mr *MockUserControllerMockRecorder) AddUserToGroup(c interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToGroup", reflect.TypeOf((*MockUserController)(nil).AddUserToGroup), c)
 }
 
+// BatchUsers mocks base method.
+func (m *MockUserController) BatchUsers(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BatchUsers", c)
+}
+
+// BatchUsers indicates an expected call of BatchUsers.
+func (mr *MockUserControllerMockRecorder) BatchUsers(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUsers", reflect.TypeOf((*MockUserController)(nil).BatchUsers), c)
+}
+
 // CreateUser mocks base method.
 func (m *MockUserController) CreateUser(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -60,6 +111,18 @@ func (mr *MockUserControllerMockRecorder) CreateUser(c interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserController)(nil).CreateUser), c)
 }
 
+// DeleteEmailAlias mocks base method.
+func (m *MockUserController) DeleteEmailAlias(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteEmailAlias", c)
+}
+
+// DeleteEmailAlias indicates an expected call of DeleteEmailAlias.
+func (mr *MockUserControllerMockRecorder) DeleteEmailAlias(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmailAlias", reflect.TypeOf((*MockUserController)(nil).DeleteEmailAlias), c)
+}
+
 // DeleteUser mocks base method.
 func (m *MockUserController) DeleteUser(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -84,6 +147,78 @@ func (mr *MockUserControllerMockRecorder) DeletetUserFromGroup(c interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletetUserFromGroup", reflect.TypeOf((*MockUserController)(nil).DeletetUserFromGroup), c)
 }
 
+// DeprovisionUser mocks base method.
+func (m *MockUserController) DeprovisionUser(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeprovisionUser", c)
+}
+
+// DeprovisionUser indicates an expected call of DeprovisionUser.
+func (mr *MockUserControllerMockRecorder) DeprovisionUser(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeprovisionUser", reflect.TypeOf((*MockUserController)(nil).DeprovisionUser), c)
+}
+
+// ExportUsers mocks base method.
+func (m *MockUserController) ExportUsers(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ExportUsers", c)
+}
+
+// ExportUsers indicates an expected call of ExportUsers.
+func (mr *MockUserControllerMockRecorder) ExportUsers(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUsers", reflect.TypeOf((*MockUserController)(nil).ExportUsers), c)
+}
+
+// GetEmailAliases mocks base method.
+func (m *MockUserController) GetEmailAliases(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetEmailAliases", c)
+}
+
+// GetEmailAliases indicates an expected call of GetEmailAliases.
+func (mr *MockUserControllerMockRecorder) GetEmailAliases(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailAliases", reflect.TypeOf((*MockUserController)(nil).GetEmailAliases), c)
+}
+
+// GetManagementChain mocks base method.
+func (m *MockUserController) GetManagementChain(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetManagementChain", c)
+}
+
+// GetManagementChain indicates an expected call of GetManagementChain.
+func (mr *MockUserControllerMockRecorder) GetManagementChain(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementChain", reflect.TypeOf((*MockUserController)(nil).GetManagementChain), c)
+}
+
+// GetReport mocks base method.
+func (m *MockUserController) GetReport(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetReport", c)
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockUserControllerMockRecorder) GetReport(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockUserController)(nil).GetReport), c)
+}
+
+// GetReports mocks base method.
+func (m *MockUserController) GetReports(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetReports", c)
+}
+
+// GetReports indicates an expected call of GetReports.
+func (mr *MockUserControllerMockRecorder) GetReports(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReports", reflect.TypeOf((*MockUserController)(nil).GetReports), c)
+}
+
 // GetUserById mocks base method.
 func (m *MockUserController) GetUserById(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -96,6 +231,18 @@ func (mr *MockUserControllerMockRecorder) GetUserById(c interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserById", reflect.TypeOf((*MockUserController)(nil).GetUserById), c)
 }
 
+// GetUserRoles mocks base method.
+func (m *MockUserController) GetUserRoles(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetUserRoles", c)
+}
+
+// GetUserRoles indicates an expected call of GetUserRoles.
+func (mr *MockUserControllerMockRecorder) GetUserRoles(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRoles", reflect.TypeOf((*MockUserController)(nil).GetUserRoles), c)
+}
+
 // GetUsers mocks base method.
 func (m *MockUserController) GetUsers(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -120,6 +267,102 @@ func (mr *MockUserControllerMockRecorder) GetUsersGroup(c interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersGroup", reflect.TypeOf((*MockUserController)(nil).GetUsersGroup), c)
 }
 
+// ImportUsers mocks base method.
+func (m *MockUserController) ImportUsers(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ImportUsers", c)
+}
+
+// ImportUsers indicates an expected call of ImportUsers.
+func (mr *MockUserControllerMockRecorder) ImportUsers(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUsers", reflect.TypeOf((*MockUserController)(nil).ImportUsers), c)
+}
+
+// LockUser mocks base method.
+func (m *MockUserController) LockUser(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LockUser", c)
+}
+
+// LockUser indicates an expected call of LockUser.
+func (mr *MockUserControllerMockRecorder) LockUser(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUser", reflect.TypeOf((*MockUserController)(nil).LockUser), c)
+}
+
+// MergeUsers mocks base method.
+func (m *MockUserController) MergeUsers(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MergeUsers", c)
+}
+
+// MergeUsers indicates an expected call of MergeUsers.
+func (mr *MockUserControllerMockRecorder) MergeUsers(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeUsers", reflect.TypeOf((*MockUserController)(nil).MergeUsers), c)
+}
+
+// RemoveUserRole mocks base method.
+func (m *MockUserController) RemoveUserRole(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveUserRole", c)
+}
+
+// RemoveUserRole indicates an expected call of RemoveUserRole.
+func (mr *MockUserControllerMockRecorder) RemoveUserRole(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserRole", reflect.TypeOf((*MockUserController)(nil).RemoveUserRole), c)
+}
+
+// RestoreUser mocks base method.
+func (m *MockUserController) RestoreUser(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RestoreUser", c)
+}
+
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockUserControllerMockRecorder) RestoreUser(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockUserController)(nil).RestoreUser), c)
+}
+
+// SendEmailAliasVerification mocks base method.
+func (m *MockUserController) SendEmailAliasVerification(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SendEmailAliasVerification", c)
+}
+
+// SendEmailAliasVerification indicates an expected call of SendEmailAliasVerification.
+func (mr *MockUserControllerMockRecorder) SendEmailAliasVerification(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmailAliasVerification", reflect.TypeOf((*MockUserController)(nil).SendEmailAliasVerification), c)
+}
+
+// SendEmailVerification mocks base method.
+func (m *MockUserController) SendEmailVerification(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SendEmailVerification", c)
+}
+
+// SendEmailVerification indicates an expected call of SendEmailVerification.
+func (mr *MockUserControllerMockRecorder) SendEmailVerification(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmailVerification", reflect.TypeOf((*MockUserController)(nil).SendEmailVerification), c)
+}
+
+// SuspendUser mocks base method.
+func (m *MockUserController) SuspendUser(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SuspendUser", c)
+}
+
+// SuspendUser indicates an expected call of SuspendUser.
+func (mr *MockUserControllerMockRecorder) SuspendUser(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendUser", reflect.TypeOf((*MockUserController)(nil).SuspendUser), c)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserController) UpdateUser(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -132,6 +375,18 @@ func (mr *MockUserControllerMockRecorder) UpdateUser(c interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserController)(nil).UpdateUser), c)
 }
 
+// VerifyEmail mocks base method.
+func (m *MockUserController) VerifyEmail(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VerifyEmail", c)
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockUserControllerMockRecorder) VerifyEmail(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockUserController)(nil).VerifyEmail), c)
+}
+
 // MockUserUseCase is a mock of UserUseCase interface.
 type MockUserUseCase struct {
 	ctrl     *gomock.Controller
@@ -155,6 +410,36 @@ func (m *MockUserUseCase) EXPECT() *MockUserUseCaseMockRecorder {
 	return m.recorder
 }
 
+// AddEmailAlias mocks base method.
+func (m *MockUserUseCase) AddEmailAlias(id string, req dtos.EmailAliasCreateRequest, ctx *gin.Context) (*dtos.EmailAliasResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEmailAlias", id, req, ctx)
+	ret0, _ := ret[0].(*dtos.EmailAliasResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// AddEmailAlias indicates an expected call of AddEmailAlias.
+func (mr *MockUserUseCaseMockRecorder) AddEmailAlias(id, req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEmailAlias", reflect.TypeOf((*MockUserUseCase)(nil).AddEmailAlias), id, req, ctx)
+}
+
+// AddUserRole mocks base method.
+func (m *MockUserUseCase) AddUserRole(id string, req dtos.UserRoleAssignRequest, ctx *gin.Context) ([]dtos.UserRoleResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserRole", id, req, ctx)
+	ret0, _ := ret[0].([]dtos.UserRoleResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// AddUserRole indicates an expected call of AddUserRole.
+func (mr *MockUserUseCaseMockRecorder) AddUserRole(id, req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserRole", reflect.TypeOf((*MockUserUseCase)(nil).AddUserRole), id, req, ctx)
+}
+
 // AddUserToGroup mocks base method.
 func (m *MockUserUseCase) AddUserToGroup(req dtos.AddUserToGroupRequest, ctx *gin.Context) (*models.ErrorResponse, string) {
 	m.ctrl.T.Helper()
@@ -170,18 +455,34 @@ func (mr *MockUserUseCaseMockRecorder) AddUserToGroup(req, ctx interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToGroup", reflect.TypeOf((*MockUserUseCase)(nil).AddUserToGroup), req, ctx)
 }
 
-// AddUserToRole mocks base method.
-func (m *MockUserUseCase) AddUserToRole(req dtos.AddUserToRoleRequest, ctx *gin.Context) *models.ErrorResponse {
+// BatchUsers mocks base method.
+func (m *MockUserUseCase) BatchUsers(req dtos.BatchUsersRequest, ctx *gin.Context) (*dtos.BatchUsersResponse, *models.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddUserToRole", req, ctx)
-	ret0, _ := ret[0].(*models.ErrorResponse)
-	return ret0
+	ret := m.ctrl.Call(m, "BatchUsers", req, ctx)
+	ret0, _ := ret[0].(*dtos.BatchUsersResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// BatchUsers indicates an expected call of BatchUsers.
+func (mr *MockUserUseCaseMockRecorder) BatchUsers(req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUsers", reflect.TypeOf((*MockUserUseCase)(nil).BatchUsers), req, ctx)
+}
+
+// ChangeUserStatus mocks base method.
+func (m *MockUserUseCase) ChangeUserStatus(id string, status models.UserStatus, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeUserStatus", id, status, ctx)
+	ret0, _ := ret[0].(*dtos.UserResponseSingle)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
 }
 
-// AddUserToRole indicates an expected call of AddUserToRole.
-func (mr *MockUserUseCaseMockRecorder) AddUserToRole(req, ctx interface{}) *gomock.Call {
+// ChangeUserStatus indicates an expected call of ChangeUserStatus.
+func (mr *MockUserUseCaseMockRecorder) ChangeUserStatus(id, status, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToRole", reflect.TypeOf((*MockUserUseCase)(nil).AddUserToRole), req, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeUserStatus", reflect.TypeOf((*MockUserUseCase)(nil).ChangeUserStatus), id, status, ctx)
 }
 
 // CheckEmailExists mocks base method.
@@ -214,6 +515,20 @@ func (mr *MockUserUseCaseMockRecorder) CreateUser(user, ctx interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserUseCase)(nil).CreateUser), user, ctx)
 }
 
+// DeleteEmailAlias mocks base method.
+func (m *MockUserUseCase) DeleteEmailAlias(id, aliasID string, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmailAlias", id, aliasID, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// DeleteEmailAlias indicates an expected call of DeleteEmailAlias.
+func (mr *MockUserUseCaseMockRecorder) DeleteEmailAlias(id, aliasID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmailAlias", reflect.TypeOf((*MockUserUseCase)(nil).DeleteEmailAlias), id, aliasID, ctx)
+}
+
 // DeleteUser mocks base method.
 func (m *MockUserUseCase) DeleteUser(id string, ctx *gin.Context) *models.ErrorResponse {
 	m.ctrl.T.Helper()
@@ -228,6 +543,20 @@ func (mr *MockUserUseCaseMockRecorder) DeleteUser(id, ctx interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserUseCase)(nil).DeleteUser), id, ctx)
 }
 
+// ExportUsers mocks base method.
+func (m *MockUserUseCase) ExportUsers(fn func(*dtos.UserExportRow) error, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUsers", fn, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// ExportUsers indicates an expected call of ExportUsers.
+func (mr *MockUserUseCaseMockRecorder) ExportUsers(fn, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUsers", reflect.TypeOf((*MockUserUseCase)(nil).ExportUsers), fn, ctx)
+}
+
 // GetAllUsers mocks base method.
 func (m *MockUserUseCase) GetAllUsers(ctx *gin.Context) ([]*dtos.UserResponseAll, *models.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -243,144 +572,394 @@ func (mr *MockUserUseCaseMockRecorder) GetAllUsers(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsers", reflect.TypeOf((*MockUserUseCase)(nil).GetAllUsers), ctx)
 }
 
-// GetUserById mocks base method.
-func (m *MockUserUseCase) GetUserById(id string, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+// GetEmailAliases mocks base method.
+func (m *MockUserUseCase) GetEmailAliases(id string, ctx *gin.Context) ([]*dtos.EmailAliasResponse, *models.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserById", id, ctx)
-	ret0, _ := ret[0].(*dtos.UserResponseSingle)
+	ret := m.ctrl.Call(m, "GetEmailAliases", id, ctx)
+	ret0, _ := ret[0].([]*dtos.EmailAliasResponse)
 	ret1, _ := ret[1].(*models.ErrorResponse)
 	return ret0, ret1
 }
 
-// GetUserById indicates an expected call of GetUserById.
-func (mr *MockUserUseCaseMockRecorder) GetUserById(id, ctx interface{}) *gomock.Call {
+// GetEmailAliases indicates an expected call of GetEmailAliases.
+func (mr *MockUserUseCaseMockRecorder) GetEmailAliases(id, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserById", reflect.TypeOf((*MockUserUseCase)(nil).GetUserById), id, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailAliases", reflect.TypeOf((*MockUserUseCase)(nil).GetEmailAliases), id, ctx)
 }
 
-// GetUsersGroup mocks base method.
-func (m *MockUserUseCase) GetUsersGroup(id string, ctx *gin.Context) ([]*dtos.GroupResponse, *models.ErrorResponse) {
+// GetManagementChain mocks base method.
+func (m *MockUserUseCase) GetManagementChain(id string, ctx *gin.Context) ([]*dtos.UserReportResponse, *models.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsersGroup", id, ctx)
-	ret0, _ := ret[0].([]*dtos.GroupResponse)
+	ret := m.ctrl.Call(m, "GetManagementChain", id, ctx)
+	ret0, _ := ret[0].([]*dtos.UserReportResponse)
 	ret1, _ := ret[1].(*models.ErrorResponse)
 	return ret0, ret1
 }
 
-// GetUsersGroup indicates an expected call of GetUsersGroup.
-func (mr *MockUserUseCaseMockRecorder) GetUsersGroup(id, ctx interface{}) *gomock.Call {
+// GetManagementChain indicates an expected call of GetManagementChain.
+func (mr *MockUserUseCaseMockRecorder) GetManagementChain(id, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersGroup", reflect.TypeOf((*MockUserUseCase)(nil).GetUsersGroup), id, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementChain", reflect.TypeOf((*MockUserUseCase)(nil).GetManagementChain), id, ctx)
 }
 
-// RemoveUserFromGroup mocks base method.
-func (m *MockUserUseCase) RemoveUserFromGroup(req dtos.RemoveUserFromGroupRequest, ctx *gin.Context) (string, *models.ErrorResponse) {
+// GetReport mocks base method.
+func (m *MockUserUseCase) GetReport(id, reportID string, ctx *gin.Context) (*dtos.UserReportResponse, *models.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveUserFromGroup", req, ctx)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetReport", id, reportID, ctx)
+	ret0, _ := ret[0].(*dtos.UserReportResponse)
 	ret1, _ := ret[1].(*models.ErrorResponse)
 	return ret0, ret1
 }
 
-// RemoveUserFromGroup indicates an expected call of RemoveUserFromGroup.
-func (mr *MockUserUseCaseMockRecorder) RemoveUserFromGroup(req, ctx interface{}) *gomock.Call {
+// GetReport indicates an expected call of GetReport.
+func (mr *MockUserUseCaseMockRecorder) GetReport(id, reportID, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserFromGroup", reflect.TypeOf((*MockUserUseCase)(nil).RemoveUserFromGroup), req, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockUserUseCase)(nil).GetReport), id, reportID, ctx)
 }
 
-// SearchUsers mocks base method.
-func (m *MockUserUseCase) SearchUsers(searchFields dtos.SearchFields, ctx *gin.Context) ([]*dtos.UserResponseAll, *models.ErrorResponse) {
+// GetReports mocks base method.
+func (m *MockUserUseCase) GetReports(id string, all bool, ctx *gin.Context) ([]*dtos.UserReportResponse, *models.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchUsers", searchFields, ctx)
-	ret0, _ := ret[0].([]*dtos.UserResponseAll)
+	ret := m.ctrl.Call(m, "GetReports", id, all, ctx)
+	ret0, _ := ret[0].([]*dtos.UserReportResponse)
 	ret1, _ := ret[1].(*models.ErrorResponse)
 	return ret0, ret1
 }
 
-// SearchUsers indicates an expected call of SearchUsers.
-func (mr *MockUserUseCaseMockRecorder) SearchUsers(searchFields, ctx interface{}) *gomock.Call {
+// GetReports indicates an expected call of GetReports.
+func (mr *MockUserUseCaseMockRecorder) GetReports(id, all, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockUserUseCase)(nil).SearchUsers), searchFields, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReports", reflect.TypeOf((*MockUserUseCase)(nil).GetReports), id, all, ctx)
 }
 
-// UpdateUser mocks base method.
-func (m *MockUserUseCase) UpdateUser(id string, user dtos.UserUpdateRequest, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+// GetUserById mocks base method.
+func (m *MockUserUseCase) GetUserById(id string, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUser", id, user, ctx)
+	ret := m.ctrl.Call(m, "GetUserById", id, ctx)
 	ret0, _ := ret[0].(*dtos.UserResponseSingle)
 	ret1, _ := ret[1].(*models.ErrorResponse)
 	return ret0, ret1
 }
 
-// UpdateUser indicates an expected call of UpdateUser.
-func (mr *MockUserUseCaseMockRecorder) UpdateUser(id, user, ctx interface{}) *gomock.Call {
+// GetUserById indicates an expected call of GetUserById.
+func (mr *MockUserUseCaseMockRecorder) GetUserById(id, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserUseCase)(nil).UpdateUser), id, user, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserById", reflect.TypeOf((*MockUserUseCase)(nil).GetUserById), id, ctx)
 }
 
-// ValidateEmail mocks base method.
-func (m *MockUserUseCase) ValidateEmail(email string) *models.ErrorResponse {
+// GetUserRedirect mocks base method.
+func (m *MockUserUseCase) GetUserRedirect(id string, ctx *gin.Context) (string, *models.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateEmail", email)
-	ret0, _ := ret[0].(*models.ErrorResponse)
-	return ret0
+	ret := m.ctrl.Call(m, "GetUserRedirect", id, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
 }
 
-// ValidateEmail indicates an expected call of ValidateEmail.
-func (mr *MockUserUseCaseMockRecorder) ValidateEmail(email interface{}) *gomock.Call {
+// GetUserRedirect indicates an expected call of GetUserRedirect.
+func (mr *MockUserUseCaseMockRecorder) GetUserRedirect(id, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateEmail", reflect.TypeOf((*MockUserUseCase)(nil).ValidateEmail), email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRedirect", reflect.TypeOf((*MockUserUseCase)(nil).GetUserRedirect), id, ctx)
 }
 
-// MockUserRepository is a mock of UserRepository interface.
-type MockUserRepository struct {
-	ctrl     *gomock.Controller
-	recorder *MockUserRepositoryMockRecorder
+// GetUserRoles mocks base method.
+func (m *MockUserUseCase) GetUserRoles(id string, ctx *gin.Context) ([]dtos.UserRoleResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRoles", id, ctx)
+	ret0, _ := ret[0].([]dtos.UserRoleResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
 }
 
-// MockUserRepositoryMockRecorder is the mock recorder for MockUserRepository.
-type MockUserRepositoryMockRecorder struct {
-	mock *MockUserRepository
+// GetUserRoles indicates an expected call of GetUserRoles.
+func (mr *MockUserUseCaseMockRecorder) GetUserRoles(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRoles", reflect.TypeOf((*MockUserUseCase)(nil).GetUserRoles), id, ctx)
 }
 
-// NewMockUserRepository creates a new mock instance.
-func NewMockUserRepository(ctrl *gomock.Controller) *MockUserRepository {
-	mock := &MockUserRepository{ctrl: ctrl}
-	mock.recorder = &MockUserRepositoryMockRecorder{mock}
-	return mock
+// GetUsersGroup mocks base method.
+func (m *MockUserUseCase) GetUsersGroup(id string, filter dtos.MembershipFilter, ctx *gin.Context) ([]*dtos.GroupResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersGroup", id, filter, ctx)
+	ret0, _ := ret[0].([]*dtos.GroupResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
-	return m.recorder
+// GetUsersGroup indicates an expected call of GetUsersGroup.
+func (mr *MockUserUseCaseMockRecorder) GetUsersGroup(id, filter, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersGroup", reflect.TypeOf((*MockUserUseCase)(nil).GetUsersGroup), id, filter, ctx)
 }
 
-// AddUserToGroup mocks base method.
-func (m *MockUserRepository) AddUserToGroup(req dtos.AddUserToGroupRequest, ctx *gin.Context) *models.ErrorResponse {
+// ImportUsers mocks base method.
+func (m *MockUserUseCase) ImportUsers(reader io.Reader, req dtos.UserImportRequest, ctx *gin.Context) (*dtos.UserImportResult, *models.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddUserToGroup", req, ctx)
-	ret0, _ := ret[0].(*models.ErrorResponse)
-	return ret0
+	ret := m.ctrl.Call(m, "ImportUsers", reader, req, ctx)
+	ret0, _ := ret[0].(*dtos.UserImportResult)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
 }
 
-// AddUserToGroup indicates an expected call of AddUserToGroup.
-func (mr *MockUserRepositoryMockRecorder) AddUserToGroup(req, ctx interface{}) *gomock.Call {
+// ImportUsers indicates an expected call of ImportUsers.
+func (mr *MockUserUseCaseMockRecorder) ImportUsers(reader, req, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToGroup", reflect.TypeOf((*MockUserRepository)(nil).AddUserToGroup), req, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUsers", reflect.TypeOf((*MockUserUseCase)(nil).ImportUsers), reader, req, ctx)
 }
 
-// AddUserToRole mocks base method.
-func (m *MockUserRepository) AddUserToRole(req dtos.AddUserToRoleRequest, ctx *gin.Context) *models.ErrorResponse {
+// MergeUsers mocks base method.
+func (m *MockUserUseCase) MergeUsers(id string, req dtos.UserMergeRequest, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddUserToRole", req, ctx)
+	ret := m.ctrl.Call(m, "MergeUsers", id, req, ctx)
+	ret0, _ := ret[0].(*dtos.UserResponseSingle)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// MergeUsers indicates an expected call of MergeUsers.
+func (mr *MockUserUseCaseMockRecorder) MergeUsers(id, req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeUsers", reflect.TypeOf((*MockUserUseCase)(nil).MergeUsers), id, req, ctx)
+}
+
+// RemoveUserFromGroup mocks base method.
+func (m *MockUserUseCase) RemoveUserFromGroup(req dtos.RemoveUserFromGroupRequest, ctx *gin.Context) (string, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUserFromGroup", req, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// RemoveUserFromGroup indicates an expected call of RemoveUserFromGroup.
+func (mr *MockUserUseCaseMockRecorder) RemoveUserFromGroup(req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserFromGroup", reflect.TypeOf((*MockUserUseCase)(nil).RemoveUserFromGroup), req, ctx)
+}
+
+// RemoveUserRole mocks base method.
+func (m *MockUserUseCase) RemoveUserRole(id, roleID string, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUserRole", id, roleID, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// RemoveUserRole indicates an expected call of RemoveUserRole.
+func (mr *MockUserUseCaseMockRecorder) RemoveUserRole(id, roleID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserRole", reflect.TypeOf((*MockUserUseCase)(nil).RemoveUserRole), id, roleID, ctx)
+}
+
+// RestoreUser mocks base method.
+func (m *MockUserUseCase) RestoreUser(id string, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUser", id, ctx)
+	ret0, _ := ret[0].(*dtos.UserResponseSingle)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockUserUseCaseMockRecorder) RestoreUser(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockUserUseCase)(nil).RestoreUser), id, ctx)
+}
+
+// SearchUsers mocks base method.
+func (m *MockUserUseCase) SearchUsers(searchFields dtos.SearchFields, ctx *gin.Context) ([]*dtos.UserResponseAll, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsers", searchFields, ctx)
+	ret0, _ := ret[0].([]*dtos.UserResponseAll)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// SearchUsers indicates an expected call of SearchUsers.
+func (mr *MockUserUseCaseMockRecorder) SearchUsers(searchFields, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockUserUseCase)(nil).SearchUsers), searchFields, ctx)
+}
+
+// SendEmailAliasVerification mocks base method.
+func (m *MockUserUseCase) SendEmailAliasVerification(id, aliasID string, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEmailAliasVerification", id, aliasID, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// SendEmailAliasVerification indicates an expected call of SendEmailAliasVerification.
+func (mr *MockUserUseCaseMockRecorder) SendEmailAliasVerification(id, aliasID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmailAliasVerification", reflect.TypeOf((*MockUserUseCase)(nil).SendEmailAliasVerification), id, aliasID, ctx)
+}
+
+// SendEmailVerification mocks base method.
+func (m *MockUserUseCase) SendEmailVerification(id string, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEmailVerification", id, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// SendEmailVerification indicates an expected call of SendEmailVerification.
+func (mr *MockUserUseCaseMockRecorder) SendEmailVerification(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmailVerification", reflect.TypeOf((*MockUserUseCase)(nil).SendEmailVerification), id, ctx)
+}
+
+// UpdateUser mocks base method.
+func (m *MockUserUseCase) UpdateUser(id string, user dtos.UserUpdateRequest, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", id, user, ctx)
+	ret0, _ := ret[0].(*dtos.UserResponseSingle)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockUserUseCaseMockRecorder) UpdateUser(id, user, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserUseCase)(nil).UpdateUser), id, user, ctx)
+}
+
+// ValidateAttributes mocks base method.
+func (m *MockUserUseCase) ValidateAttributes(attributes json.RawMessage, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAttributes", attributes, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// ValidateAttributes indicates an expected call of ValidateAttributes.
+func (mr *MockUserUseCaseMockRecorder) ValidateAttributes(attributes, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAttributes", reflect.TypeOf((*MockUserUseCase)(nil).ValidateAttributes), attributes, ctx)
+}
+
+// ValidateEmail mocks base method.
+func (m *MockUserUseCase) ValidateEmail(email string) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateEmail", email)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// ValidateEmail indicates an expected call of ValidateEmail.
+func (mr *MockUserUseCaseMockRecorder) ValidateEmail(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateEmail", reflect.TypeOf((*MockUserUseCase)(nil).ValidateEmail), email)
+}
+
+// ValidateNewUser mocks base method.
+func (m *MockUserUseCase) ValidateNewUser(user *dtos.UserCreateRequest, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateNewUser", user, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// ValidateNewUser indicates an expected call of ValidateNewUser.
+func (mr *MockUserUseCaseMockRecorder) ValidateNewUser(user, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateNewUser", reflect.TypeOf((*MockUserUseCase)(nil).ValidateNewUser), user, ctx)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockUserUseCase) VerifyEmail(token string, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", token, ctx)
+	ret0, _ := ret[0].(*dtos.UserResponseSingle)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockUserUseCaseMockRecorder) VerifyEmail(token, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockUserUseCase)(nil).VerifyEmail), token, ctx)
+}
+
+// MockUserRepository is a mock of UserRepository interface.
+type MockUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRepositoryMockRecorder
+}
+
+// MockUserRepositoryMockRecorder is the mock recorder for MockUserRepository.
+type MockUserRepositoryMockRecorder struct {
+	mock *MockUserRepository
+}
+
+// NewMockUserRepository creates a new mock instance.
+func NewMockUserRepository(ctrl *gomock.Controller) *MockUserRepository {
+	mock := &MockUserRepository{ctrl: ctrl}
+	mock.recorder = &MockUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddUserRole mocks base method.
+func (m *MockUserRepository) AddUserRole(uid string, req dtos.UserRoleAssignRequest, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserRole", uid, req, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// AddUserRole indicates an expected call of AddUserRole.
+func (mr *MockUserRepositoryMockRecorder) AddUserRole(uid, req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserRole", reflect.TypeOf((*MockUserRepository)(nil).AddUserRole), uid, req, ctx)
+}
+
+// AddUserToGroup mocks base method.
+func (m *MockUserRepository) AddUserToGroup(req dtos.AddUserToGroupRequest, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserToGroup", req, ctx)
 	ret0, _ := ret[0].(*models.ErrorResponse)
 	return ret0
 }
 
-// AddUserToRole indicates an expected call of AddUserToRole.
-func (mr *MockUserRepositoryMockRecorder) AddUserToRole(req, ctx interface{}) *gomock.Call {
+// AddUserToGroup indicates an expected call of AddUserToGroup.
+func (mr *MockUserRepositoryMockRecorder) AddUserToGroup(req, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToRole", reflect.TypeOf((*MockUserRepository)(nil).AddUserToRole), req, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToGroup", reflect.TypeOf((*MockUserRepository)(nil).AddUserToGroup), req, ctx)
+}
+
+// ApplyUserBatch mocks base method.
+func (m *MockUserRepository) ApplyUserBatch(changes []dtos.BatchUserChange, continueOnError bool, ctx *gin.Context) ([]*dtos.BatchUserResult, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyUserBatch", changes, continueOnError, ctx)
+	ret0, _ := ret[0].([]*dtos.BatchUserResult)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// ApplyUserBatch indicates an expected call of ApplyUserBatch.
+func (mr *MockUserRepositoryMockRecorder) ApplyUserBatch(changes, continueOnError, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyUserBatch", reflect.TypeOf((*MockUserRepository)(nil).ApplyUserBatch), changes, continueOnError, ctx)
+}
+
+// CreateEmailAlias mocks base method.
+func (m *MockUserRepository) CreateEmailAlias(userUID, email string, ctx *gin.Context) (*dtos.EmailAliasResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmailAlias", userUID, email, ctx)
+	ret0, _ := ret[0].(*dtos.EmailAliasResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// CreateEmailAlias indicates an expected call of CreateEmailAlias.
+func (mr *MockUserRepositoryMockRecorder) CreateEmailAlias(userUID, email, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmailAlias", reflect.TypeOf((*MockUserRepository)(nil).CreateEmailAlias), userUID, email, ctx)
 }
 
 // CreateUser mocks base method.
@@ -398,6 +977,20 @@ func (mr *MockUserRepositoryMockRecorder) CreateUser(user, ctx interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserRepository)(nil).CreateUser), user, ctx)
 }
 
+// DeleteEmailAlias mocks base method.
+func (m *MockUserRepository) DeleteEmailAlias(userUID, aliasUID string, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmailAlias", userUID, aliasUID, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// DeleteEmailAlias indicates an expected call of DeleteEmailAlias.
+func (mr *MockUserRepositoryMockRecorder) DeleteEmailAlias(userUID, aliasUID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmailAlias", reflect.TypeOf((*MockUserRepository)(nil).DeleteEmailAlias), userUID, aliasUID, ctx)
+}
+
 // DeleteUser mocks base method.
 func (m *MockUserRepository) DeleteUser(id string, ctx *gin.Context) *models.ErrorResponse {
 	m.ctrl.T.Helper()
@@ -412,6 +1005,66 @@ func (mr *MockUserRepositoryMockRecorder) DeleteUser(id, ctx interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserRepository)(nil).DeleteUser), id, ctx)
 }
 
+// EraseUser mocks base method.
+func (m *MockUserRepository) EraseUser(uid string, ctx *gin.Context) (*dtos.ErasedUser, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseUser", uid, ctx)
+	ret0, _ := ret[0].(*dtos.ErasedUser)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// EraseUser indicates an expected call of EraseUser.
+func (mr *MockUserRepositoryMockRecorder) EraseUser(uid, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseUser", reflect.TypeOf((*MockUserRepository)(nil).EraseUser), uid, ctx)
+}
+
+// ExpireGroupMemberships mocks base method.
+func (m *MockUserRepository) ExpireGroupMemberships(now time.Time, ctx *gin.Context) ([]dtos.ExpiredGroupMembership, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireGroupMemberships", now, ctx)
+	ret0, _ := ret[0].([]dtos.ExpiredGroupMembership)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// ExpireGroupMemberships indicates an expected call of ExpireGroupMemberships.
+func (mr *MockUserRepositoryMockRecorder) ExpireGroupMemberships(now, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireGroupMemberships", reflect.TypeOf((*MockUserRepository)(nil).ExpireGroupMemberships), now, ctx)
+}
+
+// ExpireRoleAssignments mocks base method.
+func (m *MockUserRepository) ExpireRoleAssignments(now time.Time, ctx *gin.Context) ([]dtos.ExpiredRoleAssignment, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireRoleAssignments", now, ctx)
+	ret0, _ := ret[0].([]dtos.ExpiredRoleAssignment)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// ExpireRoleAssignments indicates an expected call of ExpireRoleAssignments.
+func (mr *MockUserRepositoryMockRecorder) ExpireRoleAssignments(now, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireRoleAssignments", reflect.TypeOf((*MockUserRepository)(nil).ExpireRoleAssignments), now, ctx)
+}
+
+// ExportUser mocks base method.
+func (m *MockUserRepository) ExportUser(uid string, ctx *gin.Context) (*dtos.UserDataExport, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUser", uid, ctx)
+	ret0, _ := ret[0].(*dtos.UserDataExport)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// ExportUser indicates an expected call of ExportUser.
+func (mr *MockUserRepositoryMockRecorder) ExportUser(uid, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUser", reflect.TypeOf((*MockUserRepository)(nil).ExportUser), uid, ctx)
+}
+
 // GetAllUsers mocks base method.
 func (m *MockUserRepository) GetAllUsers(ctx *gin.Context) ([]*dtos.UserResponseAll, *models.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -427,6 +1080,81 @@ func (mr *MockUserRepositoryMockRecorder) GetAllUsers(ctx interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsers", reflect.TypeOf((*MockUserRepository)(nil).GetAllUsers), ctx)
 }
 
+// GetEmailAlias mocks base method.
+func (m *MockUserRepository) GetEmailAlias(userUID, aliasUID string, ctx *gin.Context) (*dtos.EmailAliasResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailAlias", userUID, aliasUID, ctx)
+	ret0, _ := ret[0].(*dtos.EmailAliasResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetEmailAlias indicates an expected call of GetEmailAlias.
+func (mr *MockUserRepositoryMockRecorder) GetEmailAlias(userUID, aliasUID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailAlias", reflect.TypeOf((*MockUserRepository)(nil).GetEmailAlias), userUID, aliasUID, ctx)
+}
+
+// GetEmailAliases mocks base method.
+func (m *MockUserRepository) GetEmailAliases(userUID string, ctx *gin.Context) ([]*dtos.EmailAliasResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailAliases", userUID, ctx)
+	ret0, _ := ret[0].([]*dtos.EmailAliasResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetEmailAliases indicates an expected call of GetEmailAliases.
+func (mr *MockUserRepositoryMockRecorder) GetEmailAliases(userUID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailAliases", reflect.TypeOf((*MockUserRepository)(nil).GetEmailAliases), userUID, ctx)
+}
+
+// GetManagementChain mocks base method.
+func (m *MockUserRepository) GetManagementChain(uid string, ctx *gin.Context) ([]*dtos.UserReportResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManagementChain", uid, ctx)
+	ret0, _ := ret[0].([]*dtos.UserReportResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetManagementChain indicates an expected call of GetManagementChain.
+func (mr *MockUserRepositoryMockRecorder) GetManagementChain(uid, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementChain", reflect.TypeOf((*MockUserRepository)(nil).GetManagementChain), uid, ctx)
+}
+
+// GetReports mocks base method.
+func (m *MockUserRepository) GetReports(uid string, maxDepth int, ctx *gin.Context) ([]*dtos.UserReportResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReports", uid, maxDepth, ctx)
+	ret0, _ := ret[0].([]*dtos.UserReportResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetReports indicates an expected call of GetReports.
+func (mr *MockUserRepositoryMockRecorder) GetReports(uid, maxDepth, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReports", reflect.TypeOf((*MockUserRepository)(nil).GetReports), uid, maxDepth, ctx)
+}
+
+// GetUserAvatar mocks base method.
+func (m *MockUserRepository) GetUserAvatar(uid string, ctx *gin.Context) (*dtos.UserAvatar, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAvatar", uid, ctx)
+	ret0, _ := ret[0].(*dtos.UserAvatar)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetUserAvatar indicates an expected call of GetUserAvatar.
+func (mr *MockUserRepositoryMockRecorder) GetUserAvatar(uid, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAvatar", reflect.TypeOf((*MockUserRepository)(nil).GetUserAvatar), uid, ctx)
+}
+
 // GetUserByEmail mocks base method.
 func (m *MockUserRepository) GetUserByEmail(email string, ctx *gin.Context) (*models.User, *models.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -457,6 +1185,51 @@ func (mr *MockUserRepositoryMockRecorder) GetUserById(id, ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserById", reflect.TypeOf((*MockUserRepository)(nil).GetUserById), id, ctx)
 }
 
+// GetUserRedirect mocks base method.
+func (m *MockUserRepository) GetUserRedirect(uid string, ctx *gin.Context) (string, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRedirect", uid, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetUserRedirect indicates an expected call of GetUserRedirect.
+func (mr *MockUserRepositoryMockRecorder) GetUserRedirect(uid, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRedirect", reflect.TypeOf((*MockUserRepository)(nil).GetUserRedirect), uid, ctx)
+}
+
+// GetUserRoles mocks base method.
+func (m *MockUserRepository) GetUserRoles(uid string, ctx *gin.Context) ([]dtos.UserRoleResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRoles", uid, ctx)
+	ret0, _ := ret[0].([]dtos.UserRoleResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetUserRoles indicates an expected call of GetUserRoles.
+func (mr *MockUserRepositoryMockRecorder) GetUserRoles(uid, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRoles", reflect.TypeOf((*MockUserRepository)(nil).GetUserRoles), uid, ctx)
+}
+
+// GetUsersByIds mocks base method.
+func (m *MockUserRepository) GetUsersByIds(uids []string, ctx *gin.Context) ([]*models.User, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByIds", uids, ctx)
+	ret0, _ := ret[0].([]*models.User)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetUsersByIds indicates an expected call of GetUsersByIds.
+func (mr *MockUserRepositoryMockRecorder) GetUsersByIds(uids, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIds", reflect.TypeOf((*MockUserRepository)(nil).GetUsersByIds), uids, ctx)
+}
+
 // GetUsersGroups mocks base method.
 func (m *MockUserRepository) GetUsersGroups(uid string, ctx *gin.Context) ([]*dtos.GroupResponse, *models.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -472,6 +1245,108 @@ func (mr *MockUserRepositoryMockRecorder) GetUsersGroups(uid, ctx interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersGroups", reflect.TypeOf((*MockUserRepository)(nil).GetUsersGroups), uid, ctx)
 }
 
+// GetUsersGroupsTransitive mocks base method.
+func (m *MockUserRepository) GetUsersGroupsTransitive(uid string, ctx *gin.Context) ([]*dtos.GroupResponse, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersGroupsTransitive", uid, ctx)
+	ret0, _ := ret[0].([]*dtos.GroupResponse)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// GetUsersGroupsTransitive indicates an expected call of GetUsersGroupsTransitive.
+func (mr *MockUserRepositoryMockRecorder) GetUsersGroupsTransitive(uid, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersGroupsTransitive", reflect.TypeOf((*MockUserRepository)(nil).GetUsersGroupsTransitive), uid, ctx)
+}
+
+// ImportUsers mocks base method.
+func (m *MockUserRepository) ImportUsers(next func() (*dtos.UserImportRow, error), dryRun bool, ctx *gin.Context) (*dtos.UserImportResult, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportUsers", next, dryRun, ctx)
+	ret0, _ := ret[0].(*dtos.UserImportResult)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// ImportUsers indicates an expected call of ImportUsers.
+func (mr *MockUserRepositoryMockRecorder) ImportUsers(next, dryRun, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUsers", reflect.TypeOf((*MockUserRepository)(nil).ImportUsers), next, dryRun, ctx)
+}
+
+// IsEmailTaken mocks base method.
+func (m *MockUserRepository) IsEmailTaken(email, exceptUserUID string, ctx *gin.Context) (bool, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsEmailTaken", email, exceptUserUID, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// IsEmailTaken indicates an expected call of IsEmailTaken.
+func (mr *MockUserRepositoryMockRecorder) IsEmailTaken(email, exceptUserUID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEmailTaken", reflect.TypeOf((*MockUserRepository)(nil).IsEmailTaken), email, exceptUserUID, ctx)
+}
+
+// IsManagerOf mocks base method.
+func (m *MockUserRepository) IsManagerOf(uid string, actor models.Actor, ctx *gin.Context) (bool, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsManagerOf", uid, actor, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// IsManagerOf indicates an expected call of IsManagerOf.
+func (mr *MockUserRepositoryMockRecorder) IsManagerOf(uid, actor, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsManagerOf", reflect.TypeOf((*MockUserRepository)(nil).IsManagerOf), uid, actor, ctx)
+}
+
+// MarkEmailAliasVerified mocks base method.
+func (m *MockUserRepository) MarkEmailAliasVerified(userUID, email string, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEmailAliasVerified", userUID, email, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// MarkEmailAliasVerified indicates an expected call of MarkEmailAliasVerified.
+func (mr *MockUserRepositoryMockRecorder) MarkEmailAliasVerified(userUID, email, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEmailAliasVerified", reflect.TypeOf((*MockUserRepository)(nil).MarkEmailAliasVerified), userUID, email, ctx)
+}
+
+// MarkEmailVerified mocks base method.
+func (m *MockUserRepository) MarkEmailVerified(uid, email string, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEmailVerified", uid, email, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// MarkEmailVerified indicates an expected call of MarkEmailVerified.
+func (mr *MockUserRepositoryMockRecorder) MarkEmailVerified(uid, email, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEmailVerified", reflect.TypeOf((*MockUserRepository)(nil).MarkEmailVerified), uid, email, ctx)
+}
+
+// MergeUsers mocks base method.
+func (m *MockUserRepository) MergeUsers(targetUID string, req dtos.UserMergeRequest, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeUsers", targetUID, req, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// MergeUsers indicates an expected call of MergeUsers.
+func (mr *MockUserRepositoryMockRecorder) MergeUsers(targetUID, req, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeUsers", reflect.TypeOf((*MockUserRepository)(nil).MergeUsers), targetUID, req, ctx)
+}
+
 // RemoveUserFromGroups mocks base method.
 func (m *MockUserRepository) RemoveUserFromGroups(userUID string, groupUIDs []string, ctx *gin.Context) *models.ErrorResponse {
 	m.ctrl.T.Helper()
@@ -487,17 +1362,31 @@ func (mr *MockUserRepositoryMockRecorder) RemoveUserFromGroups(userUID, groupUID
 }
 
 // RemoveUserRole mocks base method.
-func (m *MockUserRepository) RemoveUserRole(userID string, ctx *gin.Context) *models.ErrorResponse {
+func (m *MockUserRepository) RemoveUserRole(uid, roleUID string, ctx *gin.Context) *models.ErrorResponse {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveUserRole", userID, ctx)
+	ret := m.ctrl.Call(m, "RemoveUserRole", uid, roleUID, ctx)
 	ret0, _ := ret[0].(*models.ErrorResponse)
 	return ret0
 }
 
 // RemoveUserRole indicates an expected call of RemoveUserRole.
-func (mr *MockUserRepositoryMockRecorder) RemoveUserRole(userID, ctx interface{}) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) RemoveUserRole(uid, roleUID, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserRole", reflect.TypeOf((*MockUserRepository)(nil).RemoveUserRole), userID, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserRole", reflect.TypeOf((*MockUserRepository)(nil).RemoveUserRole), uid, roleUID, ctx)
+}
+
+// RestoreUser mocks base method.
+func (m *MockUserRepository) RestoreUser(uid string, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUser", uid, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockUserRepositoryMockRecorder) RestoreUser(uid, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockUserRepository)(nil).RestoreUser), uid, ctx)
 }
 
 // SearchUsers mocks base method.
@@ -515,6 +1404,35 @@ func (mr *MockUserRepositoryMockRecorder) SearchUsers(searchFields, ctx interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockUserRepository)(nil).SearchUsers), searchFields, ctx)
 }
 
+// SetUserAvatar mocks base method.
+func (m *MockUserRepository) SetUserAvatar(uid string, avatar *dtos.UserAvatar, ctx *gin.Context) (*dtos.UserAvatar, *models.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserAvatar", uid, avatar, ctx)
+	ret0, _ := ret[0].(*dtos.UserAvatar)
+	ret1, _ := ret[1].(*models.ErrorResponse)
+	return ret0, ret1
+}
+
+// SetUserAvatar indicates an expected call of SetUserAvatar.
+func (mr *MockUserRepositoryMockRecorder) SetUserAvatar(uid, avatar, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserAvatar", reflect.TypeOf((*MockUserRepository)(nil).SetUserAvatar), uid, avatar, ctx)
+}
+
+// StreamUsers mocks base method.
+func (m *MockUserRepository) StreamUsers(fn func(*dtos.UserExportRow) error, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamUsers", fn, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// StreamUsers indicates an expected call of StreamUsers.
+func (mr *MockUserRepositoryMockRecorder) StreamUsers(fn, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamUsers", reflect.TypeOf((*MockUserRepository)(nil).StreamUsers), fn, ctx)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserRepository) UpdateUser(id string, user *dtos.UserUpdateRequest, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -529,3 +1447,17 @@ func (mr *MockUserRepositoryMockRecorder) UpdateUser(id, user, ctx interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserRepository)(nil).UpdateUser), id, user, ctx)
 }
+
+// UpdateUserStatus mocks base method.
+func (m *MockUserRepository) UpdateUserStatus(uid string, status models.UserStatus, ctx *gin.Context) *models.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserStatus", uid, status, ctx)
+	ret0, _ := ret[0].(*models.ErrorResponse)
+	return ret0
+}
+
+// UpdateUserStatus indicates an expected call of UpdateUserStatus.
+func (mr *MockUserRepositoryMockRecorder) UpdateUserStatus(uid, status, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserStatus", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserStatus), uid, status, ctx)
+}
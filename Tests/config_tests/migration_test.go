@@ -0,0 +1,130 @@
+package config_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/google-run-code/config"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// The baseline models mirror the schema of tenants created before any upgrade,
+// so the migrations are tested against the tables they actually meet.
+type baselineUser struct {
+	ID     int       `gorm:"primaryKey;autoIncrement"`
+	UID    uuid.UUID `gorm:"unique"`
+	Name   string
+	Email  string `gorm:"unique"`
+	Status int
+	Groups []baselineGroup `gorm:"many2many:Groups_Users_Maps;foreignKey:ID;joinForeignKey:Users_Id;References:ID;joinReferences:Groups_Id"`
+	RoleID *int            `gorm:"index;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	Role   *baselineRole   `gorm:"foreignKey:RoleID;references:ID"`
+}
+
+func (baselineUser) TableName() string { return "users" }
+
+type baselineGroup struct {
+	ID   int       `gorm:"primaryKey;autoIncrement"`
+	UID  uuid.UUID `gorm:"unique"`
+	Name string
+}
+
+func (baselineGroup) TableName() string { return "groups" }
+
+type baselineRole struct {
+	ID     int       `gorm:"primaryKey;autoIncrement"`
+	UID    uuid.UUID `gorm:"unique"`
+	Name   string
+	Rights json.RawMessage `gorm:"type:json"`
+}
+
+func (baselineRole) TableName() string { return "roles" }
+
+// MigrationTestSuite needs a Postgres server it may create databases on,
+// given by TEST_DB_HOST, TEST_DB_PORT, TEST_DB_USER and TEST_DB_PASS.
+type MigrationTestSuite struct {
+	suite.Suite
+	env    config.Env
+	admin  *gorm.DB
+	dbName string
+}
+
+func (suite *MigrationTestSuite) SetupTest() {
+	suite.env = config.Env{
+		DB_HOST:             os.Getenv("TEST_DB_HOST"),
+		DB_PORT:             os.Getenv("TEST_DB_PORT"),
+		DB_USER:             os.Getenv("TEST_DB_USER"),
+		DB_PASS:             os.Getenv("TEST_DB_PASS"),
+		DB_INIT_CONCURRENCY: 1,
+	}
+	if suite.env.DB_HOST == "" {
+		suite.T().Skip("TEST_DB_HOST is not set")
+	}
+
+	admin, err := gorm.Open(postgres.Open(config.NewPostgresConfig(suite.env).BuildDBURL("postgres")), &gorm.Config{})
+	suite.Require().NoError(err)
+	suite.admin = admin
+	suite.dbName = fmt.Sprintf("migration_test_%d", time.Now().UnixNano())
+	suite.Require().NoError(admin.Exec("CREATE DATABASE " + suite.dbName).Error)
+}
+
+func (suite *MigrationTestSuite) TearDownTest() {
+	if suite.admin == nil {
+		return
+	}
+	suite.admin.Exec("DROP DATABASE IF EXISTS " + suite.dbName + " WITH (FORCE)")
+	if sqlDB, err := suite.admin.DB(); err == nil {
+		sqlDB.Close()
+	}
+}
+
+func (suite *MigrationTestSuite) TestUpgradesBaselineTenant() {
+	baseline, err := gorm.Open(postgres.Open(config.NewPostgresConfig(suite.env).BuildDBURL(suite.dbName)), &gorm.Config{})
+	suite.Require().NoError(err)
+	suite.Require().NoError(baseline.AutoMigrate(&baselineUser{}, &baselineRole{}, &baselineGroup{}))
+
+	role := baselineRole{UID: uuid.New(), Name: "admin", Rights: json.RawMessage(`{}`)}
+	suite.Require().NoError(baseline.Create(&role).Error)
+	suite.Require().NoError(baseline.Create(&baselineUser{UID: uuid.New(), Name: "Ada", Email: " Ada@Example.com", Status: 1, RoleID: &role.ID}).Error)
+	if sqlDB, err := baseline.DB(); err == nil {
+		sqlDB.Close()
+	}
+
+	dbConfig := config.NewPostgresConfig(suite.env)
+	dbConfig.RegisterTenants([]string{suite.dbName})
+	go dbConfig.InitializeTenants([]string{suite.dbName}, models.TenantSchema()...)
+
+	var tenant *models.Tenant
+	suite.Eventually(func() bool {
+		tenant, _ = dbConfig.ResolveTenant(suite.dbName)
+		return tenant.State == models.TenantReady || tenant.State == models.TenantUnavailable
+	}, time.Minute, 100*time.Millisecond)
+	suite.Require().Equal(models.TenantReady, tenant.State, tenant.Error)
+
+	db, err := dbConfig.GetDB(suite.dbName)
+	suite.Require().NoError(err)
+	defer func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	}()
+
+	var user models.User
+	suite.Require().NoError(db.Where("email_normalized = ?", "ada@example.com").First(&user).Error)
+	suite.Equal(models.UserStatusActive, user.Status)
+
+	var assignments int64
+	suite.Require().NoError(db.Model(&models.UserRole{}).Where("user_id = ? AND role_id = ?", user.ID, role.ID).Count(&assignments).Error)
+	suite.Equal(int64(1), assignments)
+}
+
+func TestMigrationTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrationTestSuite))
+}
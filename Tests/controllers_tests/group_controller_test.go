@@ -33,6 +33,8 @@ func (suite *GroupControllerTestSuite) SetupTest() {
 	suite.router.POST("/groups", groupController.CreateGroup)
 	suite.router.PUT("/groups/:id", groupController.UpdateGroup)
 	suite.router.DELETE("/groups/:id", groupController.DeleteGroup)
+	suite.router.POST("/groups/:id/roles", groupController.AddGroupRole)
+	suite.router.PUT("/groups/:id/members", groupController.SetGroupMembers)
 }
 
 func (suite *GroupControllerTestSuite) TestGetAllGroups_Success() {
@@ -61,7 +63,7 @@ func (suite *GroupControllerTestSuite) TestGetGroupById_Success() {
 
 func (suite *GroupControllerTestSuite) TestGetGroupUsers_Success() {
 	expectedUsers := []dtos.UserResponse{{UID: "user-id", Name: "User1"}}
-	suite.useCaseMock.EXPECT().GetGroupUsers("group-id", gomock.Any()).Return(expectedUsers, nil)
+	suite.useCaseMock.EXPECT().GetGroupUsers("group-id", dtos.MembershipFilter{}, gomock.Any()).Return(expectedUsers, nil)
 
 	req, _ := http.NewRequest("GET", "/groups/group-id/users", nil)
 	w := httptest.NewRecorder()
@@ -71,8 +73,20 @@ func (suite *GroupControllerTestSuite) TestGetGroupUsers_Success() {
 	suite.Contains(w.Body.String(), "User1")
 }
 
+func (suite *GroupControllerTestSuite) TestGetGroupUsers_Transitive() {
+	expectedUsers := []dtos.UserResponse{{UID: "user-id", Name: "User1", InheritedFrom: "child-id"}}
+	suite.useCaseMock.EXPECT().GetGroupUsers("group-id", dtos.MembershipFilter{Transitive: true}, gomock.Any()).Return(expectedUsers, nil)
+
+	req, _ := http.NewRequest("GET", "/groups/group-id/users?transitive=true", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), "child-id")
+}
+
 func (suite *GroupControllerTestSuite) TestCreateGroup_Success() {
-	groupRequest := dtos.GroupCreateRequest{Name: "Admin"}
+	groupRequest := dtos.GroupCreateRequest{Name: "Admin", OwnerIds: []string{"owner-id"}}
 	groupResponse := &dtos.GroupResponse{UID: "group-id", Name: "Admin"}
 	suite.useCaseMock.EXPECT().CreateGroup(groupRequest, gomock.Any()).Return(groupResponse, nil)
 
@@ -86,6 +100,16 @@ func (suite *GroupControllerTestSuite) TestCreateGroup_Success() {
 	suite.Contains(w.Body.String(), "group-id")
 }
 
+func (suite *GroupControllerTestSuite) TestCreateGroup_WithoutOwners() {
+	groupJson, _ := json.Marshal(dtos.GroupCreateRequest{Name: "Admin"})
+	req, _ := http.NewRequest("POST", "/groups", bytes.NewBuffer(groupJson))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
 func (suite *GroupControllerTestSuite) TestUpdateGroup_Success() {
 	groupRequest := dtos.GroupUpdateRequest{Name: "Admin"}
 	groupResponse := &dtos.GroupResponse{UID: "group-id", Name: "Admin"}
@@ -111,6 +135,58 @@ func (suite *GroupControllerTestSuite) TestDeleteGroup_Success() {
 	suite.Equal(http.StatusOK, w.Code)
 }
 
+func (suite *GroupControllerTestSuite) TestAddGroupRole_Success() {
+	request := dtos.GroupRoleRequest{RoleId: "role-id"}
+	roles := []dtos.RoleResponse{{UID: "role-id", Name: "Editor"}}
+	suite.useCaseMock.EXPECT().AddGroupRole("group-id", request, gomock.Any()).Return(roles, nil)
+
+	body, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/groups/group-id/roles", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), "Editor")
+}
+
+func (suite *GroupControllerTestSuite) TestAddGroupRole_WithoutRole() {
+	req, _ := http.NewRequest("POST", "/groups/group-id/roles", bytes.NewBufferString("{}"))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *GroupControllerTestSuite) TestSetGroupMembers_Success() {
+	request := dtos.GroupMembersRequest{UserIds: []string{"user-id"}}
+	diff := &dtos.GroupMembersDiff{
+		Added:     []dtos.UserResponse{{UID: "user-id"}},
+		Removed:   []dtos.UserResponse{{UID: "former-id"}},
+		Unchanged: []dtos.UserResponse{},
+	}
+	suite.useCaseMock.EXPECT().SetGroupMembers("group-id", request, gomock.Any()).Return(diff, nil)
+
+	body, _ := json.Marshal(request)
+	req, _ := http.NewRequest("PUT", "/groups/group-id/members", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), "former-id")
+}
+
+func (suite *GroupControllerTestSuite) TestSetGroupMembers_WithoutUsers() {
+	req, _ := http.NewRequest("PUT", "/groups/group-id/members", bytes.NewBufferString("{}"))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
 func TestGroupControllerTestSuite(t *testing.T) {
 	suite.Run(t, new(GroupControllerTestSuite))
 }
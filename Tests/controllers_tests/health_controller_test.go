@@ -0,0 +1,81 @@
+package controllers_tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	controllers "github.com/google-run-code/Delivery/Controllers"
+	models "github.com/google-run-code/Domain/Models"
+	mocks "github.com/google-run-code/Tests/Mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type HealthControllerTestSuite struct {
+	suite.Suite
+	router       *gin.Engine
+	registryMock *mocks.MockTenantRegistry
+}
+
+func (suite *HealthControllerTestSuite) SetupTest() {
+	mockCtrl := gomock.NewController(suite.T())
+	suite.registryMock = mocks.NewMockTenantRegistry(mockCtrl)
+	suite.router = gin.Default()
+
+	healthController := controllers.NewHealthController(suite.registryMock)
+	suite.router.GET("/startup", healthController.Startup)
+}
+
+func (suite *HealthControllerTestSuite) TestStartup_StillInitializing() {
+	suite.registryMock.EXPECT().StartupProgress().Return(models.StartupProgress{
+		Total:   2,
+		Pending: 1,
+		Ready:   1,
+	})
+
+	req, _ := http.NewRequest("GET", "/startup", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusServiceUnavailable, w.Code)
+}
+
+func (suite *HealthControllerTestSuite) TestStartup_Done() {
+	suite.registryMock.EXPECT().StartupProgress().Return(models.StartupProgress{
+		Total:       2,
+		Ready:       1,
+		Unavailable: 1,
+		Done:        true,
+		Tenants: []models.Tenant{
+			{Name: "tenant-a", State: models.TenantReady},
+			{Name: "tenant-b", State: models.TenantUnavailable, Error: "connection refused"},
+		},
+	})
+
+	req, _ := http.NewRequest("GET", "/startup", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), "connection refused")
+}
+
+func (suite *HealthControllerTestSuite) TestStartup_NoTenantReady() {
+	suite.registryMock.EXPECT().StartupProgress().Return(models.StartupProgress{
+		Total:       1,
+		Unavailable: 1,
+		Done:        true,
+	})
+
+	req, _ := http.NewRequest("GET", "/startup", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusServiceUnavailable, w.Code)
+}
+
+func TestHealthControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(HealthControllerTestSuite))
+}
@@ -106,14 +106,14 @@ func (suite *RoleControllerTestSuite) TestDeleteRole_Success() {
 }
 
 func (suite *RoleControllerTestSuite) TestGetRoleUsers_Success() {
-	users := []*dtos.UserResponse{
-		{UID: "user1-uid", Name: "User 1", Email: "user1@example.com"},
-		{UID: "user2-uid", Name: "User 2", Email: "user2@example.com"},
+	users := []*dtos.RoleUserResponse{
+		{UserResponse: dtos.UserResponse{UID: "user1-uid", Name: "User 1", Email: "user1@example.com"}, Primary: true},
+		{UserResponse: dtos.UserResponse{UID: "user2-uid", Name: "User 2", Email: "user2@example.com"}},
 	}
 
 	suite.roleUsecase.
 		EXPECT().
-		GetRoleUsers("some-id", gomock.Any()).
+		GetRoleUsers("some-id", dtos.MembershipFilter{}, gomock.Any()).
 		Return(users, nil).
 		Times(1)
 
@@ -123,7 +123,7 @@ func (suite *RoleControllerTestSuite) TestGetRoleUsers_Success() {
 
 	suite.Equal(http.StatusOK, response.StatusCode)
 
-	var responseBody []*dtos.UserResponse
+	var responseBody []*dtos.RoleUserResponse
 	err = json.NewDecoder(response.Body).Decode(&responseBody)
 	suite.NoError(err)
 	suite.Equal(users, responseBody)
@@ -2,6 +2,7 @@ package controllers_tests
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -12,6 +13,7 @@ import (
 	controllers "github.com/google-run-code/Delivery/Controllers"
 	dtos "github.com/google-run-code/Domain/Dtos"
 	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
 	mocks "github.com/google-run-code/Tests/Mocks"
 	"github.com/stretchr/testify/suite"
 )
@@ -35,8 +37,10 @@ func (suite *UserControllerTestSuite) SetupSuite() {
 	router.PUT("/users/:id", suite.controller.UpdateUser)
 	router.DELETE("/users/:id", suite.controller.DeleteUser)
 	router.GET("/users/:id", suite.controller.GetUserById)
+	router.GET("/users/:id/groups", suite.controller.GetUsersGroup)
 	router.GET("/users", suite.controller.GetUsers)
 	router.POST("/users/:id/groups", suite.controller.AddUserToGroup)
+	router.GET("/users/export.csv", suite.controller.ExportUsers)
 
 	suite.server = httptest.NewServer(router)
 }
@@ -50,7 +54,7 @@ func (suite *UserControllerTestSuite) TestCreateUser_Success() {
 	input := dtos.UserCreateRequest{
 		Name:   "Test User",
 		Email:  "test@example.com",
-		Status: 1,
+		Status: "active",
 	}
 
 	expectedUser := &dtos.UserResponseSingle{
@@ -94,7 +98,7 @@ func (suite *UserControllerTestSuite) TestUpdateUser_Success() {
 		UID:    "some-uid",
 		Name:   *input.Name,
 		Email:  *input.Email,
-		Status: 1,
+		Status: "active",
 	}
 
 	suite.usecase.
@@ -140,6 +144,58 @@ func (suite *UserControllerTestSuite) TestDeleteUser_Success() {
 	suite.Equal(http.StatusOK, response.StatusCode)
 }
 
+func (suite *UserControllerTestSuite) TestGetUsersGroup_RedirectsMergedUser() {
+	suite.usecase.EXPECT().
+		GetUsersGroup("merged-uid", gomock.Any(), gomock.Any()).
+		Return(nil, models.NotFound("User not found"))
+	suite.usecase.EXPECT().
+		GetUserRedirect("merged-uid", gomock.Any()).
+		Return("survivor-uid", nil)
+
+	client := &http.Client{CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	response, err := client.Get(suite.server.URL + "/users/merged-uid/groups?effective=true")
+	suite.NoError(err)
+	defer response.Body.Close()
+
+	suite.Equal(http.StatusPermanentRedirect, response.StatusCode)
+	suite.Equal("/users/survivor-uid/groups?effective=true", response.Header.Get("Location"))
+}
+
+func (suite *UserControllerTestSuite) TestGetUserById_NotFound() {
+	suite.usecase.EXPECT().
+		GetUserById("missing-uid", gomock.Any()).
+		Return(nil, models.NotFound("User not found"))
+	suite.usecase.EXPECT().
+		GetUserRedirect("missing-uid", gomock.Any()).
+		Return("", models.NotFound("User not found"))
+
+	response, err := http.Get(suite.server.URL + "/users/missing-uid")
+	suite.NoError(err)
+	defer response.Body.Close()
+
+	suite.Equal(http.StatusNotFound, response.StatusCode)
+}
+
+func (suite *UserControllerTestSuite) TestExportUsers_EscapesFormulas() {
+	suite.usecase.EXPECT().
+		ExportUsers(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(fn func(*dtos.UserExportRow) error, ctx *gin.Context) *models.ErrorResponse {
+			fn(&dtos.UserExportRow{UID: "uid-1", Name: "=HYPERLINK(\"http://evil\")", Email: "a@example.com", Status: "active", Role: "-admin", Groups: []string{"@ops"}})
+			return nil
+		})
+
+	response, err := http.Get(suite.server.URL + "/users/export.csv")
+	suite.NoError(err)
+	defer response.Body.Close()
+
+	records, err := csv.NewReader(response.Body).ReadAll()
+	suite.NoError(err)
+	suite.Len(records, 2)
+	suite.Equal([]string{"uid-1", "'=HYPERLINK(\"http://evil\")", "a@example.com", "active", "'-admin", "'@ops"}, records[1])
+}
+
 func TestUserControllerTestSuite(t *testing.T) {
 	suite.Run(t, new(UserControllerTestSuite))
 }
@@ -0,0 +1,97 @@
+package infrastructure_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+	infrastructure "github.com/google-run-code/Infrastructure"
+	mocks "github.com/google-run-code/Tests/Mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type ActivityTrackerTestSuite struct {
+	suite.Suite
+	ctrl     *gomock.Controller
+	repoMock *mocks.MockSignInRepository
+}
+
+func (suite *ActivityTrackerTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repoMock = mocks.NewMockSignInRepository(suite.ctrl)
+}
+
+func (suite *ActivityTrackerTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func tenantContext(name string) *gin.Context {
+	ctx := &gin.Context{}
+	ctx.Set(models.TenantContextKey, &models.Tenant{Name: name})
+	return ctx
+}
+
+func (suite *ActivityTrackerTestSuite) TestBatchesActivityPerTenant() {
+	recorded := make(chan []dtos.UserActivity, 2)
+	suite.repoMock.EXPECT().RecordActivity(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(activity []dtos.UserActivity, _ *gin.Context) *models.ErrorResponse {
+			recorded <- activity
+			return nil
+		}).Times(1)
+
+	tracker := infrastructure.NewActivityTracker(suite.repoMock, 50*time.Millisecond)
+	ctx := tenantContext("tenant_a")
+	tracker.Touch(ctx, "user-7", false)
+	tracker.Touch(ctx, "user-7", false)
+	tracker.Touch(ctx, "user-7", true)
+
+	select {
+	case activity := <-recorded:
+		suite.Require().Len(activity, 1)
+		suite.Equal("user-7", activity[0].UserUID)
+		suite.NotNil(activity[0].LoginAt)
+	case <-time.After(time.Second):
+		suite.Fail("activity was not recorded")
+	}
+}
+
+func (suite *ActivityTrackerTestSuite) TestSeparatesTenants() {
+	tenants := make(chan string, 2)
+	suite.repoMock.EXPECT().RecordActivity(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(activity []dtos.UserActivity, ctx *gin.Context) *models.ErrorResponse {
+			suite.Len(activity, 1)
+			value, _ := ctx.Get(models.TenantContextKey)
+			tenants <- value.(*models.Tenant).Name
+			return nil
+		}).Times(2)
+
+	tracker := infrastructure.NewActivityTracker(suite.repoMock, 50*time.Millisecond)
+	tracker.Touch(tenantContext("tenant_a"), "user-7", false)
+	tracker.Touch(tenantContext("tenant_b"), "user-7", false)
+
+	var names []string
+	for len(names) < 2 {
+		select {
+		case name := <-tenants:
+			names = append(names, name)
+		case <-time.After(time.Second):
+			suite.FailNow("activity was not recorded")
+		}
+	}
+	suite.ElementsMatch([]string{"tenant_a", "tenant_b"}, names)
+}
+
+func (suite *ActivityTrackerTestSuite) TestIgnoresContextWithoutTenant() {
+	tracker := infrastructure.NewActivityTracker(suite.repoMock, 20*time.Millisecond)
+	tracker.Touch(&gin.Context{}, "user-7", true)
+
+	// RecordActivity has no expectation, so a write would fail the test
+	time.Sleep(60 * time.Millisecond)
+}
+
+func TestActivityTrackerTestSuite(t *testing.T) {
+	suite.Run(t, new(ActivityTrackerTestSuite))
+}
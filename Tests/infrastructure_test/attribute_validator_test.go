@@ -0,0 +1,52 @@
+package infrastructure_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	infrastructure "github.com/google-run-code/Infrastructure"
+	"github.com/stretchr/testify/suite"
+)
+
+type AttributeValidatorTestSuite struct {
+	suite.Suite
+	validator interfaces.AttributeValidator
+	schema    json.RawMessage
+}
+
+func (suite *AttributeValidatorTestSuite) SetupTest() {
+	suite.validator = infrastructure.NewAttributeValidator()
+	suite.schema = json.RawMessage(`{
+		"type": "object",
+		"properties": {
+			"employee_id": {"type": "integer"},
+			"department": {"type": "string", "enum": ["Sales", "Engineering"]}
+		},
+		"required": ["employee_id"]
+	}`)
+}
+
+func (suite *AttributeValidatorTestSuite) TestValidate_Valid() {
+	err := suite.validator.Validate(suite.schema, json.RawMessage(`{"employee_id": 42, "department": "Sales"}`))
+	suite.NoError(err)
+}
+
+func (suite *AttributeValidatorTestSuite) TestValidate_Invalid() {
+	err := suite.validator.Validate(suite.schema, json.RawMessage(`{"department": "Marketing"}`))
+	suite.Error(err)
+}
+
+func (suite *AttributeValidatorTestSuite) TestCheckSchema_Invalid() {
+	err := suite.validator.CheckSchema(json.RawMessage(`{"type": "not-a-type"}`))
+	suite.Error(err)
+}
+
+func (suite *AttributeValidatorTestSuite) TestCheckSchema_RejectsExternalReferences() {
+	err := suite.validator.CheckSchema(json.RawMessage(`{"$ref": "file:///etc/passwd"}`))
+	suite.Error(err)
+}
+
+func TestAttributeValidatorTestSuite(t *testing.T) {
+	suite.Run(t, new(AttributeValidatorTestSuite))
+}
@@ -0,0 +1,51 @@
+package infrastructure_test
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"strings"
+	"testing"
+
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	infrastructure "github.com/google-run-code/Infrastructure"
+	"github.com/google-run-code/config"
+	"github.com/stretchr/testify/suite"
+)
+
+type BlobStoreTestSuite struct {
+	suite.Suite
+	blobStore interfaces.BlobStore
+}
+
+func (suite *BlobStoreTestSuite) SetupTest() {
+	suite.blobStore = infrastructure.NewBlobStore(config.Env{BLOB_DRIVER: "local", BLOB_DIR: suite.T().TempDir()})
+}
+
+func (suite *BlobStoreTestSuite) TestPutOpenDelete() {
+	key := "tenant_a/avatars/user/1"
+	suite.NoError(suite.blobStore.Put(key, strings.NewReader("image")))
+
+	content, err := suite.blobStore.Open(key)
+	suite.Require().NoError(err)
+	data, err := io.ReadAll(content)
+	content.Close()
+	suite.NoError(err)
+	suite.Equal("image", string(data))
+
+	suite.NoError(suite.blobStore.Delete(key))
+	suite.NoError(suite.blobStore.Delete(key))
+
+	_, err = suite.blobStore.Open(key)
+	suite.True(errors.Is(err, fs.ErrNotExist))
+}
+
+func (suite *BlobStoreTestSuite) TestRejectsKeysOutsideTheStore() {
+	for _, key := range []string{"../escape", "a/../../escape", "/absolute", ""} {
+		suite.Error(suite.blobStore.Put(key, strings.NewReader("x")), key)
+	}
+}
+
+func TestBlobStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(BlobStoreTestSuite))
+}
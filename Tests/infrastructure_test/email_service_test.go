@@ -1,22 +1,36 @@
 package infrastructure_test
 
 import (
+	"net/url"
+	"regexp"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
 	infrastructure "github.com/google-run-code/Infrastructure"
+	mocks "github.com/google-run-code/Tests/Mocks"
 	"github.com/google-run-code/config"
 	"github.com/stretchr/testify/suite"
 )
 
 type EmailServiceTestSuite struct {
 	suite.Suite
-	emailService interfaces.EmailService
+	env            config.Env
+	mailSenderMock *mocks.MockMailSender
+	emailService   interfaces.EmailService
+	ctrl           *gomock.Controller
 }
 
 func (suite *EmailServiceTestSuite) SetupTest() {
-	env := config.Env{}
-	suite.emailService = infrastructure.NewEmailService(env)
+	suite.env = config.Env{JWT_SECRET: "secret", PUBLIC_BASE_URL: "https://users.example.com/"}
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mailSenderMock = mocks.NewMockMailSender(suite.ctrl)
+	suite.emailService = infrastructure.NewEmailService(suite.env, suite.mailSenderMock)
+}
+
+func (suite *EmailServiceTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
 }
 
 func (suite *EmailServiceTestSuite) TestIsValidEmail_ValidEmail() {
@@ -31,6 +45,42 @@ func (suite *EmailServiceTestSuite) TestIsValidEmail_InvalidEmail() {
 	suite.False(isValid, "Expected email to be invalid")
 }
 
+func (suite *EmailServiceTestSuite) TestSendVerificationEmail_LinkCarriesValidToken() {
+	var sent models.MailMessage
+	suite.mailSenderMock.EXPECT().
+		Send(gomock.Any()).
+		DoAndReturn(func(message models.MailMessage) error {
+			sent = message
+			return nil
+		})
+
+	err := suite.emailService.SendVerificationEmail("tenant_a", "user-uid", "Test", "test@example.com")
+	suite.Nil(err)
+	suite.Equal("test@example.com", sent.To)
+
+	link := regexp.MustCompile(`https://users\.example\.com/verify-email\?\S+`).FindString(sent.Body)
+	suite.NotEmpty(link)
+
+	parsed, err := url.Parse(link)
+	suite.Nil(err)
+	suite.Equal("tenant_a", parsed.Query().Get("tenant"))
+
+	claims, err := suite.emailService.ParseVerificationToken(parsed.Query().Get("token"))
+	suite.Nil(err)
+	suite.Equal("tenant_a", claims.Tenant)
+	suite.Equal("user-uid", claims.UserUID)
+	suite.Equal("test@example.com", claims.Email)
+}
+
+func (suite *EmailServiceTestSuite) TestParseVerificationToken_RejectsAccessToken() {
+	accessToken, err := infrastructure.NewJwtService(&suite.env).GenerateToken("tenant_a")
+	suite.Nil(err)
+
+	claims, err := suite.emailService.ParseVerificationToken(accessToken)
+	suite.NotNil(err)
+	suite.Nil(claims)
+}
+
 func TestEmailServiceTestSuite(t *testing.T) {
 	suite.Run(t, new(EmailServiceTestSuite))
 }
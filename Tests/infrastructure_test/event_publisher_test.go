@@ -0,0 +1,59 @@
+package infrastructure_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	models "github.com/google-run-code/Domain/Models"
+	infrastructure "github.com/google-run-code/Infrastructure"
+	"github.com/google-run-code/config"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type EventPublisherTestSuite struct {
+	suite.Suite
+}
+
+func (suite *EventPublisherTestSuite) TestWebhookPostsEvent() {
+	var received models.Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodPost, r.Method)
+		suite.Equal("application/json", r.Header.Get("Content-Type"))
+		suite.NoError(json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	publisher := infrastructure.NewEventPublisher(config.Env{EVENT_DRIVER: "webhook", EVENT_WEBHOOK_URL: server.URL})
+	event := models.Event{
+		ID:         uuid.New(),
+		Type:       models.EventRoleAssignmentExpired,
+		Tenant:     "tenant_a",
+		OccurredAt: time.Now().UTC().Truncate(time.Second),
+		Data:       map[string]any{"user_id": "user-1"},
+	}
+
+	suite.NoError(publisher.Publish(event))
+	suite.Equal(event.ID, received.ID)
+	suite.Equal(event.Type, received.Type)
+	suite.Equal("user-1", received.Data["user_id"])
+}
+
+func (suite *EventPublisherTestSuite) TestWebhookRejectsErrorStatus() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	publisher := infrastructure.NewEventPublisher(config.Env{EVENT_DRIVER: "webhook", EVENT_WEBHOOK_URL: server.URL})
+
+	suite.Error(publisher.Publish(models.Event{Type: models.EventRoleAssignmentExpired}))
+}
+
+func TestEventPublisherTestSuite(t *testing.T) {
+	suite.Run(t, new(EventPublisherTestSuite))
+}
@@ -36,6 +36,17 @@ func (suite *JwtServiceTestSuite) TestValidateToken_Success() {
 	assert.Equal(suite.T(), "test-database", claims.Database)
 }
 
+func (suite *JwtServiceTestSuite) TestGenerateUserToken_CarriesUser() {
+	tokenString, err := suite.service.GenerateUserToken("test-database", "user-uid")
+	assert.NoError(suite.T(), err)
+
+	claims, err := suite.service.ValidateToken(tokenString)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "test-database", claims.Database)
+	assert.Equal(suite.T(), "user-uid", claims.UserUID)
+	assert.NotZero(suite.T(), claims.IssuedAt)
+}
+
 func (suite *JwtServiceTestSuite) TestValidateToken_Expired() {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &models.JWTCustome{
 		Expires:  time.Now().Add(-time.Hour).Unix(),
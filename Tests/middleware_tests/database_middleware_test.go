@@ -0,0 +1,131 @@
+package middleware_tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	middleware "github.com/google-run-code/Delivery/Middlewares"
+	models "github.com/google-run-code/Domain/Models"
+	mocks "github.com/google-run-code/Tests/Mocks"
+	"github.com/google-run-code/config"
+	"github.com/stretchr/testify/suite"
+)
+
+type DatabaseMiddlewareTestSuite struct {
+	suite.Suite
+	router       *gin.Engine
+	jwtMock      *mocks.MockJwtService
+	registryMock *mocks.MockTenantRegistry
+	trackerMock  *mocks.MockActivityTracker
+}
+
+func (suite *DatabaseMiddlewareTestSuite) SetupTest() {
+	mockCtrl := gomock.NewController(suite.T())
+	suite.jwtMock = mocks.NewMockJwtService(mockCtrl)
+	suite.registryMock = mocks.NewMockTenantRegistry(mockCtrl)
+	suite.trackerMock = mocks.NewMockActivityTracker(mockCtrl)
+	suite.router = gin.Default()
+
+	suite.router.Use(middleware.DatabaseMiddleware(&config.Env{}, suite.jwtMock, suite.registryMock))
+	suite.router.GET("/tenant", func(c *gin.Context) {
+		value, _ := c.Get(models.TenantContextKey)
+		c.JSON(http.StatusOK, value)
+	})
+}
+
+func (suite *DatabaseMiddlewareTestSuite) expectToken(database string) {
+	suite.jwtMock.EXPECT().ValidateAuthHeader("Bearer token").Return([]string{"Bearer", "token"}, nil)
+	suite.jwtMock.EXPECT().ValidateToken("token").Return(&models.JWTCustome{Database: database}, nil)
+}
+
+func (suite *DatabaseMiddlewareTestSuite) serve() *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("GET", "/tenant", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *DatabaseMiddlewareTestSuite) TestReadyTenant_SetsTenantInContext() {
+	suite.expectToken("tenant-a")
+	suite.registryMock.EXPECT().ResolveTenant("tenant-a").
+		Return(&models.Tenant{Name: "tenant-a", State: models.TenantReady}, true)
+
+	w := suite.serve()
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), "tenant-a")
+}
+
+func (suite *DatabaseMiddlewareTestSuite) TestUnknownTenant() {
+	suite.expectToken("missing")
+	suite.registryMock.EXPECT().ResolveTenant("missing").Return(nil, false)
+
+	w := suite.serve()
+
+	suite.Equal(http.StatusNotFound, w.Code)
+	suite.Contains(w.Body.String(), models.ErrCodeTenantNotFound)
+}
+
+func (suite *DatabaseMiddlewareTestSuite) TestDisabledTenant() {
+	suite.expectToken("tenant-b")
+	suite.registryMock.EXPECT().ResolveTenant("tenant-b").
+		Return(&models.Tenant{Name: "tenant-b", State: models.TenantDisabled}, true)
+
+	w := suite.serve()
+
+	suite.Equal(http.StatusForbidden, w.Code)
+	suite.Contains(w.Body.String(), models.ErrCodeTenantDisabled)
+}
+
+func (suite *DatabaseMiddlewareTestSuite) TestInitializingTenant() {
+	suite.expectToken("tenant-c")
+	suite.registryMock.EXPECT().ResolveTenant("tenant-c").
+		Return(&models.Tenant{Name: "tenant-c", State: models.TenantInitializing}, true)
+
+	w := suite.serve()
+
+	suite.Equal(http.StatusForbidden, w.Code)
+	suite.Contains(w.Body.String(), models.ErrCodeTenantInitializing)
+}
+
+func (suite *DatabaseMiddlewareTestSuite) TestUserToken_Rejected() {
+	suite.jwtMock.EXPECT().ValidateAuthHeader("Bearer token").Return([]string{"Bearer", "token"}, nil)
+	suite.jwtMock.EXPECT().ValidateToken("token").
+		Return(&models.JWTCustome{Database: "tenant-a", UserUID: "user-uid"}, nil)
+
+	w := suite.serve()
+
+	suite.Equal(http.StatusForbidden, w.Code)
+}
+
+func (suite *DatabaseMiddlewareTestSuite) TestDelegated_UserTokenSetsActor() {
+	router := gin.Default()
+	router.Use(middleware.DelegatedMiddleware(&config.Env{}, suite.jwtMock, suite.registryMock, suite.trackerMock))
+	router.GET("/actor", func(c *gin.Context) {
+		value, _ := c.Get(models.ActorContextKey)
+		c.JSON(http.StatusOK, value)
+	})
+
+	suite.jwtMock.EXPECT().ValidateAuthHeader("Bearer token").Return([]string{"Bearer", "token"}, nil)
+	suite.jwtMock.EXPECT().ValidateToken("token").
+		Return(&models.JWTCustome{Database: "tenant-a", UserUID: "user-uid"}, nil)
+	suite.registryMock.EXPECT().ResolveTenant("tenant-a").
+		Return(&models.Tenant{Name: "tenant-a", State: models.TenantReady}, true)
+	suite.trackerMock.EXPECT().Touch(gomock.Any(), "user-uid", false)
+
+	req, _ := http.NewRequest("GET", "/actor", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), "user-uid")
+}
+
+func TestDatabaseMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(DatabaseMiddlewareTestSuite))
+}
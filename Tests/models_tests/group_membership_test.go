@@ -0,0 +1,29 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/stretchr/testify/suite"
+)
+
+type GroupMembershipTestSuite struct {
+	suite.Suite
+}
+
+func (suite *GroupMembershipTestSuite) TestActiveAt() {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	earlier := now.Add(-time.Hour)
+
+	suite.True((&models.GroupMembership{}).ActiveAt(now))
+	suite.True((&models.GroupMembership{ValidFrom: &earlier, ValidUntil: &later}).ActiveAt(now))
+	suite.True((&models.GroupMembership{ValidFrom: &now}).ActiveAt(now))
+	suite.False((&models.GroupMembership{ValidFrom: &later}).ActiveAt(now))
+	suite.False((&models.GroupMembership{ValidUntil: &now}).ActiveAt(now))
+}
+
+func TestGroupMembershipTestSuite(t *testing.T) {
+	suite.Run(t, new(GroupMembershipTestSuite))
+}
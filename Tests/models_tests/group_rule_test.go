@@ -0,0 +1,53 @@
+package models_test
+
+import (
+	"testing"
+
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type GroupRuleTestSuite struct {
+	suite.Suite
+}
+
+func (suite *GroupRuleTestSuite) TestValidate_Valid() {
+	rule := models.GroupRule{All: []models.GroupRule{
+		{Field: models.GroupRuleStatus, Op: models.GroupRuleIn, Values: []string{"active", "invited"}},
+		{Not: &models.GroupRule{Field: models.GroupRuleRole, Op: models.GroupRuleEq, Value: uuid.New().String()}},
+		{Field: "attributes.department", Op: models.GroupRuleNe, Value: "sales"},
+	}}
+
+	suite.NoError(rule.Validate())
+}
+
+func (suite *GroupRuleTestSuite) TestValidate_Invalid() {
+	rules := []models.GroupRule{
+		{},
+		{Field: models.GroupRuleStatus, Op: models.GroupRuleEq, Value: "unknown"},
+		{Field: models.GroupRuleManager, Op: models.GroupRuleEq, Value: "not-a-uuid"},
+		{Field: models.GroupRuleEmailDomain, Op: "like", Value: "example.com"},
+		{Field: models.GroupRuleEmailDomain, Op: models.GroupRuleIn},
+		{Field: "attributes.", Op: models.GroupRuleEq, Value: "x"},
+		{Field: "name", Op: models.GroupRuleEq, Value: "x"},
+		{Field: models.GroupRuleEmailDomain, Op: models.GroupRuleEq, Value: "example.com", Any: []models.GroupRule{{}}},
+	}
+
+	for _, rule := range rules {
+		suite.Error(rule.Validate(), "%+v", rule)
+	}
+}
+
+func (suite *GroupRuleTestSuite) TestValidate_TooDeep() {
+	rule := models.GroupRule{Field: models.GroupRuleEmailDomain, Op: models.GroupRuleEq, Value: "example.com"}
+	for i := 0; i < 10; i++ {
+		rule = models.GroupRule{Not: &rule}
+	}
+
+	suite.Error(rule.Validate())
+}
+
+func TestGroupRuleTestSuite(t *testing.T) {
+	suite.Run(t, new(GroupRuleTestSuite))
+}
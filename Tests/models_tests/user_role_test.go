@@ -0,0 +1,53 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/stretchr/testify/suite"
+)
+
+type MergeRightsTestSuite struct {
+	suite.Suite
+}
+
+func (suite *MergeRightsTestSuite) TestUnionOfBooleanRights() {
+	merged := models.MergeRights(
+		json.RawMessage(`{"read": true, "write": false}`),
+		json.RawMessage(`{"write": true, "admin": false}`),
+	)
+
+	suite.JSONEq(`{"read": true, "write": true, "admin": false}`, string(merged))
+}
+
+func (suite *MergeRightsTestSuite) TestNestedObjectsAndLists() {
+	merged := models.MergeRights(
+		json.RawMessage(`{"users": {"read": true}, "scopes": ["a", "b"], "quota": 10}`),
+		json.RawMessage(`{"users": {"write": true}, "scopes": ["b", "c"], "quota": 50}`),
+	)
+
+	suite.JSONEq(`{"users": {"read": true, "write": true}, "scopes": ["a", "b", "c"], "quota": 10}`, string(merged))
+}
+
+func (suite *MergeRightsTestSuite) TestSkipsEmptyRights() {
+	suite.Nil(models.MergeRights())
+	suite.JSONEq(`{"read": true}`, string(models.MergeRights(nil, json.RawMessage(`{"read": true}`))))
+}
+
+func (suite *MergeRightsTestSuite) TestAssignmentActiveAt() {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	earlier := now.Add(-time.Hour)
+
+	suite.True((&models.UserRole{}).ActiveAt(now))
+	suite.True((&models.UserRole{StartsAt: &earlier, ExpiresAt: &later}).ActiveAt(now))
+	suite.False((&models.UserRole{StartsAt: &later}).ActiveAt(now))
+	suite.False((&models.UserRole{ExpiresAt: &earlier}).ActiveAt(now))
+	suite.False((&models.UserRole{ExpiresAt: &now}).ActiveAt(now))
+}
+
+func TestMergeRightsTestSuite(t *testing.T) {
+	suite.Run(t, new(MergeRightsTestSuite))
+}
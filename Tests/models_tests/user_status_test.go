@@ -0,0 +1,33 @@
+package models_test
+
+import (
+	"net/http"
+	"testing"
+
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/stretchr/testify/suite"
+)
+
+type UserStatusTestSuite struct {
+	suite.Suite
+}
+
+func (suite *UserStatusTestSuite) TestCheckTransitionAllowed() {
+	suite.Nil(models.UserStatusActive.CheckTransition(models.UserStatusSuspended))
+	suite.Nil(models.UserStatusSuspended.CheckTransition(models.UserStatusDeprovisioned))
+}
+
+func (suite *UserStatusTestSuite) TestCheckTransitionLeavingDeprovisioned() {
+	err := models.UserStatusDeprovisioned.CheckTransition(models.UserStatusActive)
+	suite.Equal(http.StatusConflict, err.Code)
+	suite.Equal("User status cannot change from deprovisioned to active", err.Message)
+}
+
+func (suite *UserStatusTestSuite) TestCheckTransitionUnknownStatus() {
+	err := models.UserStatusActive.CheckTransition(models.UserStatus("archived"))
+	suite.Equal(http.StatusBadRequest, err.Code)
+}
+
+func TestUserStatusTestSuite(t *testing.T) {
+	suite.Run(t, new(UserStatusTestSuite))
+}
@@ -0,0 +1,105 @@
+package usecases_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+	mocks "github.com/google-run-code/Tests/Mocks"
+	usecases "github.com/google-run-code/Usecases"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+type AvatarUsecaseTestSuite struct {
+	suite.Suite
+	userRepoMock  *mocks.MockUserRepository
+	blobStoreMock *mocks.MockBlobStore
+	avatarUsecase interfaces.AvatarUseCase
+	ctrl          *gomock.Controller
+}
+
+func (suite *AvatarUsecaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.userRepoMock = mocks.NewMockUserRepository(suite.ctrl)
+	suite.blobStoreMock = mocks.NewMockBlobStore(suite.ctrl)
+	suite.avatarUsecase = usecases.NewAvatarUseCase(suite.userRepoMock, suite.blobStoreMock, 64)
+}
+
+func (suite *AvatarUsecaseTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *AvatarUsecaseTestSuite) TestUploadAvatar_ReplacesPrevious() {
+	ctx := &gin.Context{}
+	ctx.Set(models.TenantContextKey, &models.Tenant{Name: "tenant_a"})
+	userUID := uuid.New().String()
+	user := &dtos.UserResponseSingle{UID: userUID, AvatarURL: "/users/" + userUID + "/avatar"}
+
+	suite.userRepoMock.EXPECT().GetUserAvatar(userUID, ctx).Return(&dtos.UserAvatar{Key: "old", ContentType: "image/png"}, nil)
+	var storedKey string
+	suite.blobStoreMock.EXPECT().Put(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, content io.Reader) error {
+		storedKey = key
+		data, _ := io.ReadAll(content)
+		suite.Equal(pngHeader, data)
+		return nil
+	})
+	suite.userRepoMock.EXPECT().SetUserAvatar(userUID, gomock.Any(), ctx).DoAndReturn(
+		func(uid string, avatar *dtos.UserAvatar, ctx *gin.Context) (*dtos.UserAvatar, *models.ErrorResponse) {
+			suite.Equal(storedKey, avatar.Key)
+			suite.Equal("image/png", avatar.ContentType)
+			return &dtos.UserAvatar{Key: "old", ContentType: "image/png"}, nil
+		})
+	suite.blobStoreMock.EXPECT().Delete("old").Return(nil)
+	suite.userRepoMock.EXPECT().GetUserById(userUID, ctx).Return(user, nil)
+
+	result, err := suite.avatarUsecase.UploadAvatar(userUID, bytes.NewReader(pngHeader), ctx)
+	suite.Nil(err)
+	suite.Equal(user, result)
+	suite.True(strings.HasPrefix(storedKey, "tenant_a/avatars/"+userUID+"/"))
+}
+
+func (suite *AvatarUsecaseTestSuite) TestUploadAvatar_RejectsNonImages() {
+	ctx := &gin.Context{}
+	userUID := uuid.New().String()
+
+	suite.userRepoMock.EXPECT().GetUserAvatar(userUID, ctx).Return(&dtos.UserAvatar{}, nil)
+
+	result, err := suite.avatarUsecase.UploadAvatar(userUID, strings.NewReader("<svg></svg>"), ctx)
+	suite.Nil(result)
+	suite.Equal(http.StatusUnsupportedMediaType, err.Code)
+}
+
+func (suite *AvatarUsecaseTestSuite) TestUploadAvatar_TooLarge() {
+	ctx := &gin.Context{}
+	userUID := uuid.New().String()
+
+	suite.userRepoMock.EXPECT().GetUserAvatar(userUID, ctx).Return(&dtos.UserAvatar{}, nil)
+
+	result, err := suite.avatarUsecase.UploadAvatar(userUID, bytes.NewReader(make([]byte, 65)), ctx)
+	suite.Nil(result)
+	suite.Equal(http.StatusRequestEntityTooLarge, err.Code)
+}
+
+func (suite *AvatarUsecaseTestSuite) TestDeleteAvatar_NoAvatar() {
+	ctx := &gin.Context{}
+	userUID := uuid.New().String()
+
+	suite.userRepoMock.EXPECT().SetUserAvatar(userUID, nil, ctx).Return(&dtos.UserAvatar{}, nil)
+
+	err := suite.avatarUsecase.DeleteAvatar(userUID, ctx)
+	suite.Equal(http.StatusNotFound, err.Code)
+}
+
+func TestAvatarUsecaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AvatarUsecaseTestSuite))
+}
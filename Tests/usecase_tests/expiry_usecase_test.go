@@ -0,0 +1,118 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+	mocks "github.com/google-run-code/Tests/Mocks"
+	usecases "github.com/google-run-code/Usecases"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExpiryUsecaseTestSuite struct {
+	suite.Suite
+	ctrl          *gomock.Controller
+	userRepoMock  *mocks.MockUserRepository
+	publisherMock *mocks.MockEventPublisher
+	expiryUsecase interfaces.ExpiryUseCase
+}
+
+func (suite *ExpiryUsecaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.userRepoMock = mocks.NewMockUserRepository(suite.ctrl)
+	suite.publisherMock = mocks.NewMockEventPublisher(suite.ctrl)
+	suite.expiryUsecase = usecases.NewExpiryUseCase(suite.userRepoMock, suite.publisherMock)
+}
+
+func (suite *ExpiryUsecaseTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *ExpiryUsecaseTestSuite) TestExpireRoleAssignments_PublishesEachExpiry() {
+	ctx := &gin.Context{}
+	ctx.Set(models.TenantContextKey, &models.Tenant{Name: "tenant_a"})
+	expiresAt := time.Now().Add(-time.Minute)
+
+	gomock.InOrder(
+		suite.userRepoMock.EXPECT().ExpireRoleAssignments(gomock.Any(), ctx).Return([]dtos.ExpiredRoleAssignment{
+			{UserUID: "user-1", RoleUID: "role-1", RoleName: "On-call", ExpiresAt: expiresAt},
+			{UserUID: "user-2", RoleUID: "role-1", RoleName: "On-call", Primary: true, ExpiresAt: expiresAt},
+		}, nil),
+		suite.userRepoMock.EXPECT().ExpireRoleAssignments(gomock.Any(), ctx).Return(nil, nil),
+	)
+
+	var events []models.Event
+	suite.publisherMock.EXPECT().Publish(gomock.Any()).DoAndReturn(func(event models.Event) error {
+		events = append(events, event)
+		return nil
+	}).Times(2)
+
+	err := suite.expiryUsecase.ExpireRoleAssignments(ctx)
+
+	suite.Nil(err)
+	suite.Require().Len(events, 2)
+	suite.Equal(models.EventRoleAssignmentExpired, events[0].Type)
+	suite.Equal("tenant_a", events[0].Tenant)
+	suite.Equal("user-1", events[0].Data["user_id"])
+	suite.Equal(true, events[1].Data["primary"])
+}
+
+func (suite *ExpiryUsecaseTestSuite) TestExpireRoleAssignments_ContinuesWhenPublishFails() {
+	ctx := &gin.Context{}
+
+	gomock.InOrder(
+		suite.userRepoMock.EXPECT().ExpireRoleAssignments(gomock.Any(), ctx).Return([]dtos.ExpiredRoleAssignment{
+			{UserUID: "user-1", RoleUID: "role-1"},
+			{UserUID: "user-2", RoleUID: "role-1"},
+		}, nil),
+		suite.userRepoMock.EXPECT().ExpireRoleAssignments(gomock.Any(), ctx).Return(nil, nil),
+	)
+	suite.publisherMock.EXPECT().Publish(gomock.Any()).Return(errors.New("webhook down")).Times(2)
+
+	suite.Nil(suite.expiryUsecase.ExpireRoleAssignments(ctx))
+}
+
+func (suite *ExpiryUsecaseTestSuite) TestExpireRoleAssignments_RepositoryError() {
+	ctx := &gin.Context{}
+
+	suite.userRepoMock.EXPECT().ExpireRoleAssignments(gomock.Any(), ctx).
+		Return(nil, models.InternalServerError("connection refused"))
+
+	err := suite.expiryUsecase.ExpireRoleAssignments(ctx)
+	suite.Equal(500, err.Code)
+}
+
+func (suite *ExpiryUsecaseTestSuite) TestExpireGroupMemberships_PublishesEachExpiry() {
+	ctx := &gin.Context{}
+	ctx.Set(models.TenantContextKey, &models.Tenant{Name: "tenant_a"})
+	validUntil := time.Now().Add(-time.Minute)
+
+	gomock.InOrder(
+		suite.userRepoMock.EXPECT().ExpireGroupMemberships(gomock.Any(), ctx).Return([]dtos.ExpiredGroupMembership{
+			{UserUID: "user-1", GroupUID: "group-1", GroupName: "Contractors", ValidUntil: validUntil},
+		}, nil),
+		suite.userRepoMock.EXPECT().ExpireGroupMemberships(gomock.Any(), ctx).Return(nil, nil),
+	)
+
+	var published models.Event
+	suite.publisherMock.EXPECT().Publish(gomock.Any()).DoAndReturn(func(event models.Event) error {
+		published = event
+		return nil
+	})
+
+	suite.Nil(suite.expiryUsecase.ExpireGroupMemberships(ctx))
+	suite.Equal(models.EventGroupMembershipExpired, published.Type)
+	suite.Equal("tenant_a", published.Tenant)
+	suite.Equal("group-1", published.Data["group_id"])
+	suite.Equal(validUntil, published.Data["valid_until"])
+}
+
+func TestExpiryUsecaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ExpiryUsecaseTestSuite))
+}
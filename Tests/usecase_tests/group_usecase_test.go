@@ -1,6 +1,7 @@
 package usecases_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -34,7 +35,8 @@ func (suite *GroupUsecaseTestSuite) TearDownSuite() {
 func (suite *GroupUsecaseTestSuite) TestCreateGroup_Success() {
 	ctx := &gin.Context{}
 	groupReq := dtos.GroupCreateRequest{
-		Name: "Test Group",
+		Name:     "Test Group",
+		OwnerIds: []string{uuid.New().String()},
 	}
 	group := &dtos.GroupResponse{
 		UID:  uuid.New().String(),
@@ -80,13 +82,13 @@ func (suite *GroupUsecaseTestSuite) TestGetGroupUsers_Success() {
 			UID:    uuid.New().String(),
 			Name:   "User 1",
 			Email:  "user1@example.com",
-			Status: 1,
+			Status: "active",
 		},
 		{
 			UID:    uuid.New().String(),
 			Name:   "User 2",
 			Email:  "user2@example.com",
-			Status: 1,
+			Status: "active",
 		},
 	}
 
@@ -98,7 +100,7 @@ func (suite *GroupUsecaseTestSuite) TestGetGroupUsers_Success() {
 		GetGroupUsers(groupID, ctx).
 		Return(expectedUsers, nil)
 
-	result, err := suite.groupUsecase.GetGroupUsers(groupID, ctx)
+	result, err := suite.groupUsecase.GetGroupUsers(groupID, dtos.MembershipFilter{}, ctx)
 
 	suite.Nil(err)
 	suite.Equal(expectedUsers, result)
@@ -146,6 +148,239 @@ func (suite *GroupUsecaseTestSuite) TestDeleteGroup_Success() {
 	suite.Nil(err)
 }
 
+func (suite *GroupUsecaseTestSuite) TestRestoreGroup_Success() {
+	ctx := &gin.Context{}
+	groupID := uuid.New().String()
+	group := &dtos.GroupResponse{
+		UID:  groupID,
+		Name: "Test Group",
+	}
+
+	suite.groupRepoMock.EXPECT().
+		RestoreGroup(groupID, ctx).
+		Return(group, nil)
+
+	result, err := suite.groupUsecase.RestoreGroup(groupID, ctx)
+
+	suite.Nil(err)
+	suite.Equal(group, result)
+}
+
+func (suite *GroupUsecaseTestSuite) TestGetGroupUsers_Effective() {
+	ctx := &gin.Context{}
+	groupID := uuid.New().String()
+	users := []dtos.UserResponse{
+		{UID: uuid.New().String(), Name: "Active", Status: "active"},
+		{UID: uuid.New().String(), Name: "Suspended", Status: "suspended"},
+	}
+
+	suite.groupRepoMock.EXPECT().
+		GetGroupById(groupID, ctx).
+		Return(&dtos.GroupResponse{UID: groupID, Name: "Test Group"}, nil)
+
+	suite.groupRepoMock.EXPECT().
+		GetGroupUsers(groupID, ctx).
+		Return(users, nil)
+
+	result, err := suite.groupUsecase.GetGroupUsers(groupID, dtos.MembershipFilter{Effective: true}, ctx)
+
+	suite.Nil(err)
+	suite.Equal([]dtos.UserResponse{users[0]}, result)
+}
+
+func (suite *GroupUsecaseTestSuite) TestGetGroupUsers_Transitive() {
+	ctx := &gin.Context{}
+	groupID := "engineering"
+	expectedUsers := []dtos.UserResponse{
+		{UID: "user-1", Name: "User 1", Status: "active"},
+		{UID: "user-2", Name: "User 2", Status: "suspended", InheritedFrom: "sre"},
+	}
+
+	suite.groupRepoMock.EXPECT().GetGroupById(groupID, ctx).Return(&dtos.GroupResponse{UID: groupID}, nil)
+	suite.groupRepoMock.EXPECT().GetGroupUsersTransitive(groupID, ctx).Return(expectedUsers, nil)
+
+	result, err := suite.groupUsecase.GetGroupUsers(groupID, dtos.MembershipFilter{Transitive: true, Effective: true}, ctx)
+
+	suite.Nil(err)
+	suite.Equal(expectedUsers[:1], result)
+}
+
+func (suite *GroupUsecaseTestSuite) TestCreateGroup_InvalidOwner() {
+	ctx := &gin.Context{}
+	groupReq := dtos.GroupCreateRequest{Name: "Test Group", OwnerIds: []string{"not-a-uuid"}}
+
+	result, err := suite.groupUsecase.CreateGroup(groupReq, ctx)
+	suite.Nil(result)
+	suite.Equal(400, err.Code)
+}
+
+func (suite *GroupUsecaseTestSuite) TestAddGroupOwner_ReturnsOwners() {
+	ctx := &gin.Context{}
+	groupID := uuid.New().String()
+	req := dtos.GroupOwnerRequest{UserId: uuid.New().String()}
+	owners := []dtos.UserResponse{{UID: req.UserId, Name: "Owner"}}
+
+	suite.groupRepoMock.EXPECT().AddGroupOwner(groupID, req.UserId, ctx).Return(nil)
+	suite.groupRepoMock.EXPECT().GetGroupOwners(groupID, ctx).Return(owners, nil)
+
+	result, err := suite.groupUsecase.AddGroupOwner(groupID, req, ctx)
+	suite.Nil(err)
+	suite.Equal(owners, result)
+}
+
+func (suite *GroupUsecaseTestSuite) TestRemoveGroupOwner_LastOwner() {
+	ctx := &gin.Context{}
+	groupID := uuid.New().String()
+	userID := uuid.New().String()
+
+	suite.groupRepoMock.EXPECT().RemoveGroupOwner(groupID, userID, ctx).
+		Return(models.Conflict("A group must keep at least one owner"))
+
+	err := suite.groupUsecase.RemoveGroupOwner(groupID, userID, ctx)
+	suite.Equal(409, err.Code)
+}
+
+func (suite *GroupUsecaseTestSuite) TestAddGroupMember_WithoutActor() {
+	ctx := &gin.Context{}
+	groupID := uuid.New().String()
+	userID := uuid.New().String()
+
+	suite.groupRepoMock.EXPECT().AddGroupMember(groupID, userID, dtos.GroupMemberRequest{}, ctx).Return(nil)
+
+	err := suite.groupUsecase.AddGroupMember(groupID, userID, dtos.GroupMemberRequest{}, ctx)
+	suite.Nil(err)
+}
+
+func (suite *GroupUsecaseTestSuite) TestAddGroupMember_Owner() {
+	ctx := &gin.Context{}
+	actor := &models.Actor{UserUID: uuid.New().String()}
+	ctx.Set(models.ActorContextKey, actor)
+	groupID := uuid.New().String()
+	userID := uuid.New().String()
+
+	suite.groupRepoMock.EXPECT().IsGroupOwner(groupID, *actor, ctx).Return(true, nil)
+	suite.groupRepoMock.EXPECT().GroupGrantsRoles(groupID, ctx).Return(false, nil)
+	suite.groupRepoMock.EXPECT().AddGroupMember(groupID, userID, dtos.GroupMemberRequest{}, ctx).Return(nil)
+
+	err := suite.groupUsecase.AddGroupMember(groupID, userID, dtos.GroupMemberRequest{}, ctx)
+	suite.Nil(err)
+}
+
+func (suite *GroupUsecaseTestSuite) TestAddGroupMembers_OwnerOfGroupGrantingRoles() {
+	ctx := &gin.Context{}
+	actor := &models.Actor{UserUID: uuid.New().String()}
+	ctx.Set(models.ActorContextKey, actor)
+	groupID := uuid.New().String()
+	req := dtos.GroupMembersRequest{UserIds: []string{actor.UserUID}}
+
+	suite.groupRepoMock.EXPECT().IsGroupOwner(groupID, *actor, ctx).Return(true, nil)
+	suite.groupRepoMock.EXPECT().GroupGrantsRoles(groupID, ctx).Return(true, nil)
+
+	result, err := suite.groupUsecase.AddGroupMembers(groupID, req, ctx)
+	suite.Nil(result)
+	suite.Equal(403, err.Code)
+}
+
+func (suite *GroupUsecaseTestSuite) TestRemoveGroupMember_NotOwner() {
+	ctx := &gin.Context{}
+	actor := &models.Actor{UserUID: uuid.New().String()}
+	ctx.Set(models.ActorContextKey, actor)
+	groupID := uuid.New().String()
+	userID := uuid.New().String()
+
+	suite.groupRepoMock.EXPECT().IsGroupOwner(groupID, *actor, ctx).Return(false, nil)
+
+	err := suite.groupUsecase.RemoveGroupMember(groupID, userID, ctx)
+	suite.Equal(403, err.Code)
+}
+
+func (suite *GroupUsecaseTestSuite) TestCreateGroup_InvalidRule() {
+	ctx := &gin.Context{}
+	groupReq := dtos.GroupCreateRequest{
+		Name:     "Test Group",
+		OwnerIds: []string{uuid.New().String()},
+		Rule:     json.RawMessage(`{"field": "status", "op": "eq", "value": "active", "unknown": true}`),
+	}
+
+	result, err := suite.groupUsecase.CreateGroup(groupReq, ctx)
+	suite.Nil(result)
+	suite.Equal(400, err.Code)
+}
+
+func (suite *GroupUsecaseTestSuite) TestPreviewGroupRule_Success() {
+	ctx := &gin.Context{}
+	req := dtos.GroupRulePreviewRequest{Rule: json.RawMessage(`{"field": "email_domain", "op": "eq", "value": "example.com"}`)}
+	rule := &models.GroupRule{Field: models.GroupRuleEmailDomain, Op: models.GroupRuleEq, Value: "example.com"}
+	preview := &dtos.GroupRulePreview{Count: 1, Users: []dtos.UserResponse{{Name: "User"}}}
+
+	suite.groupRepoMock.EXPECT().PreviewGroupRule(rule, ctx).Return(preview, nil)
+
+	result, err := suite.groupUsecase.PreviewGroupRule(req, ctx)
+	suite.Nil(err)
+	suite.Equal(preview, result)
+}
+
+func (suite *GroupUsecaseTestSuite) TestAddGroupRole_ReturnsRoles() {
+	ctx := &gin.Context{}
+	groupID := uuid.New().String()
+	req := dtos.GroupRoleRequest{RoleId: uuid.New().String()}
+	roles := []dtos.RoleResponse{{UID: req.RoleId, Name: "Editor"}}
+
+	suite.groupRepoMock.EXPECT().AddGroupRole(groupID, req.RoleId, ctx).Return(nil)
+	suite.groupRepoMock.EXPECT().GetGroupRoles(groupID, ctx).Return(roles, nil)
+
+	result, err := suite.groupUsecase.AddGroupRole(groupID, req, ctx)
+	suite.Nil(err)
+	suite.Equal(roles, result)
+}
+
+func (suite *GroupUsecaseTestSuite) TestRemoveGroupRole_InvalidRole() {
+	ctx := &gin.Context{}
+
+	err := suite.groupUsecase.RemoveGroupRole(uuid.New().String(), "not-a-uuid", ctx)
+	suite.Equal(404, err.Code)
+}
+
+func (suite *GroupUsecaseTestSuite) TestSetGroupMembers_ReturnsDiff() {
+	ctx := &gin.Context{}
+	groupID := uuid.New().String()
+	req := dtos.GroupMembersRequest{UserIds: []string{uuid.New().String()}}
+	diff := &dtos.GroupMembersDiff{
+		Added:     []dtos.UserResponse{{UID: req.UserIds[0]}},
+		Removed:   []dtos.UserResponse{{UID: uuid.New().String()}},
+		Unchanged: []dtos.UserResponse{},
+	}
+
+	suite.groupRepoMock.EXPECT().SetGroupMembers(groupID, req, ctx).Return(diff, nil)
+
+	result, err := suite.groupUsecase.SetGroupMembers(groupID, req, ctx)
+	suite.Nil(err)
+	suite.Equal(diff, result)
+}
+
+func (suite *GroupUsecaseTestSuite) TestAddGroupMembers_InvalidUser() {
+	ctx := &gin.Context{}
+	req := dtos.GroupMembersRequest{UserIds: []string{uuid.New().String(), "not-a-uuid"}}
+
+	result, err := suite.groupUsecase.AddGroupMembers(uuid.New().String(), req, ctx)
+	suite.Nil(result)
+	suite.Equal(404, err.Code)
+}
+
+func (suite *GroupUsecaseTestSuite) TestRemoveGroupMembers_NotOwner() {
+	ctx := &gin.Context{}
+	actor := &models.Actor{UserUID: uuid.New().String()}
+	ctx.Set(models.ActorContextKey, actor)
+	groupID := uuid.New().String()
+	req := dtos.GroupMembersRequest{UserIds: []string{uuid.New().String()}}
+
+	suite.groupRepoMock.EXPECT().IsGroupOwner(groupID, *actor, ctx).Return(false, nil)
+
+	result, err := suite.groupUsecase.RemoveGroupMembers(groupID, req, ctx)
+	suite.Nil(result)
+	suite.Equal(403, err.Code)
+}
+
 func TestGroupUsecaseTestSuite(t *testing.T) {
 	suite.Run(t, new(GroupUsecaseTestSuite))
 }
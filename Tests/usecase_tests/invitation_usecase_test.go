@@ -0,0 +1,177 @@
+package usecases_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+	mocks "github.com/google-run-code/Tests/Mocks"
+	usecases "github.com/google-run-code/Usecases"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type InvitationUsecaseTestSuite struct {
+	suite.Suite
+	invitationRepoMock *mocks.MockInvitationRepository
+	userUsecaseMock    *mocks.MockUserUseCase
+	emailServiceMock   *mocks.MockEmailService
+	invitationUsecase  interfaces.InvitationUseCase
+	ctrl               *gomock.Controller
+}
+
+func (suite *InvitationUsecaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.invitationRepoMock = mocks.NewMockInvitationRepository(suite.ctrl)
+	suite.userUsecaseMock = mocks.NewMockUserUseCase(suite.ctrl)
+	suite.emailServiceMock = mocks.NewMockEmailService(suite.ctrl)
+	suite.invitationUsecase = usecases.NewInvitationUseCase(suite.invitationRepoMock, suite.userUsecaseMock, suite.emailServiceMock, time.Hour)
+}
+
+func (suite *InvitationUsecaseTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func newInvitation(expiresAt time.Time) *models.Invitation {
+	return &models.Invitation{
+		UID:       uuid.New(),
+		User:      models.User{UID: uuid.New(), Name: "Invitee"},
+		Email:     "invitee@example.com",
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (suite *InvitationUsecaseTestSuite) TestCreateInvitation_Success() {
+	ctx := &gin.Context{}
+	ctx.Set(models.TenantContextKey, &models.Tenant{Name: "tenant_a"})
+	invitation := newInvitation(time.Now().Add(time.Hour))
+	userUID := invitation.User.UID.String()
+
+	user := dtos.UserCreateRequest{Email: "invitee@example.com", Status: string(models.UserStatusInvited)}
+
+	suite.userUsecaseMock.EXPECT().ValidateNewUser(&user, ctx).Return(nil)
+	suite.invitationRepoMock.EXPECT().
+		CreateInvitation(user, []string{"group-uid"}, gomock.Any(), gomock.Any(), ctx).
+		Return(invitation, nil)
+
+	var sentToken string
+	suite.emailServiceMock.EXPECT().
+		SendInvitationEmail("tenant_a", "Invitee", "invitee@example.com", gomock.Any(), invitation.ExpiresAt).
+		DoAndReturn(func(tenant, name, email, token string, expiresAt time.Time) error {
+			sentToken = token
+			return nil
+		})
+
+	result, err := suite.invitationUsecase.CreateInvitation(dtos.InvitationCreateRequest{Email: "invitee@example.com", GroupIds: []string{"group-uid"}}, ctx)
+	suite.Nil(err)
+	suite.Equal(string(models.InvitationPending), result.Status)
+	suite.Equal(userUID, result.UserUID)
+	suite.Empty(result.Warning)
+	suite.NotEmpty(sentToken)
+}
+
+func (suite *InvitationUsecaseTestSuite) TestCreateInvitation_InvalidUser() {
+	ctx := &gin.Context{}
+	user := dtos.UserCreateRequest{Email: "invitee@example.com", Status: string(models.UserStatusInvited)}
+
+	suite.userUsecaseMock.EXPECT().ValidateNewUser(&user, ctx).Return(models.Conflict("User with this email already exists"))
+
+	result, err := suite.invitationUsecase.CreateInvitation(dtos.InvitationCreateRequest{Email: "invitee@example.com"}, ctx)
+	suite.Nil(result)
+	suite.Equal(http.StatusConflict, err.Code)
+}
+
+func (suite *InvitationUsecaseTestSuite) TestCreateInvitation_SendFails() {
+	ctx := &gin.Context{}
+	invitation := newInvitation(time.Now().Add(time.Hour))
+	user := dtos.UserCreateRequest{Email: "invitee@example.com", Status: string(models.UserStatusInvited)}
+
+	suite.userUsecaseMock.EXPECT().ValidateNewUser(&user, ctx).Return(nil)
+	suite.invitationRepoMock.EXPECT().
+		CreateInvitation(user, gomock.Nil(), gomock.Any(), gomock.Any(), ctx).
+		Return(invitation, nil)
+	suite.emailServiceMock.EXPECT().
+		SendInvitationEmail(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("smtp unavailable"))
+
+	result, err := suite.invitationUsecase.CreateInvitation(dtos.InvitationCreateRequest{Email: "invitee@example.com"}, ctx)
+	suite.Nil(err)
+	suite.Equal(invitation.UID.String(), result.UID)
+	suite.NotEmpty(result.Warning)
+}
+
+func (suite *InvitationUsecaseTestSuite) TestAcceptInvitation_Success() {
+	ctx := &gin.Context{}
+	invitation := newInvitation(time.Now().Add(time.Hour))
+	userUID := invitation.User.UID.String()
+	name := "Jane"
+	activated := &dtos.UserResponseSingle{UID: userUID, Name: name, Status: "active", EmailVerified: true}
+
+	suite.invitationRepoMock.EXPECT().GetInvitationByTokenHash(gomock.Any(), ctx).Return(invitation, nil)
+	suite.invitationRepoMock.EXPECT().AcceptInvitation(invitation.UID.String(), &name, nil, ctx).Return(nil)
+	suite.userUsecaseMock.EXPECT().GetUserById(userUID, ctx).Return(activated, nil)
+
+	result, err := suite.invitationUsecase.AcceptInvitation(dtos.InvitationAcceptRequest{Token: "token", Name: &name}, ctx)
+	suite.Nil(err)
+	suite.Equal(activated, result)
+}
+
+func (suite *InvitationUsecaseTestSuite) TestAcceptInvitation_Expired() {
+	ctx := &gin.Context{}
+	invitation := newInvitation(time.Now().Add(-time.Minute))
+
+	suite.invitationRepoMock.EXPECT().GetInvitationByTokenHash(gomock.Any(), ctx).Return(invitation, nil)
+
+	result, err := suite.invitationUsecase.AcceptInvitation(dtos.InvitationAcceptRequest{Token: "token"}, ctx)
+	suite.Nil(result)
+	suite.Equal(http.StatusGone, err.Code)
+}
+
+func (suite *InvitationUsecaseTestSuite) TestAcceptInvitation_AlreadyAccepted() {
+	ctx := &gin.Context{}
+	invitation := newInvitation(time.Now().Add(time.Hour))
+	acceptedAt := time.Now()
+	invitation.AcceptedAt = &acceptedAt
+
+	suite.invitationRepoMock.EXPECT().GetInvitationByTokenHash(gomock.Any(), ctx).Return(invitation, nil)
+
+	result, err := suite.invitationUsecase.AcceptInvitation(dtos.InvitationAcceptRequest{Token: "token"}, ctx)
+	suite.Nil(result)
+	suite.Equal(http.StatusConflict, err.Code)
+}
+
+func (suite *InvitationUsecaseTestSuite) TestGetInvitations_FiltersByStatus() {
+	ctx := &gin.Context{}
+	pending := newInvitation(time.Now().Add(time.Hour))
+	expired := newInvitation(time.Now().Add(-time.Hour))
+
+	suite.invitationRepoMock.EXPECT().GetInvitations(ctx).Return([]*models.Invitation{pending, expired}, nil)
+
+	result, err := suite.invitationUsecase.GetInvitations("expired", ctx)
+	suite.Nil(err)
+	suite.Len(result, 1)
+	suite.Equal(expired.UID.String(), result[0].UID)
+}
+
+func (suite *InvitationUsecaseTestSuite) TestResendInvitation_Revoked() {
+	ctx := &gin.Context{}
+	invitation := newInvitation(time.Now().Add(time.Hour))
+	revokedAt := time.Now()
+	invitation.RevokedAt = &revokedAt
+
+	suite.invitationRepoMock.EXPECT().GetInvitationById(invitation.UID.String(), ctx).Return(invitation, nil)
+
+	result, err := suite.invitationUsecase.ResendInvitation(invitation.UID.String(), ctx)
+	suite.Nil(result)
+	suite.Equal(http.StatusConflict, err.Code)
+}
+
+func TestInvitationUsecaseTestSuite(t *testing.T) {
+	suite.Run(t, new(InvitationUsecaseTestSuite))
+}
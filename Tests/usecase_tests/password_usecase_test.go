@@ -0,0 +1,127 @@
+package usecases_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+	mocks "github.com/google-run-code/Tests/Mocks"
+	usecases "github.com/google-run-code/Usecases"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type PasswordUsecaseTestSuite struct {
+	suite.Suite
+	passwordRepoMock *mocks.MockPasswordRepository
+	userRepoMock     *mocks.MockUserRepository
+	emailServiceMock *mocks.MockEmailService
+	hasherMock       *mocks.MockPasswordHasher
+	passwordUsecase  interfaces.PasswordUseCase
+	ctrl             *gomock.Controller
+}
+
+func (suite *PasswordUsecaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.passwordRepoMock = mocks.NewMockPasswordRepository(suite.ctrl)
+	suite.userRepoMock = mocks.NewMockUserRepository(suite.ctrl)
+	suite.emailServiceMock = mocks.NewMockEmailService(suite.ctrl)
+	suite.hasherMock = mocks.NewMockPasswordHasher(suite.ctrl)
+	suite.passwordUsecase = usecases.NewPasswordUseCase(suite.passwordRepoMock, suite.userRepoMock, suite.emailServiceMock, suite.hasherMock, 30*time.Minute, 3)
+}
+
+func (suite *PasswordUsecaseTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *PasswordUsecaseTestSuite) TestForgotPassword_UnknownEmail() {
+	ctx := &gin.Context{}
+
+	suite.userRepoMock.EXPECT().
+		GetUserByEmail("nobody@example.com", ctx).
+		Return(nil, models.NotFound("User not found"))
+
+	err := suite.passwordUsecase.ForgotPassword(dtos.ForgotPasswordRequest{Email: "nobody@example.com"}, ctx)
+	suite.Nil(err)
+}
+
+func (suite *PasswordUsecaseTestSuite) TestForgotPassword_RateLimited() {
+	ctx := &gin.Context{}
+	user := &models.User{ID: 7, UID: uuid.New(), Email: "user@example.com", Status: models.UserStatusActive}
+
+	suite.userRepoMock.EXPECT().GetUserByEmail(user.Email, ctx).Return(user, nil)
+	done := make(chan struct{})
+	suite.passwordRepoMock.EXPECT().
+		CreatePasswordReset(user.ID, gomock.Any(), gomock.Any(), gomock.Any(), 3, gomock.Any()).
+		Do(func(int, string, time.Time, time.Time, int, *gin.Context) { close(done) }).
+		Return(false, nil)
+
+	err := suite.passwordUsecase.ForgotPassword(dtos.ForgotPasswordRequest{Email: user.Email}, ctx)
+	suite.Nil(err)
+	<-done
+}
+
+func (suite *PasswordUsecaseTestSuite) TestForgotPassword_SendsResetLink() {
+	ctx := &gin.Context{}
+	ctx.Set(models.TenantContextKey, &models.Tenant{Name: "tenant_a"})
+	user := &models.User{ID: 7, UID: uuid.New(), Name: "User", Email: "user@example.com", Status: models.UserStatusActive}
+
+	suite.userRepoMock.EXPECT().GetUserByEmail(user.Email, ctx).Return(user, nil)
+	done := make(chan struct{})
+	suite.passwordRepoMock.EXPECT().
+		CreatePasswordReset(user.ID, gomock.Any(), gomock.Any(), gomock.Any(), 3, gomock.Any()).
+		Return(true, nil)
+	suite.emailServiceMock.EXPECT().
+		SendPasswordResetEmail("tenant_a", user.Name, user.Email, gomock.Any(), gomock.Any()).
+		Do(func(string, string, string, string, time.Time) { close(done) }).
+		Return(nil)
+
+	err := suite.passwordUsecase.ForgotPassword(dtos.ForgotPasswordRequest{Email: user.Email}, ctx)
+	suite.Nil(err)
+	<-done
+}
+
+func (suite *PasswordUsecaseTestSuite) TestResetPassword_PolicyViolation() {
+	ctx := &gin.Context{}
+	reset := &models.PasswordReset{ExpiresAt: time.Now().Add(time.Minute), User: models.User{Status: models.UserStatusActive}}
+
+	suite.passwordRepoMock.EXPECT().GetPasswordReset(gomock.Any(), ctx).Return(reset, nil)
+	suite.passwordRepoMock.EXPECT().GetPasswordPolicy(ctx).Return(nil, nil)
+
+	err := suite.passwordUsecase.ResetPassword(dtos.ResetPasswordRequest{Token: "token", Password: "short"}, ctx)
+	suite.Equal(http.StatusUnprocessableEntity, err.Code)
+}
+
+func (suite *PasswordUsecaseTestSuite) TestResetPassword_UsedToken() {
+	ctx := &gin.Context{}
+	usedAt := time.Now()
+	reset := &models.PasswordReset{ExpiresAt: time.Now().Add(time.Minute), UsedAt: &usedAt, User: models.User{Status: models.UserStatusActive}}
+
+	suite.passwordRepoMock.EXPECT().GetPasswordReset(gomock.Any(), ctx).Return(reset, nil)
+
+	err := suite.passwordUsecase.ResetPassword(dtos.ResetPasswordRequest{Token: "token", Password: "a long enough password"}, ctx)
+	suite.Equal(http.StatusBadRequest, err.Code)
+}
+
+func (suite *PasswordUsecaseTestSuite) TestResetPassword_Success() {
+	ctx := &gin.Context{}
+	reset := &models.PasswordReset{ExpiresAt: time.Now().Add(time.Minute), User: models.User{Status: models.UserStatusActive}}
+	policy := &models.PasswordPolicy{MinLength: 8, RequireDigit: true}
+
+	suite.passwordRepoMock.EXPECT().GetPasswordReset(gomock.Any(), ctx).Return(reset, nil)
+	suite.passwordRepoMock.EXPECT().GetPasswordPolicy(ctx).Return(policy, nil)
+	suite.hasherMock.EXPECT().Hash("correct horse 42").Return("hashed", nil)
+	suite.passwordRepoMock.EXPECT().ResetPassword(gomock.Any(), "hashed", ctx).Return(nil)
+
+	err := suite.passwordUsecase.ResetPassword(dtos.ResetPasswordRequest{Token: "token", Password: "correct horse 42"}, ctx)
+	suite.Nil(err)
+}
+
+func TestPasswordUsecaseTestSuite(t *testing.T) {
+	suite.Run(t, new(PasswordUsecaseTestSuite))
+}
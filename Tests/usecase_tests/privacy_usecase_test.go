@@ -0,0 +1,115 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+	mocks "github.com/google-run-code/Tests/Mocks"
+	usecases "github.com/google-run-code/Usecases"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type PrivacyUsecaseTestSuite struct {
+	suite.Suite
+	userRepoMock   *mocks.MockUserRepository
+	blobStoreMock  *mocks.MockBlobStore
+	privacyUsecase interfaces.PrivacyUseCase
+	ctrl           *gomock.Controller
+}
+
+func (suite *PrivacyUsecaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.userRepoMock = mocks.NewMockUserRepository(suite.ctrl)
+	suite.blobStoreMock = mocks.NewMockBlobStore(suite.ctrl)
+	suite.privacyUsecase = usecases.NewPrivacyUseCase(suite.userRepoMock, suite.blobStoreMock)
+}
+
+func (suite *PrivacyUsecaseTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *PrivacyUsecaseTestSuite) TestExportUser_Success() {
+	ctx := &gin.Context{}
+	userUID := uuid.New().String()
+	export := &dtos.UserDataExport{Profile: dtos.UserResponseSingle{UID: userUID}}
+
+	suite.userRepoMock.EXPECT().ExportUser(userUID, ctx).Return(export, nil)
+
+	res, err := suite.privacyUsecase.ExportUser(userUID, ctx)
+
+	suite.Nil(err)
+	suite.Equal(export, res)
+}
+
+func (suite *PrivacyUsecaseTestSuite) TestExportUser_InvalidID() {
+	_, err := suite.privacyUsecase.ExportUser("not-a-uuid", &gin.Context{})
+
+	suite.Require().NotNil(err)
+	suite.Equal(404, err.Code)
+}
+
+func (suite *PrivacyUsecaseTestSuite) TestEraseUser_DeletesAvatar() {
+	ctx := &gin.Context{}
+	userUID := uuid.New().String()
+
+	gomock.InOrder(
+		suite.userRepoMock.EXPECT().EraseUser(userUID, ctx).Return(&dtos.ErasedUser{AvatarKeys: []string{"tenant_a/avatars/key"}}, nil),
+		suite.blobStoreMock.EXPECT().Delete("tenant_a/avatars/key").Return(nil),
+	)
+
+	suite.Nil(suite.privacyUsecase.EraseUser(userUID, ctx))
+}
+
+func (suite *PrivacyUsecaseTestSuite) TestEraseUser_DeletesMergedAvatars() {
+	ctx := &gin.Context{}
+	userUID := uuid.New().String()
+
+	suite.userRepoMock.EXPECT().EraseUser(userUID, ctx).Return(&dtos.ErasedUser{AvatarKeys: []string{"target", "source"}}, nil)
+	suite.blobStoreMock.EXPECT().Delete("target").Return(nil)
+	suite.blobStoreMock.EXPECT().Delete("source").Return(nil)
+
+	suite.Nil(suite.privacyUsecase.EraseUser(userUID, ctx))
+}
+
+func (suite *PrivacyUsecaseTestSuite) TestEraseUser_WithoutAvatar() {
+	ctx := &gin.Context{}
+	userUID := uuid.New().String()
+
+	suite.userRepoMock.EXPECT().EraseUser(userUID, ctx).Return(&dtos.ErasedUser{}, nil)
+	suite.blobStoreMock.EXPECT().Delete(gomock.Any()).Times(0)
+
+	suite.Nil(suite.privacyUsecase.EraseUser(userUID, ctx))
+}
+
+func (suite *PrivacyUsecaseTestSuite) TestEraseUser_AvatarDeleteFails() {
+	ctx := &gin.Context{}
+	userUID := uuid.New().String()
+
+	suite.userRepoMock.EXPECT().EraseUser(userUID, ctx).Return(&dtos.ErasedUser{AvatarKeys: []string{"key"}}, nil)
+	suite.blobStoreMock.EXPECT().Delete("key").Return(errors.New("bucket unavailable"))
+
+	suite.Nil(suite.privacyUsecase.EraseUser(userUID, ctx))
+}
+
+func (suite *PrivacyUsecaseTestSuite) TestEraseUser_AlreadyErased() {
+	ctx := &gin.Context{}
+	userUID := uuid.New().String()
+
+	suite.userRepoMock.EXPECT().EraseUser(userUID, ctx).Return(nil, models.Conflict("User has already been erased"))
+	suite.blobStoreMock.EXPECT().Delete(gomock.Any()).Times(0)
+
+	err := suite.privacyUsecase.EraseUser(userUID, ctx)
+
+	suite.Require().NotNil(err)
+	suite.Equal(409, err.Code)
+}
+
+func TestPrivacyUsecaseTestSuite(t *testing.T) {
+	suite.Run(t, new(PrivacyUsecaseTestSuite))
+}
@@ -2,6 +2,7 @@ package usecases_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
@@ -120,18 +121,24 @@ func (suite *RoleUsecaseTestSuite) TestDeleteRole_Success() {
 func (suite *RoleUsecaseTestSuite) TestGetRoleUsers_Success() {
 	ctx := &gin.Context{}
 	roleID := "some-role-id"
-	expectedUsers := []*dtos.UserResponse{
+	expectedUsers := []*dtos.RoleUserResponse{
 		{
-			UID:    uuid.New().String(),
-			Name:   "User 1",
-			Email:  "user1@example.com",
-			Status: 1,
+			UserResponse: dtos.UserResponse{
+				UID:    uuid.New().String(),
+				Name:   "User 1",
+				Email:  "user1@example.com",
+				Status: "active",
+			},
+			Active: true,
 		},
 		{
-			UID:    uuid.New().String(),
-			Name:   "User 2",
-			Email:  "user2@example.com",
-			Status: 1,
+			UserResponse: dtos.UserResponse{
+				UID:    uuid.New().String(),
+				Name:   "User 2",
+				Email:  "user2@example.com",
+				Status: "active",
+			},
+			Active: true,
 		},
 	}
 
@@ -143,12 +150,38 @@ func (suite *RoleUsecaseTestSuite) TestGetRoleUsers_Success() {
 		GetRoleUsers(&dtos.RoleResponse{UID: roleID, Name: "Test Role"}, ctx).
 		Return(expectedUsers, nil)
 
-	result, err := suite.roleUsecase.GetRoleUsers(roleID, ctx)
+	result, err := suite.roleUsecase.GetRoleUsers(roleID, dtos.MembershipFilter{}, ctx)
 
 	suite.Nil(err)
 	suite.Equal(expectedUsers, result)
 }
 
+func (suite *RoleUsecaseTestSuite) TestGetRoleUsers_EffectiveSkipsPendingAssignments() {
+	ctx := &gin.Context{}
+	roleID := "some-role-id"
+	startsAt := time.Now().Add(time.Hour)
+	current := &dtos.RoleUserResponse{
+		UserResponse: dtos.UserResponse{UID: uuid.New().String(), Status: "active"},
+		Active:       true,
+	}
+	pending := &dtos.RoleUserResponse{
+		UserResponse: dtos.UserResponse{UID: uuid.New().String(), Status: "active"},
+		StartsAt:     &startsAt,
+	}
+
+	suite.roleRepoMock.EXPECT().
+		GetRoleById(roleID, ctx).
+		Return(&dtos.RoleResponse{UID: roleID, Name: "Test Role"}, nil)
+	suite.roleRepoMock.EXPECT().
+		GetRoleUsers(&dtos.RoleResponse{UID: roleID, Name: "Test Role"}, ctx).
+		Return([]*dtos.RoleUserResponse{current, pending}, nil)
+
+	result, err := suite.roleUsecase.GetRoleUsers(roleID, dtos.MembershipFilter{Effective: true}, ctx)
+
+	suite.Nil(err)
+	suite.Equal([]*dtos.RoleUserResponse{current}, result)
+}
+
 func TestRoleUsecaseTestSuite(t *testing.T) {
 	suite.Run(t, new(RoleUsecaseTestSuite))
 }
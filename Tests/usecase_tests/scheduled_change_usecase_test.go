@@ -0,0 +1,155 @@
+package usecases_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+	mocks "github.com/google-run-code/Tests/Mocks"
+	usecases "github.com/google-run-code/Usecases"
+	"github.com/stretchr/testify/suite"
+)
+
+type ScheduledChangeUsecaseTestSuite struct {
+	suite.Suite
+	ctrl                   *gomock.Controller
+	changeRepoMock         *mocks.MockScheduledChangeRepository
+	userUsecaseMock        *mocks.MockUserUseCase
+	groupUsecaseMock       *mocks.MockGroupUseCase
+	roleUsecaseMock        *mocks.MockRoleUseCase
+	scheduledChangeUsecase interfaces.ScheduledChangeUseCase
+}
+
+func (suite *ScheduledChangeUsecaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.changeRepoMock = mocks.NewMockScheduledChangeRepository(suite.ctrl)
+	suite.userUsecaseMock = mocks.NewMockUserUseCase(suite.ctrl)
+	suite.groupUsecaseMock = mocks.NewMockGroupUseCase(suite.ctrl)
+	suite.roleUsecaseMock = mocks.NewMockRoleUseCase(suite.ctrl)
+	suite.scheduledChangeUsecase = usecases.NewScheduledChangeUseCase(suite.changeRepoMock, suite.userUsecaseMock, suite.groupUsecaseMock, suite.roleUsecaseMock)
+}
+
+func (suite *ScheduledChangeUsecaseTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *ScheduledChangeUsecaseTestSuite) TestCreateScheduledChange_Success() {
+	ctx := &gin.Context{}
+	req := dtos.ScheduledChangeRequest{
+		Type:        string(models.ScheduledUserStatus),
+		TargetID:    "user-1",
+		EffectiveAt: time.Now().Add(time.Hour),
+		Payload:     json.RawMessage(`{ "status": "suspended" }`),
+	}
+
+	suite.userUsecaseMock.EXPECT().GetUserById("user-1", ctx).Return(&dtos.UserResponseSingle{}, nil)
+	suite.changeRepoMock.EXPECT().CreateScheduledChange(gomock.Any(), ctx).DoAndReturn(func(change *models.ScheduledChange, ctx *gin.Context) *models.ErrorResponse {
+		suite.Equal(models.ScheduledChangePending, change.Status)
+		suite.Equal(`{"status":"suspended"}`, string(change.Payload))
+		return nil
+	})
+
+	res, err := suite.scheduledChangeUsecase.CreateScheduledChange(req, ctx)
+
+	suite.Nil(err)
+	suite.Equal("user-1", res.TargetID)
+	suite.Equal(string(models.ScheduledChangePending), res.Status)
+}
+
+func (suite *ScheduledChangeUsecaseTestSuite) TestCreateScheduledChange_EffectiveAtInThePast() {
+	req := dtos.ScheduledChangeRequest{
+		Type:        string(models.ScheduledUserDelete),
+		TargetID:    "user-1",
+		EffectiveAt: time.Now().Add(-time.Minute),
+	}
+
+	_, err := suite.scheduledChangeUsecase.CreateScheduledChange(req, &gin.Context{})
+
+	suite.Require().NotNil(err)
+	suite.Equal(400, err.Code)
+}
+
+func (suite *ScheduledChangeUsecaseTestSuite) TestCreateScheduledChange_UnknownType() {
+	req := dtos.ScheduledChangeRequest{
+		Type:        "user.rename",
+		TargetID:    "user-1",
+		EffectiveAt: time.Now().Add(time.Hour),
+	}
+
+	_, err := suite.scheduledChangeUsecase.CreateScheduledChange(req, &gin.Context{})
+
+	suite.Require().NotNil(err)
+	suite.Equal(400, err.Code)
+}
+
+func (suite *ScheduledChangeUsecaseTestSuite) TestCreateScheduledChange_InvalidPayload() {
+	for _, payload := range []string{``, `{}`, `{"status": "asleep"}`} {
+		req := dtos.ScheduledChangeRequest{
+			Type:        string(models.ScheduledUserStatus),
+			TargetID:    "user-1",
+			EffectiveAt: time.Now().Add(time.Hour),
+			Payload:     json.RawMessage(payload),
+		}
+
+		_, err := suite.scheduledChangeUsecase.CreateScheduledChange(req, &gin.Context{})
+
+		suite.Require().NotNil(err, payload)
+		suite.Equal(400, err.Code, payload)
+	}
+}
+
+func (suite *ScheduledChangeUsecaseTestSuite) TestCreateScheduledChange_TargetNotFound() {
+	ctx := &gin.Context{}
+	req := dtos.ScheduledChangeRequest{
+		Type:        string(models.ScheduledGroupDelete),
+		TargetID:    "group-1",
+		EffectiveAt: time.Now().Add(time.Hour),
+	}
+
+	suite.groupUsecaseMock.EXPECT().GetGroupById("group-1", ctx).Return(nil, models.NotFound("Group not found"))
+
+	_, err := suite.scheduledChangeUsecase.CreateScheduledChange(req, ctx)
+
+	suite.Require().NotNil(err)
+	suite.Equal(404, err.Code)
+}
+
+func (suite *ScheduledChangeUsecaseTestSuite) TestApplyDueChanges_RecordsOutcomes() {
+	ctx := &gin.Context{}
+
+	gomock.InOrder(
+		suite.changeRepoMock.EXPECT().ClaimDueChanges(gomock.Any(), gomock.Any(), ctx).Return([]*models.ScheduledChange{
+			{ID: 1, Type: models.ScheduledUserGroupsAdd, TargetUID: "user-1", Payload: json.RawMessage(`{"group_ids":["group-1"]}`)},
+			{ID: 2, Type: models.ScheduledRoleDelete, TargetUID: "role-1", Payload: json.RawMessage(`{}`)},
+		}, nil),
+		suite.changeRepoMock.EXPECT().ClaimDueChanges(gomock.Any(), gomock.Any(), ctx).Return(nil, nil),
+	)
+
+	suite.userUsecaseMock.EXPECT().AddUserToGroup(dtos.AddUserToGroupRequest{UserUID: "user-1", GroupIds: []string{"group-1"}}, ctx).Return(nil, "User added to groups")
+	suite.roleUsecaseMock.EXPECT().DeleteRole("role-1", ctx).Return(models.NotFound("Role not found"))
+
+	suite.changeRepoMock.EXPECT().FinishScheduledChange(1, models.ScheduledChangeApplied, "User added to groups", ctx).Return(nil)
+	suite.changeRepoMock.EXPECT().FinishScheduledChange(2, models.ScheduledChangeFailed, "Role not found", ctx).Return(nil)
+
+	suite.Nil(suite.scheduledChangeUsecase.ApplyDueChanges(ctx))
+}
+
+func (suite *ScheduledChangeUsecaseTestSuite) TestApplyDueChanges_RepositoryError() {
+	ctx := &gin.Context{}
+
+	suite.changeRepoMock.EXPECT().ClaimDueChanges(gomock.Any(), gomock.Any(), ctx).Return(nil, models.InternalServerError("db down"))
+
+	err := suite.scheduledChangeUsecase.ApplyDueChanges(ctx)
+
+	suite.Require().NotNil(err)
+	suite.Equal(500, err.Code)
+}
+
+func TestScheduledChangeUsecaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduledChangeUsecaseTestSuite))
+}
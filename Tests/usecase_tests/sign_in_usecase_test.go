@@ -0,0 +1,137 @@
+package usecases_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+	mocks "github.com/google-run-code/Tests/Mocks"
+	usecases "github.com/google-run-code/Usecases"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type SignInUsecaseTestSuite struct {
+	suite.Suite
+	signInRepoMock *mocks.MockSignInRepository
+	userRepoMock   *mocks.MockUserRepository
+	hasherMock     *mocks.MockPasswordHasher
+	trackerMock    *mocks.MockActivityTracker
+	jwtMock        *mocks.MockJwtService
+	signInUsecase  interfaces.SignInUseCase
+	ctrl           *gomock.Controller
+}
+
+func (suite *SignInUsecaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.signInRepoMock = mocks.NewMockSignInRepository(suite.ctrl)
+	suite.userRepoMock = mocks.NewMockUserRepository(suite.ctrl)
+	suite.hasherMock = mocks.NewMockPasswordHasher(suite.ctrl)
+	suite.trackerMock = mocks.NewMockActivityTracker(suite.ctrl)
+	suite.jwtMock = mocks.NewMockJwtService(suite.ctrl)
+	suite.signInUsecase = usecases.NewSignInUseCase(suite.signInRepoMock, suite.userRepoMock, suite.hasherMock, suite.trackerMock, suite.jwtMock)
+}
+
+func (suite *SignInUsecaseTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *SignInUsecaseTestSuite) signInRequest(email string) dtos.SignInRequest {
+	return dtos.SignInRequest{Email: email, Password: "secret", IPAddress: "203.0.113.7", UserAgent: "curl/8.0"}
+}
+
+func (suite *SignInUsecaseTestSuite) TestSignIn_Success() {
+	ctx := &gin.Context{}
+	ctx.Set(models.TenantContextKey, &models.Tenant{Name: "tenant-a"})
+	user := &models.User{ID: 7, UID: uuid.New(), Email: "user@example.com", Status: models.UserStatusActive, PasswordHash: "hash"}
+
+	suite.userRepoMock.EXPECT().GetUserByEmail(user.Email, ctx).Return(user, nil)
+	suite.hasherMock.EXPECT().Compare("hash", "secret").Return(true)
+	suite.signInRepoMock.EXPECT().CreateSignIn(models.SignIn{
+		UserID:    &user.ID,
+		Email:     user.Email,
+		IPAddress: "203.0.113.7",
+		UserAgent: "curl/8.0",
+		Outcome:   models.SignInSucceeded,
+	}, ctx).Return(nil)
+	suite.jwtMock.EXPECT().GenerateUserToken("tenant-a", user.UID.String()).Return("user-token", nil)
+	suite.trackerMock.EXPECT().Touch(ctx, user.UID.String(), true)
+
+	res, err := suite.signInUsecase.SignIn(suite.signInRequest(user.Email), ctx)
+	suite.Nil(err)
+	suite.Equal(user.UID.String(), res.UID)
+	suite.Equal("user-token", res.Token)
+}
+
+func (suite *SignInUsecaseTestSuite) TestSignIn_UnknownUser() {
+	ctx := &gin.Context{}
+
+	suite.userRepoMock.EXPECT().GetUserByEmail("nobody@example.com", ctx).Return(nil, models.NotFound("User not found"))
+	suite.signInRepoMock.EXPECT().CreateSignIn(gomock.Any(), ctx).DoAndReturn(func(signIn models.SignIn, _ *gin.Context) *models.ErrorResponse {
+		suite.Nil(signIn.UserID)
+		suite.Equal(models.SignInUnknownUser, signIn.Outcome)
+		return nil
+	})
+
+	res, err := suite.signInUsecase.SignIn(suite.signInRequest("nobody@example.com"), ctx)
+	suite.Nil(res)
+	suite.Equal(http.StatusUnauthorized, err.Code)
+}
+
+func (suite *SignInUsecaseTestSuite) TestSignIn_InvalidPassword() {
+	ctx := &gin.Context{}
+	user := &models.User{ID: 7, UID: uuid.New(), Email: "user@example.com", Status: models.UserStatusActive, PasswordHash: "hash"}
+
+	suite.userRepoMock.EXPECT().GetUserByEmail(user.Email, ctx).Return(user, nil)
+	suite.hasherMock.EXPECT().Compare("hash", "secret").Return(false)
+	suite.signInRepoMock.EXPECT().CreateSignIn(gomock.Any(), ctx).DoAndReturn(func(signIn models.SignIn, _ *gin.Context) *models.ErrorResponse {
+		suite.Equal(&user.ID, signIn.UserID)
+		suite.Equal(models.SignInInvalidPassword, signIn.Outcome)
+		return nil
+	})
+
+	res, err := suite.signInUsecase.SignIn(suite.signInRequest(user.Email), ctx)
+	suite.Nil(res)
+	suite.Equal(http.StatusUnauthorized, err.Code)
+	suite.Equal("Invalid email or password", err.Message)
+}
+
+func (suite *SignInUsecaseTestSuite) TestSignIn_SuspendedUser() {
+	ctx := &gin.Context{}
+	user := &models.User{ID: 7, UID: uuid.New(), Email: "user@example.com", Status: models.UserStatusSuspended, PasswordHash: "hash"}
+
+	suite.userRepoMock.EXPECT().GetUserByEmail(user.Email, ctx).Return(user, nil)
+	suite.hasherMock.EXPECT().Compare("hash", "secret").Return(true)
+	suite.signInRepoMock.EXPECT().CreateSignIn(gomock.Any(), ctx).DoAndReturn(func(signIn models.SignIn, _ *gin.Context) *models.ErrorResponse {
+		suite.Equal(models.SignInInactiveUser, signIn.Outcome)
+		return nil
+	})
+
+	res, err := suite.signInUsecase.SignIn(suite.signInRequest(user.Email), ctx)
+	suite.Nil(res)
+	suite.Equal(http.StatusForbidden, err.Code)
+}
+
+func (suite *SignInUsecaseTestSuite) TestSignIn_LogFailureDoesNotBlock() {
+	ctx := &gin.Context{}
+	ctx.Set(models.TenantContextKey, &models.Tenant{Name: "tenant-a"})
+	user := &models.User{ID: 7, UID: uuid.New(), Email: "user@example.com", Status: models.UserStatusActive, PasswordHash: "hash"}
+
+	suite.userRepoMock.EXPECT().GetUserByEmail(user.Email, ctx).Return(user, nil)
+	suite.hasherMock.EXPECT().Compare("hash", "secret").Return(true)
+	suite.signInRepoMock.EXPECT().CreateSignIn(gomock.Any(), ctx).Return(models.InternalServerError("connection refused"))
+	suite.jwtMock.EXPECT().GenerateUserToken("tenant-a", user.UID.String()).Return("user-token", nil)
+	suite.trackerMock.EXPECT().Touch(ctx, user.UID.String(), true)
+
+	res, err := suite.signInUsecase.SignIn(suite.signInRequest(user.Email), ctx)
+	suite.Nil(err)
+	suite.NotNil(res)
+}
+
+func TestSignInUsecaseTestSuite(t *testing.T) {
+	suite.Run(t, new(SignInUsecaseTestSuite))
+}
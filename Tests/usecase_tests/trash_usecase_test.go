@@ -0,0 +1,62 @@
+package usecases_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	mocks "github.com/google-run-code/Tests/Mocks"
+	usecases "github.com/google-run-code/Usecases"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type TrashUsecaseTestSuite struct {
+	suite.Suite
+	trashRepoMock *mocks.MockTrashRepository
+	trashUsecase  interfaces.TrashUseCase
+	ctrl          *gomock.Controller
+}
+
+func (suite *TrashUsecaseTestSuite) SetupSuite() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.trashRepoMock = mocks.NewMockTrashRepository(suite.ctrl)
+	suite.trashUsecase = usecases.NewTrashUseCase(suite.trashRepoMock)
+}
+
+func (suite *TrashUsecaseTestSuite) TearDownSuite() {
+	suite.ctrl.Finish()
+}
+
+func (suite *TrashUsecaseTestSuite) TestGetTrash_Success() {
+	ctx := &gin.Context{}
+	expected := []*dtos.TrashEntryResponse{
+		{Type: "user", UID: uuid.New().String(), Name: "Test User", DeletedAt: time.Now()},
+	}
+
+	suite.trashRepoMock.EXPECT().
+		GetTrash("user", ctx).
+		Return(expected, nil)
+
+	result, err := suite.trashUsecase.GetTrash("user", ctx)
+
+	suite.Nil(err)
+	suite.Equal(expected, result)
+}
+
+func (suite *TrashUsecaseTestSuite) TestGetTrash_InvalidType() {
+	ctx := &gin.Context{}
+
+	result, err := suite.trashUsecase.GetTrash("widget", ctx)
+
+	suite.Nil(result)
+	suite.Equal(http.StatusBadRequest, err.Code)
+}
+
+func TestTrashUsecaseTestSuite(t *testing.T) {
+	suite.Run(t, new(TrashUsecaseTestSuite))
+}
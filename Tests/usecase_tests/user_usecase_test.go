@@ -1,12 +1,18 @@
 package usecases_test
 
 import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	dtos "github.com/google-run-code/Domain/Dtos"
 	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
 	mocks "github.com/google-run-code/Tests/Mocks"
 	usecases "github.com/google-run-code/Usecases"
 	"github.com/google/uuid"
@@ -19,6 +25,8 @@ type UserUsecaseTestSuite struct {
 	roleRepoMock      *mocks.MockRoleRepository
 	groupRepoMock     *mocks.MockGroupRepository
 	emailService      *mocks.MockEmailService
+	schemaRepoMock    *mocks.MockAttributeSchemaRepository
+	validatorMock     *mocks.MockAttributeValidator
 	userUsecase       interfaces.UserUseCase
 	userUsecaseMocker *mocks.MockUserUseCase
 	ctrl              *gomock.Controller
@@ -30,6 +38,8 @@ func (suite *UserUsecaseTestSuite) SetupSuite() {
 	suite.roleRepoMock = mocks.NewMockRoleRepository(suite.ctrl)
 	suite.groupRepoMock = mocks.NewMockGroupRepository(suite.ctrl)
 	suite.emailService = mocks.NewMockEmailService(suite.ctrl)
+	suite.schemaRepoMock = mocks.NewMockAttributeSchemaRepository(suite.ctrl)
+	suite.validatorMock = mocks.NewMockAttributeValidator(suite.ctrl)
 	suite.userUsecaseMocker = mocks.NewMockUserUseCase(suite.ctrl)
 
 	suite.userUsecase = usecases.NewUserUseCase(
@@ -37,6 +47,8 @@ func (suite *UserUsecaseTestSuite) SetupSuite() {
 		suite.emailService,
 		suite.roleRepoMock,
 		suite.groupRepoMock,
+		suite.schemaRepoMock,
+		suite.validatorMock,
 	)
 }
 
@@ -51,7 +63,7 @@ func (suite *UserUsecaseTestSuite) TestGetUserByID_Success() {
 		UID:    uuid.New().String(),
 		Name:   "Test User",
 		Email:  "test@example.com",
-		Status: 1,
+		Status: "active",
 	}
 
 	suite.userRepoMock.EXPECT().
@@ -74,13 +86,13 @@ func (suite *UserUsecaseTestSuite) TestSearchUsers_Success() {
 			UID:    uuid.New().String(),
 			Name:   "Test User 1",
 			Email:  "user1@example.com",
-			Status: 1,
+			Status: "active",
 		},
 		{
 			UID:    uuid.New().String(),
 			Name:   "Test User 2",
 			Email:  "user2@example.com",
-			Status: 1,
+			Status: "active",
 		},
 	}
 
@@ -94,6 +106,27 @@ func (suite *UserUsecaseTestSuite) TestSearchUsers_Success() {
 	suite.Equal(expectedUsers, result)
 }
 
+func (suite *UserUsecaseTestSuite) TestSearchUsers_InvalidOrderBy() {
+	ctx := &gin.Context{}
+
+	for _, orderBy := range []string{"password_hash", "name; DROP TABLE users", "--name", "name desc"} {
+		result, err := suite.userUsecase.SearchUsers(dtos.SearchFields{Name: "Test", OrderBy: orderBy}, ctx)
+
+		suite.Nil(result)
+		suite.Equal(http.StatusBadRequest, err.Code, orderBy)
+	}
+}
+
+func (suite *UserUsecaseTestSuite) TestSearchUsers_DescendingOrder() {
+	ctx := &gin.Context{}
+	searchFields := dtos.SearchFields{Name: "Test", OrderBy: "-last_login_at"}
+
+	suite.userRepoMock.EXPECT().SearchUsers(searchFields, ctx).Return([]*dtos.UserResponseAll{}, nil)
+
+	_, err := suite.userUsecase.SearchUsers(searchFields, ctx)
+	suite.Nil(err)
+}
+
 func (suite *UserUsecaseTestSuite) TestDeleteUser_Success() {
 	ctx := &gin.Context{}
 	UserUID := "some-uuid"
@@ -116,6 +149,752 @@ func (suite *UserUsecaseTestSuite) TestDeleteUser_Success() {
 	suite.Nil(err)
 }
 
+func (suite *UserUsecaseTestSuite) TestRestoreUser_Success() {
+	ctx := &gin.Context{}
+	UserUID := "some-uuid"
+	restoredUser := &dtos.UserResponseSingle{
+		UID:  UserUID,
+		Name: "Test User",
+	}
+
+	suite.userRepoMock.EXPECT().
+		RestoreUser(UserUID, ctx).
+		Return(nil)
+
+	suite.userRepoMock.EXPECT().
+		GetUserById(UserUID, ctx).
+		Return(restoredUser, nil)
+
+	result, err := suite.userUsecase.RestoreUser(UserUID, ctx)
+
+	suite.Nil(err)
+	suite.Equal(restoredUser, result)
+}
+
+func (suite *UserUsecaseTestSuite) TestRestoreUser_EmailTaken() {
+	ctx := &gin.Context{}
+	UserUID := "some-uuid"
+
+	suite.userRepoMock.EXPECT().
+		RestoreUser(UserUID, ctx).
+		Return(models.Conflict("Another user with this email already exists"))
+
+	result, err := suite.userUsecase.RestoreUser(UserUID, ctx)
+
+	suite.Nil(result)
+	suite.Equal(http.StatusConflict, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestChangeUserStatus_Success() {
+	ctx := &gin.Context{}
+	UserUID := "active-user"
+
+	suite.userRepoMock.EXPECT().
+		GetUserById(UserUID, ctx).
+		Return(&dtos.UserResponseSingle{UID: UserUID, Status: "active"}, nil)
+
+	suite.userRepoMock.EXPECT().
+		UpdateUserStatus(UserUID, models.UserStatusSuspended, ctx).
+		Return(nil)
+
+	suite.userRepoMock.EXPECT().
+		GetUserById(UserUID, ctx).
+		Return(&dtos.UserResponseSingle{UID: UserUID, Status: "suspended"}, nil)
+
+	result, err := suite.userUsecase.ChangeUserStatus(UserUID, models.UserStatusSuspended, ctx)
+
+	suite.Nil(err)
+	suite.Equal("suspended", result.Status)
+}
+
+func (suite *UserUsecaseTestSuite) TestChangeUserStatus_InvalidTransition() {
+	ctx := &gin.Context{}
+	UserUID := "deprovisioned-user"
+
+	suite.userRepoMock.EXPECT().
+		GetUserById(UserUID, ctx).
+		Return(&dtos.UserResponseSingle{UID: UserUID, Status: "deprovisioned"}, nil)
+
+	result, err := suite.userUsecase.ChangeUserStatus(UserUID, models.UserStatusActive, ctx)
+
+	suite.Nil(result)
+	suite.Equal(http.StatusConflict, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestUpdateUser_InvalidTransition() {
+	ctx := &gin.Context{}
+	UserUID := "invited-user"
+	status := "suspended"
+
+	suite.userRepoMock.EXPECT().
+		GetUserById(UserUID, ctx).
+		Return(&dtos.UserResponseSingle{UID: UserUID, Status: "invited"}, nil)
+
+	result, err := suite.userUsecase.UpdateUser(UserUID, dtos.UserUpdateRequest{Status: &status}, ctx)
+
+	suite.Nil(result)
+	suite.Equal(http.StatusConflict, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestUpdateUser_Erased() {
+	ctx := &gin.Context{}
+	UserUID := "erased-user"
+	name := "Jane"
+	erasedAt := time.Now()
+
+	suite.userRepoMock.EXPECT().
+		GetUserById(UserUID, ctx).
+		Return(&dtos.UserResponseSingle{UID: UserUID, Status: "deprovisioned", ErasedAt: &erasedAt}, nil)
+
+	result, err := suite.userUsecase.UpdateUser(UserUID, dtos.UserUpdateRequest{Name: &name}, ctx)
+
+	suite.Nil(result)
+	suite.Equal(http.StatusConflict, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestUpdateUser_InvalidPhoneNumber() {
+	ctx := &gin.Context{}
+	UserUID := "active-user"
+	phone := "415-555-0123"
+
+	suite.userRepoMock.EXPECT().
+		GetUserById(UserUID, ctx).
+		Return(&dtos.UserResponseSingle{UID: UserUID, Status: "active"}, nil)
+
+	result, err := suite.userUsecase.UpdateUser(UserUID, dtos.UserUpdateRequest{
+		UserProfileUpdate: dtos.UserProfileUpdate{PhoneNumber: &phone},
+	}, ctx)
+
+	suite.Nil(result)
+	suite.Equal(http.StatusBadRequest, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestUpdateUser_NormalizesProfile() {
+	ctx := &gin.Context{}
+	UserUID := "active-user"
+	locale := "pt-br"
+	timezone := "America/Sao_Paulo"
+	title := "  Engineer "
+
+	suite.userRepoMock.EXPECT().
+		GetUserById(UserUID, ctx).
+		Return(&dtos.UserResponseSingle{UID: UserUID, Status: "active"}, nil)
+	suite.userRepoMock.EXPECT().
+		UpdateUser(UserUID, gomock.Any(), ctx).
+		DoAndReturn(func(uid string, update *dtos.UserUpdateRequest, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+			suite.Equal("pt-BR", *update.Locale)
+			suite.Equal(timezone, *update.Timezone)
+			suite.Equal("Engineer", *update.Title)
+			suite.Nil(update.PhoneNumber)
+			return &dtos.UserResponseSingle{UID: uid}, nil
+		})
+
+	_, err := suite.userUsecase.UpdateUser(UserUID, dtos.UserUpdateRequest{
+		UserProfileUpdate: dtos.UserProfileUpdate{Locale: &locale, Timezone: &timezone, Title: &title},
+	}, ctx)
+
+	suite.Nil(err)
+}
+
+func (suite *UserUsecaseTestSuite) TestCreateUser_RejectsInitialStatus() {
+	ctx := &gin.Context{}
+
+	result, err := suite.userUsecase.CreateUser(dtos.UserCreateRequest{
+		Name:   "Test User",
+		Email:  "test@example.com",
+		Status: "suspended",
+	}, ctx)
+
+	suite.Nil(result)
+	suite.Equal(http.StatusBadRequest, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestGetUsersGroup_EffectiveSkipsSuspended() {
+	ctx := &gin.Context{}
+	UserUID := "suspended-user"
+
+	suite.userRepoMock.EXPECT().
+		GetUserById(UserUID, ctx).
+		Return(&dtos.UserResponseSingle{UID: UserUID, Status: "suspended"}, nil)
+
+	result, err := suite.userUsecase.GetUsersGroup(UserUID, dtos.MembershipFilter{Effective: true}, ctx)
+
+	suite.Nil(err)
+	suite.Empty(result)
+}
+
+func (suite *UserUsecaseTestSuite) TestGetUsersGroup_Transitive() {
+	ctx := &gin.Context{}
+	UserUID := "user-1"
+	expected := []*dtos.GroupResponse{
+		{UID: "sre", Name: "SRE", ParentID: "platform"},
+		{UID: "platform", Name: "Platform", InheritedFrom: "sre"},
+	}
+
+	suite.userRepoMock.EXPECT().GetUsersGroupsTransitive(UserUID, ctx).Return(expected, nil)
+
+	result, err := suite.userUsecase.GetUsersGroup(UserUID, dtos.MembershipFilter{Transitive: true}, ctx)
+
+	suite.Nil(err)
+	suite.Equal(expected, result)
+}
+
+func (suite *UserUsecaseTestSuite) TestValidateAttributes_NotAnObject() {
+	ctx := &gin.Context{}
+
+	err := suite.userUsecase.ValidateAttributes(json.RawMessage(`["engineering"]`), ctx)
+
+	suite.Equal(http.StatusBadRequest, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestValidateAttributes_NoSchema() {
+	ctx := &gin.Context{}
+
+	suite.schemaRepoMock.EXPECT().
+		GetAttributeSchema(ctx).
+		Return(nil, nil)
+
+	err := suite.userUsecase.ValidateAttributes(json.RawMessage(`{"department":"Sales"}`), ctx)
+
+	suite.Nil(err)
+}
+
+func (suite *UserUsecaseTestSuite) TestValidateAttributes_SchemaViolation() {
+	ctx := &gin.Context{}
+	schema := json.RawMessage(`{"type":"object","properties":{"employee_id":{"type":"integer"}}}`)
+	attributes := json.RawMessage(`{"employee_id":"abc"}`)
+
+	suite.schemaRepoMock.EXPECT().
+		GetAttributeSchema(ctx).
+		Return(&dtos.AttributeSchemaResponse{Schema: schema}, nil)
+
+	suite.validatorMock.EXPECT().
+		Validate(schema, attributes).
+		Return(errors.New("expected integer"))
+
+	err := suite.userUsecase.ValidateAttributes(attributes, ctx)
+
+	suite.Equal(http.StatusUnprocessableEntity, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestVerifyEmail_Success() {
+	ctx := &gin.Context{}
+	ctx.Set(models.TenantContextKey, &models.Tenant{Name: "tenant_a"})
+	UserUID := uuid.New().String()
+	claims := &models.EmailVerificationClaims{Tenant: "tenant_a", UserUID: UserUID, Email: "test@example.com"}
+	verified := &dtos.UserResponseSingle{UID: UserUID, Email: "test@example.com", EmailVerified: true}
+
+	suite.emailService.EXPECT().ParseVerificationToken("token").Return(claims, nil)
+	gomock.InOrder(
+		suite.userRepoMock.EXPECT().GetUserById(UserUID, ctx).
+			Return(&dtos.UserResponseSingle{UID: UserUID, Email: "test@example.com"}, nil),
+		suite.userRepoMock.EXPECT().MarkEmailVerified(UserUID, "test@example.com", ctx).Return(nil),
+		suite.userRepoMock.EXPECT().GetUserById(UserUID, ctx).Return(verified, nil),
+	)
+
+	result, err := suite.userUsecase.VerifyEmail("token", ctx)
+	suite.Nil(err)
+	suite.Equal(verified, result)
+}
+
+func (suite *UserUsecaseTestSuite) TestVerifyEmail_EmailChanged() {
+	ctx := &gin.Context{}
+	ctx.Set(models.TenantContextKey, &models.Tenant{Name: "tenant_a"})
+	UserUID := uuid.New().String()
+	claims := &models.EmailVerificationClaims{Tenant: "tenant_a", UserUID: UserUID, Email: "old@example.com"}
+
+	suite.emailService.EXPECT().ParseVerificationToken("token").Return(claims, nil)
+	suite.userRepoMock.EXPECT().GetUserById(UserUID, ctx).
+		Return(&dtos.UserResponseSingle{UID: UserUID, Email: "new@example.com"}, nil)
+	suite.userRepoMock.EXPECT().MarkEmailAliasVerified(UserUID, "old@example.com", ctx).
+		Return(models.Conflict("The email address has changed since the link was sent"))
+
+	result, err := suite.userUsecase.VerifyEmail("token", ctx)
+	suite.Nil(result)
+	suite.Equal(http.StatusConflict, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestVerifyEmail_PrimaryIgnoresCase() {
+	ctx := &gin.Context{}
+	ctx.Set(models.TenantContextKey, &models.Tenant{Name: "tenant_a"})
+	UserUID := uuid.New().String()
+	claims := &models.EmailVerificationClaims{Tenant: "tenant_a", UserUID: UserUID, Email: "bob@example.com"}
+	user := &dtos.UserResponseSingle{UID: UserUID, Email: "Bob@Example.com"}
+
+	suite.emailService.EXPECT().ParseVerificationToken("token").Return(claims, nil)
+	suite.userRepoMock.EXPECT().GetUserById(UserUID, ctx).Return(user, nil).Times(2)
+	suite.userRepoMock.EXPECT().MarkEmailVerified(UserUID, "bob@example.com", ctx).Return(nil)
+
+	result, err := suite.userUsecase.VerifyEmail("token", ctx)
+	suite.Nil(err)
+	suite.Equal(user, result)
+}
+
+func (suite *UserUsecaseTestSuite) TestAddEmailAlias_Taken() {
+	ctx := &gin.Context{}
+	UserUID := uuid.New().String()
+
+	suite.userRepoMock.EXPECT().GetUserById(UserUID, ctx).Return(&dtos.UserResponseSingle{UID: UserUID}, nil)
+	suite.emailService.EXPECT().IsValidEmail("Other@Example.com").Return(true)
+	suite.userRepoMock.EXPECT().IsEmailTaken("Other@Example.com", "", ctx).Return(true, nil)
+
+	result, err := suite.userUsecase.AddEmailAlias(UserUID, dtos.EmailAliasCreateRequest{Email: "Other@Example.com"}, ctx)
+	suite.Nil(result)
+	suite.Equal(http.StatusConflict, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestAddEmailAlias_SendsVerification() {
+	ctx := &gin.Context{}
+	ctx.Set(models.TenantContextKey, &models.Tenant{Name: "tenant_a"})
+	UserUID := uuid.New().String()
+	alias := &dtos.EmailAliasResponse{UID: uuid.New().String(), Email: "alias@example.com"}
+
+	suite.userRepoMock.EXPECT().GetUserById(UserUID, ctx).Return(&dtos.UserResponseSingle{UID: UserUID, Name: "Bob"}, nil)
+	suite.emailService.EXPECT().IsValidEmail("alias@example.com").Return(true)
+	suite.userRepoMock.EXPECT().IsEmailTaken("alias@example.com", "", ctx).Return(false, nil)
+	suite.userRepoMock.EXPECT().CreateEmailAlias(UserUID, "alias@example.com", ctx).Return(alias, nil)
+	suite.emailService.EXPECT().SendVerificationEmail("tenant_a", UserUID, "Bob", "alias@example.com").Return(nil)
+
+	result, err := suite.userUsecase.AddEmailAlias(UserUID, dtos.EmailAliasCreateRequest{Email: "alias@example.com"}, ctx)
+	suite.Nil(err)
+	suite.Equal(alias, result)
+}
+
+func (suite *UserUsecaseTestSuite) TestVerifyEmail_WrongTenant() {
+	ctx := &gin.Context{}
+	ctx.Set(models.TenantContextKey, &models.Tenant{Name: "tenant_b"})
+	claims := &models.EmailVerificationClaims{Tenant: "tenant_a", UserUID: uuid.New().String(), Email: "test@example.com"}
+
+	suite.emailService.EXPECT().ParseVerificationToken("token").Return(claims, nil)
+
+	result, err := suite.userUsecase.VerifyEmail("token", ctx)
+	suite.Nil(result)
+	suite.Equal(http.StatusBadRequest, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestBatchUsers_AtomicStopsOnInvalidOperation() {
+	ctx := &gin.Context{}
+	missing := uuid.New().String()
+	req := dtos.BatchUsersRequest{Operations: []dtos.BatchUserOperation{
+		{Op: dtos.BatchOpCreate, Data: json.RawMessage(`{"name":"A","email":"a@example.com"}`)},
+		{Op: dtos.BatchOpDelete, ID: missing},
+	}}
+
+	suite.schemaRepoMock.EXPECT().GetAttributeSchema(ctx).Return(nil, nil)
+	suite.userRepoMock.EXPECT().GetUsersByIds([]string{missing}, ctx).Return(nil, nil)
+	suite.emailService.EXPECT().IsValidEmail("a@example.com").Return(true)
+
+	res, err := suite.userUsecase.BatchUsers(req, ctx)
+	suite.Nil(err)
+	suite.Equal(0, res.Succeeded)
+	suite.Equal(2, res.Failed)
+	suite.Equal(http.StatusFailedDependency, res.Results[0].Status)
+	suite.Equal(http.StatusNotFound, res.Results[1].Status)
+}
+
+func (suite *UserUsecaseTestSuite) TestBatchUsers_ContinueOnError() {
+	ctx := &gin.Context{}
+	existing := &models.User{UID: uuid.New(), Name: "B", Email: "b@example.com", Status: models.UserStatusActive}
+	req := dtos.BatchUsersRequest{
+		ContinueOnError: true,
+		Operations: []dtos.BatchUserOperation{
+			{Op: dtos.BatchOpCreate, Data: json.RawMessage(`{"name":"A","email":"a@example.com","status":"invited"}`)},
+			{Op: dtos.BatchOpUpdate, ID: existing.UID.String(), Data: json.RawMessage(`{"status":"invited"}`)},
+			{Op: dtos.BatchOpDelete, ID: existing.UID.String()},
+		},
+	}
+	createdUID := uuid.New().String()
+
+	suite.schemaRepoMock.EXPECT().GetAttributeSchema(ctx).Return(nil, nil)
+	suite.userRepoMock.EXPECT().
+		GetUsersByIds([]string{existing.UID.String(), existing.UID.String()}, ctx).
+		Return([]*models.User{existing}, nil)
+	suite.emailService.EXPECT().IsValidEmail("a@example.com").Return(true)
+	suite.userRepoMock.EXPECT().
+		ApplyUserBatch(gomock.Len(1), true, ctx).
+		DoAndReturn(func(changes []dtos.BatchUserChange, continueOnError bool, ctx *gin.Context) ([]*dtos.BatchUserResult, *models.ErrorResponse) {
+			suite.Equal(0, changes[0].Index)
+			suite.Equal("a@example.com", changes[0].Create.Email)
+			return []*dtos.BatchUserResult{{Index: 0, Op: dtos.BatchOpCreate, UID: createdUID, Status: http.StatusCreated}}, nil
+		})
+
+	res, err := suite.userUsecase.BatchUsers(req, ctx)
+	suite.Nil(err)
+	suite.Equal(1, res.Succeeded)
+	suite.Equal(2, res.Failed)
+	suite.Equal(createdUID, res.Results[0].UID)
+	suite.Equal(http.StatusConflict, res.Results[1].Status)
+	suite.Equal(http.StatusBadRequest, res.Results[2].Status)
+}
+
+func (suite *UserUsecaseTestSuite) TestImportUsers_MappingAndRowErrors() {
+	ctx := &gin.Context{}
+	csvData := "Full Name,Mail,Teams\n" +
+		"Alice,alice@example.com,Sales; Ops\n" +
+		"Bob,not-an-email,\n" +
+		"Carol,carol@example.com,\n"
+	req := dtos.UserImportRequest{Mapping: map[string]string{
+		dtos.UserCSVFieldName:   "Full Name",
+		dtos.UserCSVFieldEmail:  "Mail",
+		dtos.UserCSVFieldGroups: "Teams",
+	}}
+
+	suite.emailService.EXPECT().IsValidEmail("alice@example.com").Return(true)
+	suite.emailService.EXPECT().IsValidEmail("not-an-email").Return(false)
+	suite.emailService.EXPECT().IsValidEmail("carol@example.com").Return(true)
+	suite.userRepoMock.EXPECT().
+		ImportUsers(gomock.Any(), false, ctx).
+		DoAndReturn(func(next func() (*dtos.UserImportRow, error), dryRun bool, ctx *gin.Context) (*dtos.UserImportResult, *models.ErrorResponse) {
+			var rows []*dtos.UserImportRow
+			for {
+				row, err := next()
+				suite.NoError(err)
+				if row == nil {
+					break
+				}
+				rows = append(rows, row)
+			}
+
+			suite.Len(rows, 2)
+			suite.Equal(2, rows[0].Line)
+			suite.Equal("Alice", rows[0].Name)
+			suite.Equal([]string{"Sales", "Ops"}, rows[0].Groups)
+			suite.Equal(4, rows[1].Line)
+			return &dtos.UserImportResult{
+				Total:  2,
+				Failed: 1,
+				Errors: []dtos.UserImportError{{Line: 4, Error: "Group not found"}},
+			}, nil
+		})
+
+	res, err := suite.userUsecase.ImportUsers(strings.NewReader(csvData), req, ctx)
+	suite.Nil(err)
+	suite.Equal(3, res.Total)
+	suite.Equal(2, res.Failed)
+	suite.Equal([]dtos.UserImportError{
+		{Line: 3, Error: "Invalid email"},
+		{Line: 4, Error: "Group not found"},
+	}, res.Errors)
+}
+
+func (suite *UserUsecaseTestSuite) TestImportUsers_MalformedQuotedRow() {
+	ctx := &gin.Context{}
+	csvData := "name,email\n" +
+		"Al\"ice,alice@example.com\n" +
+		"Bob,bob@example.com\n" +
+		"\"Carol,carol@example.com\n"
+
+	suite.emailService.EXPECT().IsValidEmail("bob@example.com").Return(true)
+	suite.userRepoMock.EXPECT().
+		ImportUsers(gomock.Any(), false, ctx).
+		DoAndReturn(func(next func() (*dtos.UserImportRow, error), dryRun bool, ctx *gin.Context) (*dtos.UserImportResult, *models.ErrorResponse) {
+			row, err := next()
+			suite.NoError(err)
+			suite.Equal(3, row.Line)
+			suite.Equal("bob@example.com", row.Email)
+
+			row, err = next()
+			suite.NoError(err)
+			suite.Nil(row)
+			return &dtos.UserImportResult{Total: 1, Created: 1}, nil
+		})
+
+	res, err := suite.userUsecase.ImportUsers(strings.NewReader(csvData), dtos.UserImportRequest{}, ctx)
+	suite.Nil(err)
+	suite.Equal(3, res.Total)
+	suite.Equal(2, res.Failed)
+	suite.Len(res.Errors, 2)
+	suite.Equal(2, res.Errors[0].Line)
+	suite.Equal(4, res.Errors[1].Line)
+}
+
+func (suite *UserUsecaseTestSuite) TestImportUsers_RemovesExportEscaping() {
+	ctx := &gin.Context{}
+	csvData := "name,email,groups\n" +
+		"'=SUM(A1),alice@example.com,'@ops;sales\n" +
+		"'quoted,bob@example.com,\n"
+
+	suite.emailService.EXPECT().IsValidEmail("alice@example.com").Return(true)
+	suite.emailService.EXPECT().IsValidEmail("bob@example.com").Return(true)
+	suite.userRepoMock.EXPECT().
+		ImportUsers(gomock.Any(), false, ctx).
+		DoAndReturn(func(next func() (*dtos.UserImportRow, error), dryRun bool, ctx *gin.Context) (*dtos.UserImportResult, *models.ErrorResponse) {
+			row, err := next()
+			suite.NoError(err)
+			suite.Equal("=SUM(A1)", row.Name)
+			suite.Equal([]string{"@ops", "sales"}, row.Groups)
+
+			row, err = next()
+			suite.NoError(err)
+			suite.Equal("'quoted", row.Name)
+			return &dtos.UserImportResult{Total: 2, Created: 2}, nil
+		})
+
+	_, err := suite.userUsecase.ImportUsers(strings.NewReader(csvData), dtos.UserImportRequest{}, ctx)
+	suite.Nil(err)
+}
+
+func (suite *UserUsecaseTestSuite) TestImportUsers_MissingMappedColumn() {
+	ctx := &gin.Context{}
+	req := dtos.UserImportRequest{Mapping: map[string]string{dtos.UserCSVFieldEmail: "Mail"}}
+
+	res, err := suite.userUsecase.ImportUsers(strings.NewReader("name,email\nA,a@example.com\n"), req, ctx)
+	suite.Nil(res)
+	suite.Equal(http.StatusBadRequest, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestMergeUsers_IntoItself() {
+	ctx := &gin.Context{}
+	UserUID := uuid.New().String()
+
+	result, err := suite.userUsecase.MergeUsers(UserUID, dtos.UserMergeRequest{SourceID: UserUID}, ctx)
+	suite.Nil(result)
+	suite.Equal(http.StatusBadRequest, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestMergeUsers_DefaultsRoleConflict() {
+	ctx := &gin.Context{}
+	UserUID := uuid.New().String()
+	SourceUID := uuid.New().String()
+	merged := &dtos.UserResponseSingle{UID: UserUID}
+
+	suite.userRepoMock.EXPECT().
+		MergeUsers(UserUID, gomock.Any(), ctx).
+		DoAndReturn(func(targetUID string, req dtos.UserMergeRequest, ctx *gin.Context) *models.ErrorResponse {
+			suite.Equal(SourceUID, req.SourceID)
+			suite.Equal(dtos.MergeRoleKeepTarget, req.RoleConflict)
+			return nil
+		})
+	suite.userRepoMock.EXPECT().GetUserById(UserUID, ctx).Return(merged, nil)
+
+	result, err := suite.userUsecase.MergeUsers(UserUID, dtos.UserMergeRequest{SourceID: SourceUID}, ctx)
+	suite.Nil(err)
+	suite.Equal(merged, result)
+}
+
+func (suite *UserUsecaseTestSuite) TestMergeUsers_UnknownRoleConflict() {
+	ctx := &gin.Context{}
+
+	result, err := suite.userUsecase.MergeUsers(uuid.New().String(), dtos.UserMergeRequest{
+		SourceID:     uuid.New().String(),
+		RoleConflict: "newest",
+	}, ctx)
+	suite.Nil(result)
+	suite.Equal(http.StatusBadRequest, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestGetReport_IndirectReport() {
+	ctx := &gin.Context{}
+	ManagerUID := uuid.New().String()
+	ReportUID := uuid.New().String()
+	LeadUID := uuid.New().String()
+
+	suite.userRepoMock.EXPECT().GetManagementChain(ReportUID, ctx).Return([]*dtos.UserReportResponse{
+		{UID: LeadUID, Depth: 1},
+		{UID: ManagerUID, Depth: 2},
+	}, nil)
+	suite.userRepoMock.EXPECT().GetUserById(ReportUID, ctx).Return(&dtos.UserResponseSingle{
+		UID:     ReportUID,
+		Name:    "Report",
+		Manager: &dtos.UserReference{UID: LeadUID},
+	}, nil)
+
+	report, err := suite.userUsecase.GetReport(ManagerUID, ReportUID, ctx)
+	suite.Nil(err)
+	suite.Equal(2, report.Depth)
+	suite.Equal(LeadUID, report.ManagerUID)
+}
+
+func (suite *UserUsecaseTestSuite) TestGetReport_NotInChain() {
+	ctx := &gin.Context{}
+	ReportUID := uuid.New().String()
+
+	suite.userRepoMock.EXPECT().GetManagementChain(ReportUID, ctx).Return([]*dtos.UserReportResponse{
+		{UID: uuid.New().String(), Depth: 1},
+	}, nil)
+
+	report, err := suite.userUsecase.GetReport(uuid.New().String(), ReportUID, ctx)
+	suite.Nil(report)
+	suite.Equal(http.StatusNotFound, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestGetReports_AllLevels() {
+	ctx := &gin.Context{}
+	ManagerUID := uuid.New().String()
+
+	suite.userRepoMock.EXPECT().GetReports(ManagerUID, 1, ctx).Return(nil, nil)
+	suite.userRepoMock.EXPECT().GetReports(ManagerUID, 100, ctx).Return(nil, nil)
+
+	_, err := suite.userUsecase.GetReports(ManagerUID, false, ctx)
+	suite.Nil(err)
+	_, err = suite.userUsecase.GetReports(ManagerUID, true, ctx)
+	suite.Nil(err)
+}
+
+func (suite *UserUsecaseTestSuite) TestGetReports_Manager() {
+	ctx := &gin.Context{}
+	actor := &models.Actor{UserUID: uuid.New().String()}
+	ctx.Set(models.ActorContextKey, actor)
+	TeamLeadUID := uuid.New().String()
+
+	suite.userRepoMock.EXPECT().IsManagerOf(TeamLeadUID, *actor, ctx).Return(true, nil)
+	suite.userRepoMock.EXPECT().GetReports(TeamLeadUID, 1, ctx).Return(nil, nil)
+
+	_, err := suite.userUsecase.GetReports(TeamLeadUID, false, ctx)
+	suite.Nil(err)
+}
+
+func (suite *UserUsecaseTestSuite) TestGetUserById_NotManager() {
+	ctx := &gin.Context{}
+	actor := &models.Actor{UserUID: uuid.New().String()}
+	ctx.Set(models.ActorContextKey, actor)
+	UserUID := uuid.New().String()
+
+	suite.userRepoMock.EXPECT().IsManagerOf(UserUID, *actor, ctx).Return(false, nil)
+
+	user, err := suite.userUsecase.GetUserById(UserUID, ctx)
+	suite.Nil(user)
+	suite.Equal(http.StatusForbidden, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestUpdateUser_ManagerCycle() {
+	ctx := &gin.Context{}
+	UserUID := "manager-user"
+	ReportUID := "report-user"
+
+	suite.userRepoMock.EXPECT().
+		GetUserById(UserUID, ctx).
+		Return(&dtos.UserResponseSingle{UID: UserUID, Status: "active"}, nil)
+	suite.userRepoMock.EXPECT().
+		UpdateUser(UserUID, gomock.Any(), ctx).
+		DoAndReturn(func(_ string, req *dtos.UserUpdateRequest, _ *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+			suite.Equal(ReportUID, *req.ManagerId)
+			return nil, models.Conflict("The manager reports to this user, which would create a cycle")
+		})
+
+	result, err := suite.userUsecase.UpdateUser(UserUID, dtos.UserUpdateRequest{ManagerId: &ReportUID}, ctx)
+	suite.Nil(result)
+	suite.Equal(http.StatusConflict, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestAddUserToGroup_ValidUntilInThePast() {
+	ctx := &gin.Context{}
+	validUntil := time.Now().Add(-time.Hour)
+
+	err, msg := suite.userUsecase.AddUserToGroup(dtos.AddUserToGroupRequest{
+		UserUID:    uuid.New().String(),
+		GroupIds:   []string{uuid.New().String()},
+		ValidUntil: &validUntil,
+	}, ctx)
+	suite.Empty(msg)
+	suite.Equal(http.StatusBadRequest, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestAddUserToGroup_UpdatesPeriodOfExistingMembership() {
+	ctx := &gin.Context{}
+	userUID := uuid.New().String()
+	memberOf := uuid.New().String()
+	newGroup := uuid.New().String()
+	validUntil := time.Now().Add(24 * time.Hour)
+
+	suite.userRepoMock.EXPECT().GetUserById(userUID, ctx).Return(&dtos.UserResponseSingle{UID: userUID}, nil)
+	suite.groupRepoMock.EXPECT().GetGroupById(memberOf, ctx).Return(&dtos.GroupResponse{UID: memberOf}, nil)
+	suite.groupRepoMock.EXPECT().GetGroupById(newGroup, ctx).Return(&dtos.GroupResponse{UID: newGroup}, nil)
+	suite.userRepoMock.EXPECT().GetUsersGroups(userUID, ctx).Return([]*dtos.GroupResponse{{UID: memberOf}}, nil)
+	suite.userRepoMock.EXPECT().AddUserToGroup(dtos.AddUserToGroupRequest{
+		UserUID:    userUID,
+		GroupIds:   []string{newGroup, memberOf},
+		ValidUntil: &validUntil,
+	}, ctx).Return(nil)
+
+	err, msg := suite.userUsecase.AddUserToGroup(dtos.AddUserToGroupRequest{
+		UserUID:    userUID,
+		GroupIds:   []string{memberOf, newGroup},
+		ValidUntil: &validUntil,
+	}, ctx)
+	suite.Nil(err)
+	suite.Contains(msg, "The membership period was updated for the following groups: "+memberOf)
+}
+
+func (suite *UserUsecaseTestSuite) TestAddUserToGroup_DynamicGroup() {
+	ctx := &gin.Context{}
+	userUID := uuid.New().String()
+	groupUID := uuid.New().String()
+
+	suite.userRepoMock.EXPECT().GetUserById(userUID, ctx).Return(&dtos.UserResponseSingle{UID: userUID}, nil)
+	suite.groupRepoMock.EXPECT().GetGroupById(groupUID, ctx).
+		Return(&dtos.GroupResponse{UID: groupUID, Name: "Engineering", Type: models.GroupTypeDynamic}, nil)
+
+	err, msg := suite.userUsecase.AddUserToGroup(dtos.AddUserToGroupRequest{
+		UserUID:  userUID,
+		GroupIds: []string{groupUID},
+	}, ctx)
+	suite.Empty(msg)
+	suite.Equal(http.StatusConflict, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestAddUserRole_RoleNotFound() {
+	ctx := &gin.Context{}
+	UserUID := uuid.New().String()
+	RoleUID := uuid.New().String()
+
+	suite.roleRepoMock.EXPECT().GetRoleById(RoleUID, ctx).Return(nil, models.NotFound("Role not found"))
+
+	roles, err := suite.userUsecase.AddUserRole(UserUID, dtos.UserRoleAssignRequest{RoleId: RoleUID}, ctx)
+	suite.Nil(roles)
+	suite.Equal(http.StatusNotFound, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestAddUserRole_ExpiryInThePast() {
+	ctx := &gin.Context{}
+	expiresAt := time.Now().Add(-time.Minute)
+
+	roles, err := suite.userUsecase.AddUserRole(uuid.New().String(), dtos.UserRoleAssignRequest{
+		RoleId:    uuid.New().String(),
+		ExpiresAt: &expiresAt,
+	}, ctx)
+	suite.Nil(roles)
+	suite.Equal(http.StatusBadRequest, err.Code)
+}
+
+func (suite *UserUsecaseTestSuite) TestAddUserRole_ReturnsAllRoles() {
+	ctx := &gin.Context{}
+	UserUID := uuid.New().String()
+	RoleUID := uuid.New().String()
+	roles := []dtos.UserRoleResponse{
+		{UID: uuid.New().String(), Name: "Viewer", Primary: true},
+		{UID: RoleUID, Name: "On-call"},
+	}
+
+	suite.roleRepoMock.EXPECT().GetRoleById(RoleUID, ctx).Return(&dtos.RoleResponse{UID: RoleUID}, nil)
+	suite.userRepoMock.EXPECT().AddUserRole(UserUID, dtos.UserRoleAssignRequest{RoleId: RoleUID}, ctx).Return(nil)
+	suite.userRepoMock.EXPECT().GetUserRoles(UserUID, ctx).Return(roles, nil)
+
+	result, err := suite.userUsecase.AddUserRole(UserUID, dtos.UserRoleAssignRequest{RoleId: RoleUID}, ctx)
+	suite.Nil(err)
+	suite.Equal(roles, result)
+}
+
+func (suite *UserUsecaseTestSuite) TestUpdateUser_ClearsPrimaryRole() {
+	ctx := &gin.Context{}
+	UserUID := uuid.New().String()
+	noRole := ""
+
+	suite.userRepoMock.EXPECT().
+		GetUserById(UserUID, ctx).
+		Return(&dtos.UserResponseSingle{UID: UserUID, Status: "active"}, nil)
+	suite.userRepoMock.EXPECT().
+		UpdateUser(UserUID, gomock.Any(), ctx).
+		DoAndReturn(func(_ string, req *dtos.UserUpdateRequest, _ *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+			suite.Equal("", *req.RoleId)
+			return &dtos.UserResponseSingle{UID: UserUID, Status: "active", Roles: []dtos.UserRoleResponse{}}, nil
+		})
+
+	result, err := suite.userUsecase.UpdateUser(UserUID, dtos.UserUpdateRequest{RoleId: &noRole}, ctx)
+	suite.Nil(err)
+	suite.Empty(result.Roles)
+}
+
 func TestUserUsecaseTestSuite(t *testing.T) {
 	suite.Run(t, new(UserUsecaseTestSuite))
 }
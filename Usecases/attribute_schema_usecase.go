@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+type attributeSchemaUseCase struct {
+	schemaRepo interfaces.AttributeSchemaRepository
+	validator  interfaces.AttributeValidator
+}
+
+func NewAttributeSchemaUseCase(schemaRepo interfaces.AttributeSchemaRepository, validator interfaces.AttributeValidator) interfaces.AttributeSchemaUseCase {
+	return &attributeSchemaUseCase{
+		schemaRepo: schemaRepo,
+		validator:  validator,
+	}
+}
+
+func (uc *attributeSchemaUseCase) GetAttributeSchema(ctx *gin.Context) (*dtos.AttributeSchemaResponse, *models.ErrorResponse) {
+	schema, err := uc.schemaRepo.GetAttributeSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if schema == nil {
+		return nil, models.NotFound("No attribute schema defined")
+	}
+	return schema, nil
+}
+
+// SaveAttributeSchema replaces the tenant's schema. Existing users are not
+// revalidated; the schema applies to subsequent creates and updates.
+func (uc *attributeSchemaUseCase) SaveAttributeSchema(req dtos.AttributeSchemaRequest, ctx *gin.Context) (*dtos.AttributeSchemaResponse, *models.ErrorResponse) {
+	if err := uc.validator.CheckSchema(req.Schema); err != nil {
+		return nil, models.BadRequest("Invalid JSON Schema: " + err.Error())
+	}
+
+	return uc.schemaRepo.SaveAttributeSchema(req.Schema, ctx)
+}
+
+func (uc *attributeSchemaUseCase) DeleteAttributeSchema(ctx *gin.Context) *models.ErrorResponse {
+	return uc.schemaRepo.DeleteAttributeSchema(ctx)
+}
@@ -0,0 +1,126 @@
+package usecases
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/google/uuid"
+)
+
+var avatarContentTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+type avatarUseCase struct {
+	userRepo  interfaces.UserRepository
+	blobStore interfaces.BlobStore
+	maxBytes  int64
+}
+
+func NewAvatarUseCase(userRepo interfaces.UserRepository, blobStore interfaces.BlobStore, maxBytes int64) interfaces.AvatarUseCase {
+	return &avatarUseCase{
+		userRepo:  userRepo,
+		blobStore: blobStore,
+		maxBytes:  maxBytes,
+	}
+}
+
+func (uc *avatarUseCase) GetAvatar(userUID string, ctx *gin.Context) (io.ReadCloser, string, *models.ErrorResponse) {
+	avatar, err := uc.userRepo.GetUserAvatar(userUID, ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if avatar.Key == "" {
+		return nil, "", models.NotFound("User has no avatar")
+	}
+
+	content, openErr := uc.blobStore.Open(avatar.Key)
+	if errors.Is(openErr, fs.ErrNotExist) {
+		return nil, "", models.NotFound("User has no avatar")
+	}
+	if openErr != nil {
+		return nil, "", models.InternalServerError("Failed to read avatar: " + openErr.Error())
+	}
+
+	return content, avatar.ContentType, nil
+}
+
+// UploadAvatar stores the image under a new key on every upload, so a cached
+// copy of the previous avatar is never served under the new one. The type is
+// detected from the content rather than trusted from the request.
+func (uc *avatarUseCase) UploadAvatar(userUID string, content io.Reader, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+	if _, err := uuid.Parse(userUID); err != nil {
+		return nil, models.NotFound("User not found")
+	}
+	if _, err := uc.userRepo.GetUserAvatar(userUID, ctx); err != nil {
+		return nil, err
+	}
+
+	data, readErr := io.ReadAll(io.LimitReader(content, uc.maxBytes+1))
+	if readErr != nil {
+		return nil, models.BadRequest("Failed to read the avatar")
+	}
+	if len(data) == 0 {
+		return nil, models.BadRequest("The avatar is empty")
+	}
+	if int64(len(data)) > uc.maxBytes {
+		return nil, models.RequestEntityTooLarge("Avatars are limited to " + strconv.FormatInt(uc.maxBytes, 10) + " bytes")
+	}
+
+	contentType := http.DetectContentType(data)
+	if !avatarContentTypes[contentType] {
+		return nil, models.UnsupportedMediaType("Avatars must be PNG, JPEG, GIF or WebP images")
+	}
+
+	avatar := &dtos.UserAvatar{
+		Key:         tenantName(ctx) + "/avatars/" + userUID + "/" + uuid.New().String(),
+		ContentType: contentType,
+	}
+	if err := uc.blobStore.Put(avatar.Key, bytes.NewReader(data)); err != nil {
+		return nil, models.InternalServerError("Failed to store avatar: " + err.Error())
+	}
+
+	previous, err := uc.userRepo.SetUserAvatar(userUID, avatar, ctx)
+	if err != nil {
+		uc.removeBlob(avatar.Key)
+		return nil, err
+	}
+	uc.removeBlob(previous.Key)
+
+	return uc.userRepo.GetUserById(userUID, ctx)
+}
+
+func (uc *avatarUseCase) DeleteAvatar(userUID string, ctx *gin.Context) *models.ErrorResponse {
+	previous, err := uc.userRepo.SetUserAvatar(userUID, nil, ctx)
+	if err != nil {
+		return err
+	}
+	if previous.Key == "" {
+		return models.NotFound("User has no avatar")
+	}
+
+	uc.removeBlob(previous.Key)
+	return nil
+}
+
+// removeBlob only logs failures; an orphaned blob is harmless.
+func (uc *avatarUseCase) removeBlob(key string) {
+	if key == "" {
+		return
+	}
+	if err := uc.blobStore.Delete(key); err != nil {
+		log.Printf("failed to delete avatar blob %s: %v", key, err)
+	}
+}
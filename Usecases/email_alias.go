@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+func (uc *userUseCase) GetEmailAliases(id string, ctx *gin.Context) ([]*dtos.EmailAliasResponse, *models.ErrorResponse) {
+	return uc.userRepo.GetEmailAliases(id, ctx)
+}
+
+// AddEmailAlias stores an unverified alias and emails it a verification link.
+// The alias resolves to the user once the link is opened.
+func (uc *userUseCase) AddEmailAlias(id string, req dtos.EmailAliasCreateRequest, ctx *gin.Context) (*dtos.EmailAliasResponse, *models.ErrorResponse) {
+	user, err := uc.userRepo.GetUserById(id, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.ValidateEmail(req.Email); err != nil {
+		return nil, err
+	}
+
+	taken, err := uc.userRepo.IsEmailTaken(req.Email, "", ctx)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, models.Conflict("Email is already in use")
+	}
+
+	alias, err := uc.userRepo.CreateEmailAlias(user.UID, req.Email, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.sendVerification(user.UID, user.Name, alias.Email, ctx)
+
+	return alias, nil
+}
+
+func (uc *userUseCase) SendEmailAliasVerification(id string, aliasID string, ctx *gin.Context) *models.ErrorResponse {
+	user, err := uc.userRepo.GetUserById(id, ctx)
+	if err != nil {
+		return err
+	}
+
+	alias, err := uc.userRepo.GetEmailAlias(user.UID, aliasID, ctx)
+	if err != nil {
+		return err
+	}
+
+	if alias.Verified {
+		return models.Conflict("Email alias is already verified")
+	}
+
+	if err := uc.emailService.SendVerificationEmail(tenantName(ctx), user.UID, user.Name, alias.Email); err != nil {
+		return models.InternalServerError("Failed to send verification email")
+	}
+
+	return nil
+}
+
+func (uc *userUseCase) DeleteEmailAlias(id string, aliasID string, ctx *gin.Context) *models.ErrorResponse {
+	return uc.userRepo.DeleteEmailAlias(id, aliasID, ctx)
+}
@@ -0,0 +1,90 @@
+package usecases
+
+import (
+	"log"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+type expiryUseCase struct {
+	userRepo  interfaces.UserRepository
+	publisher interfaces.EventPublisher
+}
+
+func NewExpiryUseCase(userRepo interfaces.UserRepository, publisher interfaces.EventPublisher) interfaces.ExpiryUseCase {
+	return &expiryUseCase{
+		userRepo:  userRepo,
+		publisher: publisher,
+	}
+}
+
+// ExpireRoleAssignments removes expired role assignments batch by batch and
+// publishes an event for each one. The assignments are gone even when an event
+// cannot be published, so a failed publish is only logged.
+func (uc *expiryUseCase) ExpireRoleAssignments(ctx *gin.Context) *models.ErrorResponse {
+	for {
+		expired, err := uc.userRepo.ExpireRoleAssignments(time.Now(), ctx)
+		if err != nil {
+			return err
+		}
+		if len(expired) == 0 {
+			return nil
+		}
+
+		for _, assignment := range expired {
+			event := models.Event{
+				ID:         uuid.New(),
+				Type:       models.EventRoleAssignmentExpired,
+				Tenant:     tenantName(ctx),
+				OccurredAt: time.Now(),
+				Data: map[string]any{
+					"user_id":    assignment.UserUID,
+					"role_id":    assignment.RoleUID,
+					"role_name":  assignment.RoleName,
+					"primary":    assignment.Primary,
+					"expires_at": assignment.ExpiresAt,
+				},
+			}
+			if err := uc.publisher.Publish(event); err != nil {
+				log.Printf("Failed to publish %s for user %s: %v", event.Type, assignment.UserUID, err)
+			}
+		}
+	}
+}
+
+// ExpireGroupMemberships removes expired group memberships batch by batch and
+// publishes an event for each one.
+func (uc *expiryUseCase) ExpireGroupMemberships(ctx *gin.Context) *models.ErrorResponse {
+	for {
+		expired, err := uc.userRepo.ExpireGroupMemberships(time.Now(), ctx)
+		if err != nil {
+			return err
+		}
+		if len(expired) == 0 {
+			return nil
+		}
+
+		for _, membership := range expired {
+			event := models.Event{
+				ID:         uuid.New(),
+				Type:       models.EventGroupMembershipExpired,
+				Tenant:     tenantName(ctx),
+				OccurredAt: time.Now(),
+				Data: map[string]any{
+					"user_id":     membership.UserUID,
+					"group_id":    membership.GroupUID,
+					"group_name":  membership.GroupName,
+					"valid_until": membership.ValidUntil,
+				},
+			}
+			if err := uc.publisher.Publish(event); err != nil {
+				log.Printf("Failed to publish %s for user %s: %v", event.Type, membership.UserUID, err)
+			}
+		}
+	}
+}
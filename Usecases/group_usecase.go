@@ -1,10 +1,15 @@
 package usecases
 
 import (
+	"bytes"
+	"encoding/json"
+	"time"
+
 	"github.com/gin-gonic/gin"
 	dtos "github.com/google-run-code/Domain/Dtos"
 	interfaces "github.com/google-run-code/Domain/Interfaces"
 	models "github.com/google-run-code/Domain/Models"
+	"github.com/google/uuid"
 )
 
 type groupUseCase struct {
@@ -33,21 +38,52 @@ func (uc *groupUseCase) GetGroupById(id string, ctx *gin.Context) (*dtos.GroupRe
 	return uc.checkGroupExists(id, ctx)
 }
 
-func (uc *groupUseCase) GetGroupUsers(id string, ctx *gin.Context) ([]dtos.UserResponse, *models.ErrorResponse) {
+func (uc *groupUseCase) GetGroupUsers(id string, filter dtos.MembershipFilter, ctx *gin.Context) ([]dtos.UserResponse, *models.ErrorResponse) {
 	_, err := uc.checkGroupExists(id, ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	users, err := uc.groupRepo.GetGroupUsers(id, ctx)
+	var users []dtos.UserResponse
+	if filter.Transitive {
+		users, err = uc.groupRepo.GetGroupUsersTransitive(id, ctx)
+	} else {
+		users, err = uc.groupRepo.GetGroupUsers(id, ctx)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	if filter.Effective {
+		var effective []dtos.UserResponse
+		for _, user := range users {
+			if models.UserStatus(user.Status).GrantsAccess() {
+				effective = append(effective, user)
+			}
+		}
+		return effective, nil
+	}
+
 	return users, nil
 }
 
 func (uc *groupUseCase) CreateGroup(group dtos.GroupCreateRequest, ctx *gin.Context) (*dtos.GroupResponse, *models.ErrorResponse) {
+	for _, ownerId := range group.OwnerIds {
+		if _, err := uuid.Parse(ownerId); err != nil {
+			return nil, models.BadRequest("Invalid owner id: " + ownerId)
+		}
+	}
+	if len(group.Rule) > 0 && string(group.Rule) != "null" {
+		rule, err := parseGroupRule(group.Rule)
+		if err != nil {
+			return nil, err
+		}
+		if group.Rule, err = normalizeGroupRule(rule); err != nil {
+			return nil, err
+		}
+	} else {
+		group.Rule = nil
+	}
 	if group, err := uc.groupRepo.GetGroupByName(group.Name, ctx); err == nil && group != nil {
 		return nil, models.BadRequest("Group with the given name already exists")
 	}
@@ -58,9 +94,32 @@ func (uc *groupUseCase) UpdateGroup(id string, group dtos.GroupUpdateRequest, ct
 	if _, err := uc.checkGroupExists(id, ctx); err != nil {
 		return nil, err
 	}
+	if group.Rule != nil && string(group.Rule) != "null" {
+		rule, err := parseGroupRule(group.Rule)
+		if err != nil {
+			return nil, err
+		}
+		if group.Rule, err = normalizeGroupRule(rule); err != nil {
+			return nil, err
+		}
+	}
 	return uc.groupRepo.UpdateGroup(id, group, ctx)
 }
 
+func (uc *groupUseCase) PreviewGroupRule(req dtos.GroupRulePreviewRequest, ctx *gin.Context) (*dtos.GroupRulePreview, *models.ErrorResponse) {
+	rule, err := parseGroupRule(req.Rule)
+	if err != nil {
+		return nil, err
+	}
+	return uc.groupRepo.PreviewGroupRule(rule, ctx)
+}
+
+// EvaluateDynamicGroups brings every dynamic group up to date with the roles
+// and memberships that started or ended since it was last evaluated.
+func (uc *groupUseCase) EvaluateDynamicGroups(ctx *gin.Context) *models.ErrorResponse {
+	return uc.groupRepo.EvaluateDynamicGroups(ctx)
+}
+
 func (uc *groupUseCase) DeleteGroup(id string, ctx *gin.Context) *models.ErrorResponse {
 	if _, err := uc.checkGroupExists(id, ctx); err != nil {
 		return err
@@ -68,3 +127,193 @@ func (uc *groupUseCase) DeleteGroup(id string, ctx *gin.Context) *models.ErrorRe
 
 	return uc.groupRepo.DeleteGroup(id, ctx)
 }
+
+func (uc *groupUseCase) RestoreGroup(id string, ctx *gin.Context) (*dtos.GroupResponse, *models.ErrorResponse) {
+	return uc.groupRepo.RestoreGroup(id, ctx)
+}
+
+func (uc *groupUseCase) GetGroupOwners(id string, ctx *gin.Context) ([]dtos.UserResponse, *models.ErrorResponse) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, models.NotFound("Group not found")
+	}
+	return uc.groupRepo.GetGroupOwners(id, ctx)
+}
+
+func (uc *groupUseCase) AddGroupOwner(id string, req dtos.GroupOwnerRequest, ctx *gin.Context) ([]dtos.UserResponse, *models.ErrorResponse) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, models.NotFound("Group not found")
+	}
+	if _, err := uuid.Parse(req.UserId); err != nil {
+		return nil, models.NotFound("User not found")
+	}
+	if err := uc.groupRepo.AddGroupOwner(id, req.UserId, ctx); err != nil {
+		return nil, err
+	}
+	return uc.groupRepo.GetGroupOwners(id, ctx)
+}
+
+func (uc *groupUseCase) RemoveGroupOwner(id string, userId string, ctx *gin.Context) *models.ErrorResponse {
+	if _, err := uuid.Parse(id); err != nil {
+		return models.NotFound("Group not found")
+	}
+	if _, err := uuid.Parse(userId); err != nil {
+		return models.NotFound("User not found")
+	}
+	return uc.groupRepo.RemoveGroupOwner(id, userId, ctx)
+}
+
+func (uc *groupUseCase) GetGroupRoles(id string, ctx *gin.Context) ([]dtos.RoleResponse, *models.ErrorResponse) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, models.NotFound("Group not found")
+	}
+	return uc.groupRepo.GetGroupRoles(id, ctx)
+}
+
+func (uc *groupUseCase) AddGroupRole(id string, req dtos.GroupRoleRequest, ctx *gin.Context) ([]dtos.RoleResponse, *models.ErrorResponse) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, models.NotFound("Group not found")
+	}
+	if _, err := uuid.Parse(req.RoleId); err != nil {
+		return nil, models.NotFound("Role not found")
+	}
+	if err := uc.groupRepo.AddGroupRole(id, req.RoleId, ctx); err != nil {
+		return nil, err
+	}
+	return uc.groupRepo.GetGroupRoles(id, ctx)
+}
+
+func (uc *groupUseCase) RemoveGroupRole(id string, roleId string, ctx *gin.Context) *models.ErrorResponse {
+	if _, err := uuid.Parse(id); err != nil {
+		return models.NotFound("Group not found")
+	}
+	if _, err := uuid.Parse(roleId); err != nil {
+		return models.NotFound("Role not found")
+	}
+	return uc.groupRepo.RemoveGroupRole(id, roleId, ctx)
+}
+
+// AddGroupMember puts one user in the group. With a validity period, the
+// period of an existing membership is updated.
+func (uc *groupUseCase) AddGroupMember(id string, userId string, req dtos.GroupMemberRequest, ctx *gin.Context) *models.ErrorResponse {
+	if err := uc.authorizeMembers(id, []string{userId}, ctx); err != nil {
+		return err
+	}
+	if err := validateMembershipPeriod(req.ValidFrom, req.ValidUntil); err != nil {
+		return err
+	}
+	return uc.groupRepo.AddGroupMember(id, userId, req, ctx)
+}
+
+func (uc *groupUseCase) RemoveGroupMember(id string, userId string, ctx *gin.Context) *models.ErrorResponse {
+	if err := uc.authorizeMembers(id, []string{userId}, ctx); err != nil {
+		return err
+	}
+	return uc.groupRepo.RemoveGroupMember(id, userId, ctx)
+}
+
+func (uc *groupUseCase) SetGroupMembers(id string, req dtos.GroupMembersRequest, ctx *gin.Context) (*dtos.GroupMembersDiff, *models.ErrorResponse) {
+	if err := uc.authorizeMembers(id, req.UserIds, ctx); err != nil {
+		return nil, err
+	}
+	if err := validateMembershipPeriod(req.ValidFrom, req.ValidUntil); err != nil {
+		return nil, err
+	}
+	return uc.groupRepo.SetGroupMembers(id, req, ctx)
+}
+
+func (uc *groupUseCase) AddGroupMembers(id string, req dtos.GroupMembersRequest, ctx *gin.Context) (*dtos.GroupMembersDiff, *models.ErrorResponse) {
+	if err := uc.authorizeMembers(id, req.UserIds, ctx); err != nil {
+		return nil, err
+	}
+	if err := validateMembershipPeriod(req.ValidFrom, req.ValidUntil); err != nil {
+		return nil, err
+	}
+	return uc.groupRepo.AddGroupMembers(id, req, ctx)
+}
+
+func (uc *groupUseCase) RemoveGroupMembers(id string, req dtos.GroupMembersRequest, ctx *gin.Context) (*dtos.GroupMembersDiff, *models.ErrorResponse) {
+	if err := uc.authorizeMembers(id, req.UserIds, ctx); err != nil {
+		return nil, err
+	}
+	return uc.groupRepo.RemoveGroupMembers(id, req, ctx)
+}
+
+// authorizeMembers lets tokens that are not bound to a user change the members
+// of any group, and tokens bound to a user only those of groups the user owns
+// and that do not grant roles, directly or through a group they are nested in.
+func (uc *groupUseCase) authorizeMembers(id string, userIds []string, ctx *gin.Context) *models.ErrorResponse {
+	if _, err := uuid.Parse(id); err != nil {
+		return models.NotFound("Group not found")
+	}
+	for _, userId := range userIds {
+		if _, err := uuid.Parse(userId); err != nil {
+			return models.NotFound("User not found: " + userId)
+		}
+	}
+
+	value, exists := ctx.Get(models.ActorContextKey)
+	if !exists {
+		return nil
+	}
+	actor, ok := value.(*models.Actor)
+	if !ok {
+		return models.Forbidden("Only owners of the group can change its members")
+	}
+
+	owner, err := uc.groupRepo.IsGroupOwner(id, *actor, ctx)
+	if err != nil {
+		return err
+	}
+	if !owner {
+		return models.Forbidden("Only owners of the group can change its members")
+	}
+
+	// Membership would hand out roles, which owners cannot assign
+	grants, err := uc.groupRepo.GroupGrantsRoles(id, ctx)
+	if err != nil {
+		return err
+	}
+	if grants {
+		return models.Forbidden("Owners cannot change the members of a group that grants roles")
+	}
+	return nil
+}
+
+// validateMembershipPeriod checks the validity period of a group membership.
+func validateMembershipPeriod(validFrom *time.Time, validUntil *time.Time) *models.ErrorResponse {
+	if validUntil == nil {
+		return nil
+	}
+	if !validUntil.After(time.Now()) {
+		return models.BadRequest("valid_until must be in the future")
+	}
+	if validFrom != nil && !validUntil.After(*validFrom) {
+		return models.BadRequest("valid_until must be after valid_from")
+	}
+	return nil
+}
+
+// parseGroupRule decodes and validates a rule, rejecting unknown keys so a
+// misspelled field is not silently ignored.
+func parseGroupRule(raw json.RawMessage) (*models.GroupRule, *models.ErrorResponse) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+
+	var rule models.GroupRule
+	if err := decoder.Decode(&rule); err != nil {
+		return nil, models.BadRequest("Invalid group rule: " + err.Error())
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, models.BadRequest("Invalid group rule: " + err.Error())
+	}
+	return &rule, nil
+}
+
+// normalizeGroupRule stores a rule the way it was validated.
+func normalizeGroupRule(rule *models.GroupRule) (json.RawMessage, *models.ErrorResponse) {
+	raw, err := json.Marshal(rule)
+	if err != nil {
+		return nil, models.InternalServerError(err.Error())
+	}
+	return raw, nil
+}
@@ -0,0 +1,171 @@
+package usecases
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+type invitationUseCase struct {
+	invitationRepo interfaces.InvitationRepository
+	userUseCase    interfaces.UserUseCase
+	emailService   interfaces.EmailService
+	ttl            time.Duration
+}
+
+func NewInvitationUseCase(
+	invitationRepo interfaces.InvitationRepository,
+	userUseCase interfaces.UserUseCase,
+	emailService interfaces.EmailService,
+	ttl time.Duration,
+) interfaces.InvitationUseCase {
+	return &invitationUseCase{
+		invitationRepo: invitationRepo,
+		userUseCase:    userUseCase,
+		emailService:   emailService,
+		ttl:            ttl,
+	}
+}
+
+func toInvitationResponse(invitation *models.Invitation, now time.Time) *dtos.InvitationResponse {
+	return &dtos.InvitationResponse{
+		UID:        invitation.UID.String(),
+		UserUID:    invitation.User.UID.String(),
+		Email:      invitation.Email,
+		Status:     string(invitation.Status(now)),
+		ExpiresAt:  invitation.ExpiresAt,
+		AcceptedAt: invitation.AcceptedAt,
+		RevokedAt:  invitation.RevokedAt,
+		CreatedAt:  invitation.CreatedAt,
+	}
+}
+
+func (uc *invitationUseCase) GetInvitations(status string, ctx *gin.Context) ([]*dtos.InvitationResponse, *models.ErrorResponse) {
+	switch models.InvitationStatus(status) {
+	case "", models.InvitationPending, models.InvitationAccepted, models.InvitationRevoked, models.InvitationExpired:
+	default:
+		return nil, models.BadRequest("Invalid status, expected one of: pending, accepted, revoked, expired")
+	}
+
+	invitations, err := uc.invitationRepo.GetInvitations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var result []*dtos.InvitationResponse
+	for _, invitation := range invitations {
+		if status != "" && string(invitation.Status(now)) != status {
+			continue
+		}
+		result = append(result, toInvitationResponse(invitation, now))
+	}
+
+	return result, nil
+}
+
+// CreateInvitation stores the invited user, their groups and the invitation
+// together, so a failure leaves nothing behind. The invitation stands even
+// when its email cannot be sent, and the response then says to resend it.
+func (uc *invitationUseCase) CreateInvitation(req dtos.InvitationCreateRequest, ctx *gin.Context) (*dtos.InvitationResponse, *models.ErrorResponse) {
+	user := dtos.UserCreateRequest{
+		Name:       req.Name,
+		Email:      req.Email,
+		Status:     string(models.UserStatusInvited),
+		RoleId:     req.RoleId,
+		Attributes: req.Attributes,
+	}
+	if err := uc.userUseCase.ValidateNewUser(&user, ctx); err != nil {
+		return nil, err
+	}
+
+	token, tokenHash, tErr := newOpaqueToken()
+	if tErr != nil {
+		return nil, models.InternalServerError("Failed to generate invitation token")
+	}
+
+	invitation, err := uc.invitationRepo.CreateInvitation(user, req.GroupIds, tokenHash, time.Now().Add(uc.ttl), ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := toInvitationResponse(invitation, time.Now())
+	if err := uc.send(invitation, token, ctx); err != nil {
+		res.Warning = "The invitation email could not be sent, resend the invitation to try again"
+	}
+	return res, nil
+}
+
+func (uc *invitationUseCase) send(invitation *models.Invitation, token string, ctx *gin.Context) *models.ErrorResponse {
+	if err := uc.emailService.SendInvitationEmail(tenantName(ctx), invitation.User.Name, invitation.Email, token, invitation.ExpiresAt); err != nil {
+		return models.InternalServerError("Failed to send invitation email, it can be resent later")
+	}
+	return nil
+}
+
+func (uc *invitationUseCase) ResendInvitation(id string, ctx *gin.Context) (*dtos.InvitationResponse, *models.ErrorResponse) {
+	invitation, err := uc.invitationRepo.GetInvitationById(id, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch invitation.Status(time.Now()) {
+	case models.InvitationAccepted, models.InvitationRevoked:
+		return nil, models.Conflict("Invitation is no longer pending")
+	}
+
+	token, tokenHash, tErr := newOpaqueToken()
+	if tErr != nil {
+		return nil, models.InternalServerError("Failed to generate invitation token")
+	}
+
+	invitation, err = uc.invitationRepo.RenewInvitation(id, tokenHash, time.Now().Add(uc.ttl), ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.send(invitation, token, ctx); err != nil {
+		return nil, err
+	}
+
+	return toInvitationResponse(invitation, time.Now()), nil
+}
+
+func (uc *invitationUseCase) RevokeInvitation(id string, ctx *gin.Context) *models.ErrorResponse {
+	return uc.invitationRepo.RevokeInvitation(id, ctx)
+}
+
+func (uc *invitationUseCase) AcceptInvitation(req dtos.InvitationAcceptRequest, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+	invitation, err := uc.invitationRepo.GetInvitationByTokenHash(hashToken(req.Token), ctx)
+	if err != nil {
+		if err.Code == http.StatusNotFound {
+			return nil, models.NotFound("Invalid invitation token")
+		}
+		return nil, err
+	}
+
+	switch invitation.Status(time.Now()) {
+	case models.InvitationAccepted:
+		return nil, models.Conflict("Invitation has already been accepted")
+	case models.InvitationRevoked:
+		return nil, models.Gone("Invitation has been revoked")
+	case models.InvitationExpired:
+		return nil, models.Gone("Invitation has expired")
+	}
+
+	if req.Attributes != nil {
+		if err := uc.userUseCase.ValidateAttributes(req.Attributes, ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.invitationRepo.AcceptInvitation(invitation.UID.String(), req.Name, req.Attributes, ctx); err != nil {
+		return nil, err
+	}
+
+	return uc.userUseCase.GetUserById(invitation.User.UID.String(), ctx)
+}
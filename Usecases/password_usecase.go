@@ -0,0 +1,180 @@
+package usecases
+
+import (
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// resetRateWindow is the period over which resetLimit applies.
+const resetRateWindow = time.Hour
+
+const minPasswordPolicyLength = 8
+
+type passwordUseCase struct {
+	passwordRepo interfaces.PasswordRepository
+	userRepo     interfaces.UserRepository
+	emailService interfaces.EmailService
+	hasher       interfaces.PasswordHasher
+	resetTTL     time.Duration
+	resetLimit   int
+}
+
+func NewPasswordUseCase(
+	passwordRepo interfaces.PasswordRepository,
+	userRepo interfaces.UserRepository,
+	emailService interfaces.EmailService,
+	hasher interfaces.PasswordHasher,
+	resetTTL time.Duration,
+	resetLimit int,
+) interfaces.PasswordUseCase {
+	return &passwordUseCase{
+		passwordRepo: passwordRepo,
+		userRepo:     userRepo,
+		emailService: emailService,
+		hasher:       hasher,
+		resetTTL:     resetTTL,
+		resetLimit:   resetLimit,
+	}
+}
+
+func toPasswordPolicyResponse(policy models.PasswordPolicy) *dtos.PasswordPolicyResponse {
+	res := &dtos.PasswordPolicyResponse{
+		MinLength:        policy.MinLength,
+		RequireUppercase: policy.RequireUppercase,
+		RequireLowercase: policy.RequireLowercase,
+		RequireDigit:     policy.RequireDigit,
+		RequireSymbol:    policy.RequireSymbol,
+	}
+	if !policy.UpdatedAt.IsZero() {
+		res.UpdatedAt = &policy.UpdatedAt
+	}
+	return res
+}
+
+func (uc *passwordUseCase) policy(ctx *gin.Context) (models.PasswordPolicy, *models.ErrorResponse) {
+	policy, err := uc.passwordRepo.GetPasswordPolicy(ctx)
+	if err != nil {
+		return models.PasswordPolicy{}, err
+	}
+	if policy == nil {
+		return models.DefaultPasswordPolicy(), nil
+	}
+	return *policy, nil
+}
+
+func (uc *passwordUseCase) GetPasswordPolicy(ctx *gin.Context) (*dtos.PasswordPolicyResponse, *models.ErrorResponse) {
+	policy, err := uc.policy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toPasswordPolicyResponse(policy), nil
+}
+
+func (uc *passwordUseCase) SavePasswordPolicy(req dtos.PasswordPolicyRequest, ctx *gin.Context) (*dtos.PasswordPolicyResponse, *models.ErrorResponse) {
+	if req.MinLength < minPasswordPolicyLength {
+		return nil, models.BadRequest("min_length must be at least 8")
+	}
+
+	policy, err := uc.passwordRepo.SavePasswordPolicy(models.PasswordPolicy{
+		MinLength:        req.MinLength,
+		RequireUppercase: req.RequireUppercase,
+		RequireLowercase: req.RequireLowercase,
+		RequireDigit:     req.RequireDigit,
+		RequireSymbol:    req.RequireSymbol,
+	}, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPasswordPolicyResponse(*policy), nil
+}
+
+// canResetPassword excludes users who never completed an invitation and users
+// that were deprovisioned.
+func canResetPassword(status models.UserStatus) bool {
+	return status != models.UserStatusInvited && status != models.UserStatusDeprovisioned
+}
+
+// ForgotPassword behaves the same whether or not the address belongs to a user,
+// so it cannot be used to discover accounts. The link is issued in the
+// background, so a known address takes no longer to answer than an unknown one,
+// and requests over the per-address limit are dropped silently.
+func (uc *passwordUseCase) ForgotPassword(req dtos.ForgotPasswordRequest, ctx *gin.Context) *models.ErrorResponse {
+	user, err := uc.userRepo.GetUserByEmail(req.Email, ctx)
+	if err != nil {
+		if err.Code == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+
+	if !canResetPassword(user.Status) {
+		return nil
+	}
+
+	go uc.issuePasswordReset(user, tenantName(ctx), ctx.Copy())
+	return nil
+}
+
+// issuePasswordReset stores a reset token for the user and emails the link.
+// It runs after the request was answered, so failures are only logged.
+func (uc *passwordUseCase) issuePasswordReset(user *models.User, tenant string, ctx *gin.Context) {
+	token, tokenHash, err := newOpaqueToken()
+	if err != nil {
+		log.Printf("Failed to generate reset token for user %s: %v", user.UID, err)
+		return
+	}
+
+	expiresAt := time.Now().Add(uc.resetTTL)
+	created, errResp := uc.passwordRepo.CreatePasswordReset(user.ID, tokenHash, expiresAt, time.Now().Add(-resetRateWindow), uc.resetLimit, ctx)
+	if errResp != nil {
+		log.Printf("Failed to create password reset for user %s: %s", user.UID, errResp.Message)
+		return
+	}
+	if !created {
+		return
+	}
+
+	if err := uc.emailService.SendPasswordResetEmail(tenant, user.Name, user.Email, token, expiresAt); err != nil {
+		log.Printf("Failed to send password reset email to user %s: %v", user.UID, err)
+	}
+}
+
+func (uc *passwordUseCase) ResetPassword(req dtos.ResetPasswordRequest, ctx *gin.Context) *models.ErrorResponse {
+	tokenHash := hashToken(req.Token)
+
+	reset, err := uc.passwordRepo.GetPasswordReset(tokenHash, ctx)
+	if err != nil {
+		if err.Code == http.StatusNotFound {
+			return models.BadRequest("Invalid or expired reset token")
+		}
+		return err
+	}
+
+	if reset.UsedAt != nil || time.Now().After(reset.ExpiresAt) || !canResetPassword(reset.User.Status) {
+		return models.BadRequest("Invalid or expired reset token")
+	}
+
+	policy, err := uc.policy(ctx)
+	if err != nil {
+		return err
+	}
+
+	if violations := policy.Violations(req.Password); len(violations) > 0 {
+		return models.UnprocessableEntity("Password " + strings.Join(violations, ", "))
+	}
+
+	passwordHash, hErr := uc.hasher.Hash(req.Password)
+	if hErr != nil {
+		return models.InternalServerError("Failed to hash password")
+	}
+
+	return uc.passwordRepo.ResetPassword(tokenHash, passwordHash, ctx)
+}
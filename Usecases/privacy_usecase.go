@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"log"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+type privacyUseCase struct {
+	userRepo  interfaces.UserRepository
+	blobStore interfaces.BlobStore
+}
+
+func NewPrivacyUseCase(userRepo interfaces.UserRepository, blobStore interfaces.BlobStore) interfaces.PrivacyUseCase {
+	return &privacyUseCase{
+		userRepo:  userRepo,
+		blobStore: blobStore,
+	}
+}
+
+func (uc *privacyUseCase) ExportUser(userUID string, ctx *gin.Context) (*dtos.UserDataExport, *models.ErrorResponse) {
+	if _, err := uuid.Parse(userUID); err != nil {
+		return nil, models.NotFound("User not found")
+	}
+	return uc.userRepo.ExportUser(userUID, ctx)
+}
+
+// EraseUser removes the avatars only once the erasure is committed. Failing to
+// delete one is logged and does not undo the erasure.
+func (uc *privacyUseCase) EraseUser(userUID string, ctx *gin.Context) *models.ErrorResponse {
+	if _, err := uuid.Parse(userUID); err != nil {
+		return models.NotFound("User not found")
+	}
+
+	erased, err := uc.userRepo.EraseUser(userUID, ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range erased.AvatarKeys {
+		if err := uc.blobStore.Delete(key); err != nil {
+			log.Printf("failed to delete avatar blob %s of erased user %s: %v", key, userUID, err)
+		}
+	}
+	return nil
+}
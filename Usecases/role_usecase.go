@@ -75,11 +75,30 @@ func (uc *roleUseCase) DeleteRole(id string, ctx *gin.Context) *models.ErrorResp
 	return uc.roleRepository.DeleteRole(id, ctx)
 }
 
-func (uc *roleUseCase) GetRoleUsers(id string, ctx *gin.Context) ([]*dtos.UserResponse, *models.ErrorResponse) {
+func (uc *roleUseCase) GetRoleUsers(id string, filter dtos.MembershipFilter, ctx *gin.Context) ([]*dtos.RoleUserResponse, *models.ErrorResponse) {
 	role, err := uc.checkRoleExists(id, ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return uc.roleRepository.GetRoleUsers(role, ctx)
+	users, err := uc.roleRepository.GetRoleUsers(role, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.Effective {
+		var effective []*dtos.RoleUserResponse
+		for _, user := range users {
+			if user.Active && models.UserStatus(user.Status).GrantsAccess() {
+				effective = append(effective, user)
+			}
+		}
+		return effective, nil
+	}
+
+	return users, nil
+}
+
+func (uc *roleUseCase) RestoreRole(id string, ctx *gin.Context) (*dtos.RoleResponse, *models.ErrorResponse) {
+	return uc.roleRepository.RestoreRole(id, ctx)
 }
@@ -0,0 +1,277 @@
+package usecases
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// scheduledBatchSize caps the changes one run claims at a time.
+const scheduledBatchSize = 100
+
+type scheduledChangeUseCase struct {
+	changeRepo   interfaces.ScheduledChangeRepository
+	userUseCase  interfaces.UserUseCase
+	groupUseCase interfaces.GroupUseCase
+	roleUseCase  interfaces.RoleUseCase
+}
+
+func NewScheduledChangeUseCase(
+	changeRepo interfaces.ScheduledChangeRepository,
+	userUseCase interfaces.UserUseCase,
+	groupUseCase interfaces.GroupUseCase,
+	roleUseCase interfaces.RoleUseCase,
+) interfaces.ScheduledChangeUseCase {
+	return &scheduledChangeUseCase{
+		changeRepo:   changeRepo,
+		userUseCase:  userUseCase,
+		groupUseCase: groupUseCase,
+		roleUseCase:  roleUseCase,
+	}
+}
+
+func toScheduledChangeResponse(change *models.ScheduledChange) *dtos.ScheduledChangeResponse {
+	res := &dtos.ScheduledChangeResponse{
+		UID:         change.UID.String(),
+		Type:        string(change.Type),
+		TargetID:    change.TargetUID,
+		EffectiveAt: change.EffectiveAt,
+		Status:      string(change.Status),
+		Result:      change.Result,
+		FinishedAt:  change.FinishedAt,
+		CancelledAt: change.CancelledAt,
+		CreatedAt:   change.CreatedAt,
+	}
+	if string(change.Payload) != "{}" {
+		res.Payload = change.Payload
+	}
+	return res
+}
+
+func (uc *scheduledChangeUseCase) GetScheduledChanges(filter dtos.ScheduledChangeFilter, ctx *gin.Context) ([]*dtos.ScheduledChangeResponse, *models.ErrorResponse) {
+	changes, err := uc.changeRepo.GetScheduledChanges(filter, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*dtos.ScheduledChangeResponse
+	for _, change := range changes {
+		result = append(result, toScheduledChangeResponse(change))
+	}
+	return result, nil
+}
+
+func (uc *scheduledChangeUseCase) GetScheduledChangeById(id string, ctx *gin.Context) (*dtos.ScheduledChangeResponse, *models.ErrorResponse) {
+	change, err := uc.changeRepo.GetScheduledChangeById(id, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toScheduledChangeResponse(change), nil
+}
+
+// CreateScheduledChange checks the change as far as possible up front, so
+// mistakes are reported now rather than when it is applied.
+func (uc *scheduledChangeUseCase) CreateScheduledChange(req dtos.ScheduledChangeRequest, ctx *gin.Context) (*dtos.ScheduledChangeResponse, *models.ErrorResponse) {
+	if !req.EffectiveAt.After(time.Now()) {
+		return nil, models.BadRequest("effective_at must be in the future")
+	}
+
+	changeType := models.ScheduledChangeType(req.Type)
+	payload, err := scheduledPayload(changeType, req.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkTarget(changeType, req.TargetID, ctx); err != nil {
+		return nil, err
+	}
+
+	change := &models.ScheduledChange{
+		UID:         uuid.New(),
+		Type:        changeType,
+		TargetUID:   req.TargetID,
+		Payload:     payload,
+		EffectiveAt: req.EffectiveAt,
+		Status:      models.ScheduledChangePending,
+	}
+	if err := uc.changeRepo.CreateScheduledChange(change, ctx); err != nil {
+		return nil, err
+	}
+
+	return toScheduledChangeResponse(change), nil
+}
+
+func (uc *scheduledChangeUseCase) CancelScheduledChange(id string, ctx *gin.Context) (*dtos.ScheduledChangeResponse, *models.ErrorResponse) {
+	change, err := uc.changeRepo.CancelScheduledChange(id, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toScheduledChangeResponse(change), nil
+}
+
+// ApplyDueChanges applies every change that is due, in the order they take
+// effect, and records what each one answered. A change that fails is not
+// retried.
+func (uc *scheduledChangeUseCase) ApplyDueChanges(ctx *gin.Context) *models.ErrorResponse {
+	for {
+		changes, err := uc.changeRepo.ClaimDueChanges(time.Now(), scheduledBatchSize, ctx)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+
+		for _, change := range changes {
+			status := models.ScheduledChangeApplied
+			result, applyErr := uc.apply(change, ctx)
+			if applyErr != nil {
+				status = models.ScheduledChangeFailed
+				result = applyErr.Message
+			}
+
+			if err := uc.changeRepo.FinishScheduledChange(change.ID, status, result, ctx); err != nil {
+				log.Printf("Failed to record result of scheduled change %s: %s", change.UID, err.Message)
+			}
+		}
+	}
+}
+
+func (uc *scheduledChangeUseCase) apply(change *models.ScheduledChange, ctx *gin.Context) (string, *models.ErrorResponse) {
+	target := change.TargetUID
+
+	switch change.Type {
+	case models.ScheduledUserStatus:
+		var payload dtos.ScheduledStatusPayload
+		if err := json.Unmarshal(change.Payload, &payload); err != nil {
+			return "", models.BadRequest("Invalid payload: " + err.Error())
+		}
+		if _, err := uc.userUseCase.ChangeUserStatus(target, models.UserStatus(payload.Status), ctx); err != nil {
+			return "", err
+		}
+		return "User is now " + payload.Status, nil
+
+	case models.ScheduledUserRoleAdd:
+		var payload dtos.UserRoleAssignRequest
+		if err := json.Unmarshal(change.Payload, &payload); err != nil {
+			return "", models.BadRequest("Invalid payload: " + err.Error())
+		}
+		if _, err := uc.userUseCase.AddUserRole(target, payload, ctx); err != nil {
+			return "", err
+		}
+		return "Role assigned to user", nil
+
+	case models.ScheduledUserRoleRemove:
+		var payload dtos.ScheduledRolePayload
+		if err := json.Unmarshal(change.Payload, &payload); err != nil {
+			return "", models.BadRequest("Invalid payload: " + err.Error())
+		}
+		if err := uc.userUseCase.RemoveUserRole(target, payload.RoleId, ctx); err != nil {
+			return "", err
+		}
+		return "Role removed from user", nil
+
+	case models.ScheduledUserGroupsAdd:
+		var payload dtos.AddUserToGroupRequest
+		if err := json.Unmarshal(change.Payload, &payload); err != nil {
+			return "", models.BadRequest("Invalid payload: " + err.Error())
+		}
+		payload.UserUID = target
+		err, message := uc.userUseCase.AddUserToGroup(payload, ctx)
+		if err != nil {
+			return "", err
+		}
+		return message, nil
+
+	case models.ScheduledUserGroupsRemove:
+		var payload dtos.RemoveUserFromGroupRequest
+		if err := json.Unmarshal(change.Payload, &payload); err != nil {
+			return "", models.BadRequest("Invalid payload: " + err.Error())
+		}
+		payload.UserUID = target
+		return uc.userUseCase.RemoveUserFromGroup(payload, ctx)
+
+	case models.ScheduledUserDelete:
+		if err := uc.userUseCase.DeleteUser(target, ctx); err != nil {
+			return "", err
+		}
+		return "User deleted", nil
+
+	case models.ScheduledGroupDelete:
+		if err := uc.groupUseCase.DeleteGroup(target, ctx); err != nil {
+			return "", err
+		}
+		return "Group deleted", nil
+
+	case models.ScheduledRoleDelete:
+		if err := uc.roleUseCase.DeleteRole(target, ctx); err != nil {
+			return "", err
+		}
+		return "Role deleted", nil
+	}
+
+	return "", models.BadRequest("Unknown change type: " + string(change.Type))
+}
+
+func (uc *scheduledChangeUseCase) checkTarget(changeType models.ScheduledChangeType, target string, ctx *gin.Context) *models.ErrorResponse {
+	var err *models.ErrorResponse
+	switch changeType {
+	case models.ScheduledGroupDelete:
+		_, err = uc.groupUseCase.GetGroupById(target, ctx)
+	case models.ScheduledRoleDelete:
+		_, err = uc.roleUseCase.GetRoleById(target, ctx)
+	default:
+		_, err = uc.userUseCase.GetUserById(target, ctx)
+	}
+	return err
+}
+
+// scheduledPayload checks the payload against the request the change type is
+// applied with and returns it compacted for storage.
+func scheduledPayload(changeType models.ScheduledChangeType, raw json.RawMessage) (json.RawMessage, *models.ErrorResponse) {
+	var payload any
+	switch changeType {
+	case models.ScheduledUserStatus:
+		payload = &dtos.ScheduledStatusPayload{}
+	case models.ScheduledUserRoleAdd:
+		payload = &dtos.UserRoleAssignRequest{}
+	case models.ScheduledUserRoleRemove:
+		payload = &dtos.ScheduledRolePayload{}
+	case models.ScheduledUserGroupsAdd:
+		payload = &dtos.AddUserToGroupRequest{}
+	case models.ScheduledUserGroupsRemove:
+		payload = &dtos.RemoveUserFromGroupRequest{}
+	case models.ScheduledUserDelete, models.ScheduledGroupDelete, models.ScheduledRoleDelete:
+		return json.RawMessage("{}"), nil
+	default:
+		return nil, models.BadRequest("Unknown change type: " + string(changeType))
+	}
+
+	if len(raw) == 0 {
+		return nil, models.BadRequest("payload is required for " + string(changeType))
+	}
+	if err := json.Unmarshal(raw, payload); err != nil {
+		return nil, models.BadRequest("Invalid payload: " + err.Error())
+	}
+	if err := binding.Validator.ValidateStruct(payload); err != nil {
+		return nil, models.BadRequest("payload is missing required fields")
+	}
+	if status, ok := payload.(*dtos.ScheduledStatusPayload); ok && !models.UserStatus(status.Status).IsValid() {
+		return nil, models.BadRequest("Invalid status: " + status.Status)
+	}
+
+	var stored bytes.Buffer
+	if err := json.Compact(&stored, raw); err != nil {
+		return nil, models.BadRequest("Invalid payload: " + err.Error())
+	}
+	return stored.Bytes(), nil
+}
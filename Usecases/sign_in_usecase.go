@@ -0,0 +1,105 @@
+package usecases
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+type signInUseCase struct {
+	signInRepo interfaces.SignInRepository
+	userRepo   interfaces.UserRepository
+	hasher     interfaces.PasswordHasher
+	tracker    interfaces.ActivityTracker
+	jwtService interfaces.JwtService
+}
+
+func NewSignInUseCase(
+	signInRepo interfaces.SignInRepository,
+	userRepo interfaces.UserRepository,
+	hasher interfaces.PasswordHasher,
+	tracker interfaces.ActivityTracker,
+	jwtService interfaces.JwtService,
+) interfaces.SignInUseCase {
+	return &signInUseCase{
+		signInRepo: signInRepo,
+		userRepo:   userRepo,
+		hasher:     hasher,
+		tracker:    tracker,
+		jwtService: jwtService,
+	}
+}
+
+// SignIn checks the password of the user with the given email and records
+// the attempt. Unknown emails and wrong passwords get the same answer. A
+// successful sign-in returns a token bound to the user.
+func (uc *signInUseCase) SignIn(req dtos.SignInRequest, ctx *gin.Context) (*dtos.SignInResult, *models.ErrorResponse) {
+	attempt := models.SignIn{
+		Email:     req.Email,
+		IPAddress: req.IPAddress,
+		UserAgent: req.UserAgent,
+	}
+
+	user, err := uc.userRepo.GetUserByEmail(req.Email, ctx)
+	if err != nil && err.Code != http.StatusNotFound {
+		return nil, err
+	}
+
+	var result *models.ErrorResponse
+	switch {
+	case user == nil:
+		attempt.Outcome = models.SignInUnknownUser
+		result = models.Unauthorized("Invalid email or password")
+	case user.PasswordHash == "" || !uc.hasher.Compare(user.PasswordHash, req.Password):
+		attempt.Outcome = models.SignInInvalidPassword
+		result = models.Unauthorized("Invalid email or password")
+	case !user.Status.GrantsAccess():
+		attempt.Outcome = models.SignInInactiveUser
+		result = models.Forbidden("User is not active")
+	default:
+		attempt.Outcome = models.SignInSucceeded
+	}
+	if user != nil {
+		attempt.UserID = &user.ID
+	}
+
+	// The attempt was decided either way, so a failure to log it does not
+	// change the answer
+	if err := uc.signInRepo.CreateSignIn(attempt, ctx); err != nil {
+		log.Printf("Failed to record sign-in for %s: %s", req.Email, err.Message)
+	}
+
+	if result != nil {
+		return nil, result
+	}
+
+	value, _ := ctx.Get(models.TenantContextKey)
+	tenant, ok := value.(*models.Tenant)
+	if !ok {
+		return nil, models.InternalServerError("Tenant missing from request context")
+	}
+	token, tokenErr := uc.jwtService.GenerateUserToken(tenant.Name, user.UID.String())
+	if tokenErr != nil {
+		return nil, models.InternalServerError("Failed to generate token")
+	}
+
+	uc.tracker.Touch(ctx, user.UID.String(), true)
+
+	return &dtos.SignInResult{
+		UserResponse: dtos.UserResponse{
+			UID:    user.UID.String(),
+			Name:   user.Name,
+			Email:  user.Email,
+			Status: string(user.Status),
+		},
+		Token: token,
+	}, nil
+}
+
+func (uc *signInUseCase) GetUserSignIns(uid string, limit int, ctx *gin.Context) ([]dtos.SignInResponse, *models.ErrorResponse) {
+	return uc.signInRepo.GetUserSignIns(uid, limit, ctx)
+}
@@ -0,0 +1,28 @@
+package usecases
+
+import (
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	interfaces "github.com/google-run-code/Domain/Interfaces"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+type trashUseCase struct {
+	trashRepo interfaces.TrashRepository
+}
+
+func NewTrashUseCase(trashRepo interfaces.TrashRepository) interfaces.TrashUseCase {
+	return &trashUseCase{
+		trashRepo: trashRepo,
+	}
+}
+
+func (uc *trashUseCase) GetTrash(entityType string, ctx *gin.Context) ([]*dtos.TrashEntryResponse, *models.ErrorResponse) {
+	switch entityType {
+	case "", models.TrashTypeUser, models.TrashTypeGroup, models.TrashTypeRole:
+	default:
+		return nil, models.BadRequest("Invalid type, expected one of: user, group, role")
+	}
+
+	return uc.trashRepo.GetTrash(entityType, ctx)
+}
@@ -0,0 +1,260 @@
+package usecases
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/google/uuid"
+)
+
+const maxBatchOperations = 1000
+
+// BatchUsers validates every operation up front, with the tenant schema and the
+// affected users loaded once for the whole batch, and then applies the valid
+// operations through a single repository call. Email uniqueness is left to the
+// database index so that it holds under concurrent writes.
+func (uc *userUseCase) BatchUsers(req dtos.BatchUsersRequest, ctx *gin.Context) (*dtos.BatchUsersResponse, *models.ErrorResponse) {
+	if len(req.Operations) == 0 {
+		return nil, models.BadRequest("At least one operation is required")
+	}
+	if len(req.Operations) > maxBatchOperations {
+		return nil, models.BadRequest(fmt.Sprintf("A batch can contain at most %d operations", maxBatchOperations))
+	}
+
+	schema, err := uc.schemaRepo.GetAttributeSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var uids []string
+	for _, op := range req.Operations {
+		if op.Op != dtos.BatchOpCreate {
+			if _, err := uuid.Parse(op.ID); err == nil {
+				uids = append(uids, op.ID)
+			}
+		}
+	}
+
+	users, err := uc.userRepo.GetUsersByIds(uids, ctx)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]*models.User, len(users))
+	for _, user := range users {
+		existing[user.UID.String()] = user
+	}
+
+	results := make([]*dtos.BatchUserResult, len(req.Operations))
+	var changes []dtos.BatchUserChange
+	seenIDs := make(map[string]bool)
+	seenEmails := make(map[string]bool)
+
+	for i, op := range req.Operations {
+		change, itemErr := uc.validateBatchOperation(i, op, schema, existing, seenIDs, seenEmails)
+		if itemErr != nil {
+			results[i] = &dtos.BatchUserResult{Index: i, Op: op.Op, UID: op.ID, Status: itemErr.Code, Error: itemErr.Message}
+			continue
+		}
+		changes = append(changes, *change)
+	}
+
+	invalid := len(changes) < len(req.Operations)
+	if invalid && !req.ContinueOnError {
+		for i, op := range req.Operations {
+			if results[i] == nil {
+				results[i] = &dtos.BatchUserResult{
+					Index:  i,
+					Op:     op.Op,
+					UID:    op.ID,
+					Status: http.StatusFailedDependency,
+					Error:  "Not applied because another operation in the batch failed",
+				}
+			}
+		}
+		return summarizeBatch(results), nil
+	}
+
+	if len(changes) > 0 {
+		applied, err := uc.userRepo.ApplyUserBatch(changes, req.ContinueOnError, ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, result := range applied {
+			results[result.Index] = result
+			if result.Error == "" {
+				uc.notifyBatchChange(changes[i], result, existing, ctx)
+			}
+		}
+	}
+
+	return summarizeBatch(results), nil
+}
+
+func (uc *userUseCase) validateBatchOperation(
+	index int,
+	op dtos.BatchUserOperation,
+	schema *dtos.AttributeSchemaResponse,
+	existing map[string]*models.User,
+	seenIDs map[string]bool,
+	seenEmails map[string]bool,
+) (*dtos.BatchUserChange, *models.ErrorResponse) {
+	change := &dtos.BatchUserChange{Index: index, Op: op.Op, UID: op.ID}
+
+	if op.Op == dtos.BatchOpUpdate || op.Op == dtos.BatchOpDelete {
+		if _, err := uuid.Parse(op.ID); err != nil {
+			return nil, models.BadRequest("A valid user id is required")
+		}
+		if seenIDs[op.ID] {
+			return nil, models.BadRequest("User appears in more than one operation of the batch")
+		}
+		seenIDs[op.ID] = true
+
+		if _, ok := existing[op.ID]; !ok {
+			return nil, models.NotFound("User not found")
+		}
+	}
+
+	checkEmail := func(email string) *models.ErrorResponse {
+		if err := uc.ValidateEmail(email); err != nil {
+			return err
+		}
+		normalized := models.NormalizeEmail(email)
+		if seenEmails[normalized] {
+			return models.Conflict("Email is used by another operation in the batch")
+		}
+		seenEmails[normalized] = true
+		return nil
+	}
+
+	switch op.Op {
+	case dtos.BatchOpCreate:
+		var create dtos.UserCreateRequest
+		if err := json.Unmarshal(op.Data, &create); err != nil {
+			return nil, models.BadRequest("Invalid data: " + err.Error())
+		}
+		if create.Name == "" || create.Email == "" {
+			return nil, models.BadRequest("name and email are required")
+		}
+
+		if create.Status == "" {
+			create.Status = string(models.UserStatusActive)
+		}
+		if status := models.UserStatus(create.Status); status != models.UserStatusActive && status != models.UserStatusInvited {
+			return nil, models.BadRequest("New users must be either invited or active")
+		}
+
+		if len(create.Attributes) == 0 {
+			create.Attributes = json.RawMessage("{}")
+		}
+		if err := checkAttributesObject(create.Attributes); err != nil {
+			return nil, err
+		}
+		if err := uc.checkAttributes(schema, create.Attributes); err != nil {
+			return nil, err
+		}
+
+		if err := normalizeProfile(&create.UserProfile); err != nil {
+			return nil, err
+		}
+
+		if err := checkEmail(create.Email); err != nil {
+			return nil, err
+		}
+		if create.RoleId != "" {
+			if _, err := uuid.Parse(create.RoleId); err != nil {
+				return nil, models.NotFound("Role not found")
+			}
+		}
+		if create.ManagerId != "" {
+			if _, err := uuid.Parse(create.ManagerId); err != nil {
+				return nil, models.NotFound("Manager not found")
+			}
+		}
+		change.Create = &create
+
+	case dtos.BatchOpUpdate:
+		var update dtos.UserUpdateRequest
+		if err := json.Unmarshal(op.Data, &update); err != nil {
+			return nil, models.BadRequest("Invalid data: " + err.Error())
+		}
+		current := existing[op.ID]
+
+		if update.Email != nil && models.NormalizeEmail(*update.Email) != models.NormalizeEmail(current.Email) {
+			if err := checkEmail(*update.Email); err != nil {
+				return nil, err
+			}
+		}
+		if update.Status != nil && *update.Status != string(current.Status) {
+			if err := current.Status.CheckTransition(models.UserStatus(*update.Status)); err != nil {
+				return nil, err
+			}
+		}
+		if update.Attributes != nil {
+			if err := checkAttributesObject(update.Attributes); err != nil {
+				return nil, err
+			}
+			if err := uc.checkAttributes(schema, update.Attributes); err != nil {
+				return nil, err
+			}
+		}
+		if err := normalizeProfileUpdate(&update.UserProfileUpdate); err != nil {
+			return nil, err
+		}
+		if update.RoleId != nil && *update.RoleId != "" {
+			if _, err := uuid.Parse(*update.RoleId); err != nil {
+				return nil, models.NotFound("Role not found")
+			}
+		}
+		if update.ManagerId != nil && *update.ManagerId != "" {
+			if _, err := uuid.Parse(*update.ManagerId); err != nil {
+				return nil, models.NotFound("Manager not found")
+			}
+		}
+		update.UserUID = op.ID
+		change.Update = &update
+
+	case dtos.BatchOpDelete:
+
+	default:
+		return nil, models.BadRequest("op must be one of: create, update, delete")
+	}
+
+	return change, nil
+}
+
+// notifyBatchChange sends the verification emails a single create or update
+// would have sent.
+func (uc *userUseCase) notifyBatchChange(change dtos.BatchUserChange, result *dtos.BatchUserResult, existing map[string]*models.User, ctx *gin.Context) {
+	switch change.Op {
+	case dtos.BatchOpCreate:
+		if models.UserStatus(change.Create.Status) != models.UserStatusInvited {
+			uc.sendVerification(result.UID, change.Create.Name, change.Create.Email, ctx)
+		}
+	case dtos.BatchOpUpdate:
+		current := existing[change.UID]
+		if change.Update.Email != nil && models.NormalizeEmail(*change.Update.Email) != models.NormalizeEmail(current.Email) {
+			name := current.Name
+			if change.Update.Name != nil {
+				name = *change.Update.Name
+			}
+			uc.sendVerification(change.UID, name, *change.Update.Email, ctx)
+		}
+	}
+}
+
+func summarizeBatch(results []*dtos.BatchUserResult) *dtos.BatchUsersResponse {
+	res := &dtos.BatchUsersResponse{Results: results}
+	for _, result := range results {
+		if result.Error == "" {
+			res.Succeeded++
+		} else {
+			res.Failed++
+		}
+	}
+	return res
+}
@@ -0,0 +1,148 @@
+package usecases
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+var userImportFields = []string{
+	dtos.UserCSVFieldName,
+	dtos.UserCSVFieldEmail,
+	dtos.UserCSVFieldStatus,
+	dtos.UserCSVFieldRole,
+	dtos.UserCSVFieldGroups,
+}
+
+func (uc *userUseCase) ExportUsers(fn func(row *dtos.UserExportRow) error, ctx *gin.Context) *models.ErrorResponse {
+	return uc.userRepo.StreamUsers(fn, ctx)
+}
+
+// importColumns resolves the CSV column index of every import field. Only the
+// email column is required.
+func importColumns(header []string, mapping map[string]string) (map[string]int, *models.ErrorResponse) {
+	for field := range mapping {
+		known := false
+		for _, f := range userImportFields {
+			known = known || f == field
+		}
+		if !known {
+			return nil, models.BadRequest("Unknown import field: " + field + ", expected one of: " + strings.Join(userImportFields, ", "))
+		}
+	}
+
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		positions[strings.TrimSpace(name)] = i
+	}
+
+	columns := make(map[string]int)
+	for _, field := range userImportFields {
+		column, mapped := mapping[field]
+		if !mapped {
+			column = field
+		}
+
+		if i, ok := positions[column]; ok {
+			columns[field] = i
+		} else if mapped {
+			return nil, models.BadRequest(fmt.Sprintf("Column %q mapped to %s is not in the CSV header", column, field))
+		}
+	}
+
+	if _, ok := columns[dtos.UserCSVFieldEmail]; !ok {
+		return nil, models.BadRequest("The CSV must have an email column")
+	}
+
+	return columns, nil
+}
+
+// ImportUsers streams the CSV into the repository. Rows that cannot be parsed
+// or fail field validation are reported here and never reach the database;
+// the repository reports rows that conflict with existing data.
+func (uc *userUseCase) ImportUsers(reader io.Reader, req dtos.UserImportRequest, ctx *gin.Context) (*dtos.UserImportResult, *models.ErrorResponse) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, models.BadRequest("Failed to read the CSV header")
+	}
+
+	columns, errResp := importColumns(header, req.Mapping)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	var rowErrors []dtos.UserImportError
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return dtos.UnescapeUserCSVCell(strings.TrimSpace(record[i]))
+		}
+		return ""
+	}
+
+	next := func() (*dtos.UserImportRow, error) {
+		for {
+			record, err := csvReader.Read()
+			if err == io.EOF {
+				return nil, nil
+			}
+
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				rowErrors = append(rowErrors, dtos.UserImportError{Line: parseErr.StartLine, Error: parseErr.Err.Error()})
+				continue
+			}
+			if err != nil {
+				return nil, models.BadRequest("Failed to read the CSV: " + err.Error())
+			}
+			line, _ := csvReader.FieldPos(0)
+
+			row := &dtos.UserImportRow{
+				Line:   line,
+				Name:   field(record, dtos.UserCSVFieldName),
+				Email:  field(record, dtos.UserCSVFieldEmail),
+				Status: field(record, dtos.UserCSVFieldStatus),
+				Role:   field(record, dtos.UserCSVFieldRole),
+			}
+			for _, group := range strings.Split(field(record, dtos.UserCSVFieldGroups), dtos.UserCSVGroupSeparator) {
+				if group = strings.TrimSpace(group); group != "" {
+					row.Groups = append(row.Groups, group)
+				}
+			}
+
+			if row.Email == "" || !uc.emailService.IsValidEmail(row.Email) {
+				rowErrors = append(rowErrors, dtos.UserImportError{Line: line, Error: "Invalid email"})
+				continue
+			}
+			if row.Status != "" && !models.UserStatus(row.Status).IsValid() {
+				rowErrors = append(rowErrors, dtos.UserImportError{Line: line, Error: "Invalid status: " + row.Status})
+				continue
+			}
+
+			return row, nil
+		}
+	}
+
+	result, errResp := uc.userRepo.ImportUsers(next, req.DryRun, ctx)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	result.Total += len(rowErrors)
+	result.Failed += len(rowErrors)
+	result.Errors = append(result.Errors, rowErrors...)
+	sort.SliceStable(result.Errors, func(i, j int) bool {
+		return result.Errors[i].Line < result.Errors[j].Line
+	})
+
+	return result, nil
+}
@@ -0,0 +1,88 @@
+package usecases
+
+import (
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+// maxReportDepth is how deep GetReports looks when asked for all reports.
+const maxReportDepth = 100
+
+// GetReports lists direct reports, or with all set every user below id in the
+// hierarchy.
+func (uc *userUseCase) GetReports(id string, all bool, ctx *gin.Context) ([]*dtos.UserReportResponse, *models.ErrorResponse) {
+	if err := uc.authorizeManager(id, ctx); err != nil {
+		return nil, err
+	}
+	depth := 1
+	if all {
+		depth = maxReportDepth
+	}
+	return uc.userRepo.GetReports(id, depth, ctx)
+}
+
+func (uc *userUseCase) GetManagementChain(id string, ctx *gin.Context) ([]*dtos.UserReportResponse, *models.ErrorResponse) {
+	if err := uc.authorizeManager(id, ctx); err != nil {
+		return nil, err
+	}
+	return uc.userRepo.GetManagementChain(id, ctx)
+}
+
+// GetReport answers whether reportID is in the reporting line below id, and
+// at which depth.
+func (uc *userUseCase) GetReport(id string, reportID string, ctx *gin.Context) (*dtos.UserReportResponse, *models.ErrorResponse) {
+	if err := uc.authorizeManager(id, ctx); err != nil {
+		return nil, err
+	}
+	chain, err := uc.userRepo.GetManagementChain(reportID, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, manager := range chain {
+		if manager.UID == id {
+			report, err := uc.userRepo.GetUserById(reportID, ctx)
+			if err != nil {
+				return nil, err
+			}
+			managerUID := ""
+			if report.Manager != nil {
+				managerUID = report.Manager.UID
+			}
+			return &dtos.UserReportResponse{
+				UID:        report.UID,
+				Name:       report.Name,
+				Email:      report.Email,
+				Title:      report.Title,
+				ManagerUID: managerUID,
+				Depth:      manager.Depth,
+			}, nil
+		}
+	}
+
+	return nil, models.NotFound("The user does not report to this manager")
+}
+
+// authorizeManager lets tokens that are not bound to a user look at any user,
+// and tokens bound to a user only at themselves and the users who report to
+// them, directly or not.
+func (uc *userUseCase) authorizeManager(id string, ctx *gin.Context) *models.ErrorResponse {
+	value, exists := ctx.Get(models.ActorContextKey)
+	if !exists {
+		return nil
+	}
+	actor, ok := value.(*models.Actor)
+	if !ok {
+		return models.Forbidden("Only the user and their managers can see this user")
+	}
+
+	allowed, err := uc.userRepo.IsManagerOf(id, *actor, ctx)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return models.Forbidden("Only the user and their managers can see this user")
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+	"github.com/google/uuid"
+)
+
+func (uc *userUseCase) MergeUsers(id string, req dtos.UserMergeRequest, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, models.NotFound("User not found")
+	}
+	if _, err := uuid.Parse(req.SourceID); err != nil {
+		return nil, models.BadRequest("A valid source_id is required")
+	}
+	if req.SourceID == id {
+		return nil, models.BadRequest("A user cannot be merged into itself")
+	}
+
+	switch req.RoleConflict {
+	case "":
+		req.RoleConflict = dtos.MergeRoleKeepTarget
+	case dtos.MergeRoleKeepTarget, dtos.MergeRoleKeepSource, dtos.MergeRoleFail:
+	default:
+		return nil, models.BadRequest("role_conflict must be one of: keep_target, keep_source, fail")
+	}
+
+	overrides := &req.Overrides
+	if overrides.Name != nil && *overrides.Name == "" {
+		return nil, models.BadRequest("name cannot be empty")
+	}
+	if overrides.Attributes != nil {
+		if err := uc.ValidateAttributes(overrides.Attributes, ctx); err != nil {
+			return nil, err
+		}
+	}
+	if err := normalizeProfileUpdate(&overrides.UserProfileUpdate); err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepo.MergeUsers(id, req, ctx); err != nil {
+		return nil, err
+	}
+
+	return uc.userRepo.GetUserById(id, ctx)
+}
+
+func (uc *userUseCase) GetUserRedirect(id string, ctx *gin.Context) (string, *models.ErrorResponse) {
+	if _, err := uuid.Parse(id); err != nil {
+		return "", models.NotFound("User not found")
+	}
+	return uc.userRepo.GetUserRedirect(id, ctx)
+}
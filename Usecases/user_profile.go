@@ -0,0 +1,59 @@
+package usecases
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	// Timezones are validated against the embedded database because the
+	// runtime image ships without one
+	_ "time/tzdata"
+
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+	"golang.org/x/text/language"
+)
+
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// normalizeProfile validates the profile and rewrites the locale in its
+// canonical form, e.g. "en-us" becomes "en-US".
+func normalizeProfile(profile *dtos.UserProfile) *models.ErrorResponse {
+	return normalizeProfileUpdate(&dtos.UserProfileUpdate{
+		GivenName:   &profile.GivenName,
+		FamilyName:  &profile.FamilyName,
+		DisplayName: &profile.DisplayName,
+		PhoneNumber: &profile.PhoneNumber,
+		Locale:      &profile.Locale,
+		Timezone:    &profile.Timezone,
+		Title:       &profile.Title,
+	})
+}
+
+func normalizeProfileUpdate(update *dtos.UserProfileUpdate) *models.ErrorResponse {
+	for _, field := range []*string{update.GivenName, update.FamilyName, update.DisplayName, update.Title} {
+		if field != nil {
+			*field = strings.TrimSpace(*field)
+		}
+	}
+
+	if update.PhoneNumber != nil && *update.PhoneNumber != "" && !e164Pattern.MatchString(*update.PhoneNumber) {
+		return models.BadRequest("phone_number must be in E.164 format, e.g. +14155550123")
+	}
+
+	if update.Locale != nil && *update.Locale != "" {
+		tag, err := language.Parse(*update.Locale)
+		if err != nil {
+			return models.BadRequest("locale must be a BCP 47 language tag, e.g. en-US")
+		}
+		*update.Locale = tag.String()
+	}
+
+	// LoadLocation also accepts "Local", which means nothing to other clients
+	if update.Timezone != nil && *update.Timezone != "" {
+		if _, err := time.LoadLocation(*update.Timezone); err != nil || *update.Timezone == "Local" {
+			return models.BadRequest("timezone must be an IANA time zone, e.g. Europe/Paris")
+		}
+	}
+
+	return nil
+}
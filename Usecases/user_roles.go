@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	dtos "github.com/google-run-code/Domain/Dtos"
+	models "github.com/google-run-code/Domain/Models"
+)
+
+func (uc *userUseCase) GetUserRoles(id string, ctx *gin.Context) ([]dtos.UserRoleResponse, *models.ErrorResponse) {
+	return uc.userRepo.GetUserRoles(id, ctx)
+}
+
+// AddUserRole gives the user another role next to the ones they hold, or
+// changes when a role they hold starts and expires, and returns all of their
+// roles.
+func (uc *userUseCase) AddUserRole(id string, req dtos.UserRoleAssignRequest, ctx *gin.Context) ([]dtos.UserRoleResponse, *models.ErrorResponse) {
+	if req.ExpiresAt != nil {
+		if !req.ExpiresAt.After(time.Now()) {
+			return nil, models.BadRequest("expires_at must be in the future")
+		}
+		if req.StartsAt != nil && !req.ExpiresAt.After(*req.StartsAt) {
+			return nil, models.BadRequest("expires_at must be after starts_at")
+		}
+	}
+
+	if _, err := uc.roleRepo.GetRoleById(req.RoleId, ctx); err != nil {
+		return nil, models.NotFound("Role not found")
+	}
+
+	if err := uc.userRepo.AddUserRole(id, req, ctx); err != nil {
+		return nil, err
+	}
+
+	return uc.userRepo.GetUserRoles(id, ctx)
+}
+
+func (uc *userUseCase) RemoveUserRole(id string, roleID string, ctx *gin.Context) *models.ErrorResponse {
+	return uc.userRepo.RemoveUserRole(id, roleID, ctx)
+}
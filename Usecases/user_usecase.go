@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"encoding/json"
+	"log"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -10,10 +12,12 @@ import (
 )
 
 type userUseCase struct {
-	userRepo     interfaces.UserRepository
-	roleRepo     interfaces.RoleRepository
-	groupRepo    interfaces.GroupRepository
-	emailService interfaces.EmailService
+	userRepo           interfaces.UserRepository
+	roleRepo           interfaces.RoleRepository
+	groupRepo          interfaces.GroupRepository
+	emailService       interfaces.EmailService
+	schemaRepo         interfaces.AttributeSchemaRepository
+	attributeValidator interfaces.AttributeValidator
 }
 
 func NewUserUseCase(
@@ -21,19 +25,28 @@ func NewUserUseCase(
 	emailService interfaces.EmailService,
 	roleRepo interfaces.RoleRepository,
 	groupRepo interfaces.GroupRepository,
+	schemaRepo interfaces.AttributeSchemaRepository,
+	attributeValidator interfaces.AttributeValidator,
 ) interfaces.UserUseCase {
 	return &userUseCase{
-		userRepo:     userRepo,
-		emailService: emailService,
-		roleRepo:     roleRepo,
-		groupRepo:    groupRepo,
+		userRepo:           userRepo,
+		emailService:       emailService,
+		roleRepo:           roleRepo,
+		groupRepo:          groupRepo,
+		schemaRepo:         schemaRepo,
+		attributeValidator: attributeValidator,
 	}
 }
 
+// CheckEmailExists compares emails case-insensitively and also rejects the
+// aliases of existing users.
 func (uc *userUseCase) CheckEmailExists(email string, ctx *gin.Context) (*models.User, *models.ErrorResponse) {
-	existingUser, err := uc.userRepo.GetUserByEmail(email, ctx)
-	if existingUser != nil && err == nil {
-		return existingUser, models.BadRequest("User already exists")
+	taken, err := uc.userRepo.IsEmailTaken(email, "", ctx)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, models.BadRequest("User already exists")
 	}
 	return nil, nil
 }
@@ -45,52 +58,138 @@ func (uc *userUseCase) ValidateEmail(email string) *models.ErrorResponse {
 	return nil
 }
 
+// ValidateAttributes checks custom attributes against the tenant's schema. When
+// the tenant has no schema any JSON object is accepted.
+func (uc *userUseCase) ValidateAttributes(attributes json.RawMessage, ctx *gin.Context) *models.ErrorResponse {
+	if err := checkAttributesObject(attributes); err != nil {
+		return err
+	}
+
+	schema, err := uc.schemaRepo.GetAttributeSchema(ctx)
+	if err != nil {
+		return err
+	}
+
+	return uc.checkAttributes(schema, attributes)
+}
+
+func checkAttributesObject(attributes json.RawMessage) *models.ErrorResponse {
+	var object map[string]interface{}
+	if err := json.Unmarshal(attributes, &object); err != nil || object == nil {
+		return models.BadRequest("Attributes must be a JSON object")
+	}
+	return nil
+}
+
+func (uc *userUseCase) checkAttributes(schema *dtos.AttributeSchemaResponse, attributes json.RawMessage) *models.ErrorResponse {
+	if schema == nil {
+		return nil
+	}
+
+	if err := uc.attributeValidator.Validate(schema.Schema, attributes); err != nil {
+		return models.UnprocessableEntity("Attributes do not match the tenant schema: " + err.Error())
+	}
+
+	return nil
+}
+
 func (uc *userUseCase) GetAllUsers(ctx *gin.Context) ([]*dtos.UserResponseAll, *models.ErrorResponse) {
 	return uc.userRepo.GetAllUsers(ctx)
 }
 
 func (uc *userUseCase) GetUserById(id string, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+	if err := uc.authorizeManager(id, ctx); err != nil {
+		return nil, err
+	}
 	return uc.userRepo.GetUserById(id, ctx)
 }
 
-func (uc *userUseCase) GetUsersGroup(id string, ctx *gin.Context) ([]*dtos.GroupResponse, *models.ErrorResponse) {
+func (uc *userUseCase) GetUsersGroup(id string, filter dtos.MembershipFilter, ctx *gin.Context) ([]*dtos.GroupResponse, *models.ErrorResponse) {
+	if filter.Effective {
+		user, err := uc.userRepo.GetUserById(id, ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !models.UserStatus(user.Status).GrantsAccess() {
+			return []*dtos.GroupResponse{}, nil
+		}
+	}
+
+	if filter.Transitive {
+		return uc.userRepo.GetUsersGroupsTransitive(id, ctx)
+	}
 	return uc.userRepo.GetUsersGroups(id, ctx)
 }
 
 func (uc *userUseCase) SearchUsers(searchFields dtos.SearchFields, ctx *gin.Context) ([]*dtos.UserResponseAll, *models.ErrorResponse) {
+	if searchFields.OrderBy != "" {
+		if _, ok := dtos.UserOrderColumns[strings.TrimPrefix(searchFields.OrderBy, "-")]; !ok {
+			return nil, models.BadRequest("Invalid orderby value: " + searchFields.OrderBy)
+		}
+	}
 	return uc.userRepo.SearchUsers(searchFields, ctx)
 }
 
-func (uc *userUseCase) CreateUser(user dtos.UserCreateRequest, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+// ValidateNewUser checks a user about to be created and fills in the default
+// status and attributes.
+func (uc *userUseCase) ValidateNewUser(user *dtos.UserCreateRequest, ctx *gin.Context) *models.ErrorResponse {
+	if user.Status == "" {
+		user.Status = string(models.UserStatusActive)
+	}
+
+	if status := models.UserStatus(user.Status); status != models.UserStatusActive && status != models.UserStatusInvited {
+		return models.BadRequest("New users must be either invited or active")
+	}
+
+	if len(user.Attributes) == 0 {
+		user.Attributes = json.RawMessage("{}")
+	}
+
+	if err := uc.ValidateAttributes(user.Attributes, ctx); err != nil {
+		return err
+	}
+
+	if err := normalizeProfile(&user.UserProfile); err != nil {
+		return err
+	}
+
 	if _, err := uc.CheckEmailExists(user.Email, ctx); err != nil {
-		return nil, err
+		return err
 	}
 
 	if err := uc.ValidateEmail(user.Email); err != nil {
-		return nil, err
+		return err
 	}
 
 	if user.RoleId != "" {
 		if _, err := uc.roleRepo.GetRoleById(user.RoleId, ctx); err != nil {
-			return nil, models.NotFound("Role not found")
+			return models.NotFound("Role not found")
 		}
 
 	}
-	newUser, nErr := uc.userRepo.CreateUser(user, ctx)
-	if user.RoleId != "" {
-
-		if err := uc.AddUserToRole(dtos.AddUserToRoleRequest{
-			UserUID: newUser.UID,
-			RoleId:  user.RoleId,
-		}, ctx); err != nil {
-			return nil, err
+	if user.ManagerId != "" {
+		if _, err := uc.userRepo.GetUserById(user.ManagerId, ctx); err != nil {
+			return models.NotFound("Manager not found")
 		}
 	}
+	return nil
+}
 
+func (uc *userUseCase) CreateUser(user dtos.UserCreateRequest, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+	if err := uc.ValidateNewUser(&user, ctx); err != nil {
+		return nil, err
+	}
+
+	newUser, nErr := uc.userRepo.CreateUser(user, ctx)
 	if nErr != nil {
 		return nil, nErr
 	}
 
+	// Invited users verify their address by accepting the invitation
+	if models.UserStatus(user.Status) != models.UserStatusInvited {
+		uc.sendVerification(newUser.UID, newUser.Name, newUser.Email, ctx)
+	}
+
 	return uc.userRepo.GetUserById(newUser.UID, ctx)
 }
 
@@ -99,45 +198,77 @@ func (uc *userUseCase) UpdateUser(id string, user dtos.UserUpdateRequest, ctx *g
 	if userToUpdate == nil && err != nil {
 		return nil, models.NotFound("User not found")
 	}
+	if userToUpdate.ErasedAt != nil {
+		return nil, models.Conflict("An erased user cannot be updated")
+	}
 
 	if user.Name != nil {
 		userToUpdate.Name = *user.Name
 	}
 
+	emailChanged := false
 	if user.Email != nil {
 		if err := uc.ValidateEmail(*user.Email); err != nil {
 			return nil, err
 		}
+		emailChanged = models.NormalizeEmail(*user.Email) != models.NormalizeEmail(userToUpdate.Email)
+		if emailChanged {
+			taken, err := uc.userRepo.IsEmailTaken(*user.Email, userToUpdate.UID, ctx)
+			if err != nil {
+				return nil, err
+			}
+			if taken {
+				return nil, models.Conflict("Email is already in use")
+			}
+		}
 		userToUpdate.Email = *user.Email
 	}
 
-	if user.Status != nil {
-		userToUpdate.Status = *user.Status
-	}
-
-	if user.RoleId != nil && *user.RoleId != "" {
-		err := uc.AddUserToRole(dtos.AddUserToRoleRequest{
-			UserUID: userToUpdate.UID,
-			RoleId:  *user.RoleId,
-		}, ctx)
-		if err != nil {
+	if user.Attributes != nil {
+		if err := uc.ValidateAttributes(user.Attributes, ctx); err != nil {
 			return nil, err
 		}
-	} else if user.RoleId != nil && *user.RoleId == "" {
-		if err := uc.userRepo.RemoveUserRole(userToUpdate.UID, ctx); err != nil {
+		userToUpdate.Attributes = user.Attributes
+	}
+
+	if err := normalizeProfileUpdate(&user.UserProfileUpdate); err != nil {
+		return nil, err
+	}
+
+	if user.Status != nil && *user.Status != userToUpdate.Status {
+		if err := models.UserStatus(userToUpdate.Status).CheckTransition(models.UserStatus(*user.Status)); err != nil {
 			return nil, err
 		}
+		userToUpdate.Status = *user.Status
+	}
 
+	if user.RoleId != nil && *user.RoleId != "" {
+		if _, err := uc.roleRepo.GetRoleById(*user.RoleId, ctx); err != nil {
+			return nil, models.NotFound("Role not found")
+		}
 	}
 
 	updateUs := &dtos.UserUpdateRequest{
-		Name:    &userToUpdate.Name,
-		Email:   &userToUpdate.Email,
-		Status:  &userToUpdate.Status,
-		UserUID: userToUpdate.UID,
+		Name:              &userToUpdate.Name,
+		Email:             &userToUpdate.Email,
+		Status:            &userToUpdate.Status,
+		RoleId:            user.RoleId,
+		ManagerId:         user.ManagerId,
+		Attributes:        userToUpdate.Attributes,
+		UserUID:           userToUpdate.UID,
+		UserProfileUpdate: user.UserProfileUpdate,
+	}
+
+	updated, uErr := uc.userRepo.UpdateUser(id, updateUs, ctx)
+	if uErr != nil {
+		return nil, uErr
+	}
+
+	if emailChanged {
+		uc.sendVerification(updated.UID, updated.Name, updated.Email, ctx)
 	}
 
-	return uc.userRepo.UpdateUser(id, updateUs, ctx)
+	return updated, nil
 }
 
 func (uc *userUseCase) DeleteUser(id string, ctx *gin.Context) *models.ErrorResponse {
@@ -149,7 +280,13 @@ func (uc *userUseCase) DeleteUser(id string, ctx *gin.Context) *models.ErrorResp
 	return uc.userRepo.DeleteUser(id, ctx)
 }
 
+// AddUserToGroup puts the user in the given groups. With a validity period,
+// the period of groups the user is already a member of is updated as well.
 func (uc *userUseCase) AddUserToGroup(req dtos.AddUserToGroupRequest, ctx *gin.Context) (*models.ErrorResponse, string) {
+	if err := validateMembershipPeriod(req.ValidFrom, req.ValidUntil); err != nil {
+		return err, ""
+	}
+
 	_, err := uc.userRepo.GetUserById(req.UserUID, ctx)
 	if err != nil {
 		return models.NotFound("User not found"), ""
@@ -157,8 +294,11 @@ func (uc *userUseCase) AddUserToGroup(req dtos.AddUserToGroupRequest, ctx *gin.C
 
 	var validGroups []string
 	for _, groupId := range req.GroupIds {
-		_, err = uc.groupRepo.GetGroupById(groupId, ctx)
+		group, err := uc.groupRepo.GetGroupById(groupId, ctx)
 		if err == nil {
+			if group.Type == models.GroupTypeDynamic {
+				return dynamicMembersError(group), ""
+			}
 			validGroups = append(validGroups, groupId)
 		}
 	}
@@ -187,33 +327,34 @@ func (uc *userUseCase) AddUserToGroup(req dtos.AddUserToGroupRequest, ctx *gin.C
 		}
 	}
 
+	bounded := req.ValidFrom != nil || req.ValidUntil != nil
 	successMessage := ""
-	if len(newGroups) > 0 {
+	if len(newGroups) > 0 || (bounded && len(existingGroups) > 0) {
 		req.GroupIds = newGroups
+		if bounded {
+			req.GroupIds = append(req.GroupIds, existingGroups...)
+		}
 		addErr := uc.userRepo.AddUserToGroup(req, ctx)
 		if addErr != nil {
 			return addErr, ""
 		}
+	}
 
+	if len(newGroups) > 0 {
 		successMessage += "User has been added to the given groups: "
 	}
 
 	if len(existingGroups) > 0 {
-		successMessage += " The user was already a member of the following groups: " + strings.Join(existingGroups, ", ")
+		if bounded {
+			successMessage += " The membership period was updated for the following groups: " + strings.Join(existingGroups, ", ")
+		} else {
+			successMessage += " The user was already a member of the following groups: " + strings.Join(existingGroups, ", ")
+		}
 	}
 
 	return nil, successMessage
 }
 
-func (uc *userUseCase) AddUserToRole(req dtos.AddUserToRoleRequest, ctx *gin.Context) *models.ErrorResponse {
-
-	_, err := uc.roleRepo.GetRoleById(req.RoleId, ctx)
-	if err != nil {
-		return models.NotFound("Role not found")
-	}
-	return uc.userRepo.AddUserToRole(req, ctx)
-}
-
 func (uc *userUseCase) RemoveUserFromGroup(req dtos.RemoveUserFromGroupRequest, ctx *gin.Context) (string, *models.ErrorResponse) {
 	_, err := uc.userRepo.GetUserById(req.UserUID, ctx)
 	if err != nil {
@@ -226,6 +367,9 @@ func (uc *userUseCase) RemoveUserFromGroup(req dtos.RemoveUserFromGroupRequest,
 	for _, groupId := range req.GroupIds {
 		groupResponse, err := uc.groupRepo.GetGroupById(groupId, ctx)
 		if err == nil {
+			if groupResponse.Type == models.GroupTypeDynamic {
+				return "", dynamicMembersError(groupResponse)
+			}
 			validGroupUIDs = append(validGroupUIDs, groupResponse.UID)
 		} else {
 			nonMemberGroups = append(nonMemberGroups, groupId)
@@ -272,3 +416,99 @@ func (uc *userUseCase) RemoveUserFromGroup(req dtos.RemoveUserFromGroupRequest,
 
 	return successMessage, nil
 }
+
+func (uc *userUseCase) RestoreUser(id string, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+	if err := uc.userRepo.RestoreUser(id, ctx); err != nil {
+		return nil, err
+	}
+
+	return uc.userRepo.GetUserById(id, ctx)
+}
+
+func (uc *userUseCase) ChangeUserStatus(id string, status models.UserStatus, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+	user, err := uc.userRepo.GetUserById(id, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Status == string(status) {
+		return nil, models.Conflict("User is already " + string(status))
+	}
+
+	if err := models.UserStatus(user.Status).CheckTransition(status); err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepo.UpdateUserStatus(id, status, ctx); err != nil {
+		return nil, err
+	}
+
+	return uc.userRepo.GetUserById(id, ctx)
+}
+
+func tenantName(ctx *gin.Context) string {
+	value, _ := ctx.Get(models.TenantContextKey)
+	if tenant, ok := value.(*models.Tenant); ok {
+		return tenant.Name
+	}
+	return ""
+}
+
+// sendVerification does not fail the surrounding request, the user can ask
+// for a new link through SendEmailVerification.
+func (uc *userUseCase) sendVerification(uid string, name string, email string, ctx *gin.Context) {
+	if err := uc.emailService.SendVerificationEmail(tenantName(ctx), uid, name, email); err != nil {
+		log.Printf("Failed to send verification email to user %s: %v", uid, err)
+	}
+}
+
+func (uc *userUseCase) SendEmailVerification(id string, ctx *gin.Context) *models.ErrorResponse {
+	user, err := uc.userRepo.GetUserById(id, ctx)
+	if err != nil {
+		return err
+	}
+
+	if user.EmailVerified {
+		return models.Conflict("Email is already verified")
+	}
+
+	if err := uc.emailService.SendVerificationEmail(tenantName(ctx), user.UID, user.Name, user.Email); err != nil {
+		return models.InternalServerError("Failed to send verification email")
+	}
+
+	return nil
+}
+
+func (uc *userUseCase) VerifyEmail(token string, ctx *gin.Context) (*dtos.UserResponseSingle, *models.ErrorResponse) {
+	claims, err := uc.emailService.ParseVerificationToken(token)
+	if err != nil || claims.Tenant != tenantName(ctx) {
+		return nil, models.BadRequest("Invalid or expired verification link")
+	}
+
+	user, uErr := uc.userRepo.GetUserById(claims.UserUID, ctx)
+	if uErr != nil {
+		return nil, uErr
+	}
+
+	if models.NormalizeEmail(user.Email) != models.NormalizeEmail(claims.Email) {
+		// Links sent for an alias carry the alias address
+		if err := uc.userRepo.MarkEmailAliasVerified(user.UID, claims.Email, ctx); err != nil {
+			return nil, err
+		}
+		return uc.userRepo.GetUserById(user.UID, ctx)
+	}
+
+	if user.EmailVerified {
+		return user, nil
+	}
+
+	if err := uc.userRepo.MarkEmailVerified(user.UID, claims.Email, ctx); err != nil {
+		return nil, err
+	}
+
+	return uc.userRepo.GetUserById(user.UID, ctx)
+}
+
+func dynamicMembersError(group *dtos.GroupResponse) *models.ErrorResponse {
+	return models.Conflict("The members of dynamic group " + group.Name + " are managed by its rule")
+}
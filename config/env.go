@@ -7,12 +7,39 @@ import (
 )
 
 type Env struct {
-	DB_USER    string   `mapstructure:"DB_USER"`
-	DB_PASS    string   `mapstructure:"DB_PASS"`
-	DB_HOST    string   `mapstructure:"DB_HOST"`
-	DB_PORT    string   `mapstructure:"DB_PORT"`
-	JWT_SECRET string   `mapstructure:"JWT_SECRET"`
-	DB_NAMES   string `mapstructure:"DB_NAMES"`
+	DB_USER             string `mapstructure:"DB_USER"`
+	DB_PASS             string `mapstructure:"DB_PASS"`
+	DB_HOST             string `mapstructure:"DB_HOST"`
+	DB_PORT             string `mapstructure:"DB_PORT"`
+	JWT_SECRET          string `mapstructure:"JWT_SECRET"`
+	DB_NAMES            string `mapstructure:"DB_NAMES"`
+	DB_INIT_CONCURRENCY int    `mapstructure:"DB_INIT_CONCURRENCY"`
+	DISABLED_TENANTS    string `mapstructure:"DISABLED_TENANTS"`
+
+	PUBLIC_BASE_URL        string `mapstructure:"PUBLIC_BASE_URL"`
+	EMAIL_VERIFICATION_TTL int    `mapstructure:"EMAIL_VERIFICATION_TTL"`
+	INVITATION_TTL         int    `mapstructure:"INVITATION_TTL"`
+	PASSWORD_RESET_TTL     int    `mapstructure:"PASSWORD_RESET_TTL"`
+	PASSWORD_RESET_LIMIT   int    `mapstructure:"PASSWORD_RESET_LIMIT"`
+	MAIL_DRIVER            string `mapstructure:"MAIL_DRIVER"`
+	MAIL_FROM              string `mapstructure:"MAIL_FROM"`
+	MAIL_DIR               string `mapstructure:"MAIL_DIR"`
+	SMTP_HOST              string `mapstructure:"SMTP_HOST"`
+	SMTP_PORT              string `mapstructure:"SMTP_PORT"`
+	SMTP_USERNAME          string `mapstructure:"SMTP_USERNAME"`
+	SMTP_PASSWORD          string `mapstructure:"SMTP_PASSWORD"`
+	BLOB_DRIVER            string `mapstructure:"BLOB_DRIVER"`
+	BLOB_DIR               string `mapstructure:"BLOB_DIR"`
+	AVATAR_MAX_BYTES       int64  `mapstructure:"AVATAR_MAX_BYTES"`
+	EVENT_DRIVER           string `mapstructure:"EVENT_DRIVER"`
+	EVENT_WEBHOOK_URL      string `mapstructure:"EVENT_WEBHOOK_URL"`
+	TRUSTED_PROXIES        string `mapstructure:"TRUSTED_PROXIES"`
+	ROLE_EXPIRY_INTERVAL   int    `mapstructure:"ROLE_EXPIRY_INTERVAL"`
+	GROUP_EXPIRY_INTERVAL  int    `mapstructure:"GROUP_EXPIRY_INTERVAL"`
+
+	ACTIVITY_FLUSH_INTERVAL    int `mapstructure:"ACTIVITY_FLUSH_INTERVAL"`
+	SCHEDULED_CHANGES_INTERVAL int `mapstructure:"SCHEDULED_CHANGES_INTERVAL"`
+	DYNAMIC_GROUP_INTERVAL     int `mapstructure:"DYNAMIC_GROUP_INTERVAL"`
 }
 
 func NewEnv() *Env {
@@ -25,6 +52,59 @@ func NewEnv() *Env {
 	viper.BindEnv("DB_PORT")
 	viper.BindEnv("JWT_SECRET")
 	viper.BindEnv("DB_NAMES")
+	viper.BindEnv("DB_INIT_CONCURRENCY")
+	viper.BindEnv("DISABLED_TENANTS")
+	viper.BindEnv("PUBLIC_BASE_URL")
+	viper.BindEnv("EMAIL_VERIFICATION_TTL")
+	viper.BindEnv("INVITATION_TTL")
+	viper.BindEnv("PASSWORD_RESET_TTL")
+	viper.BindEnv("PASSWORD_RESET_LIMIT")
+	viper.BindEnv("MAIL_DRIVER")
+	viper.BindEnv("MAIL_FROM")
+	viper.BindEnv("MAIL_DIR")
+	viper.BindEnv("SMTP_HOST")
+	viper.BindEnv("SMTP_PORT")
+	viper.BindEnv("SMTP_USERNAME")
+	viper.BindEnv("SMTP_PASSWORD")
+	viper.BindEnv("BLOB_DRIVER")
+	viper.BindEnv("BLOB_DIR")
+	viper.BindEnv("AVATAR_MAX_BYTES")
+	viper.BindEnv("EVENT_DRIVER")
+	viper.BindEnv("EVENT_WEBHOOK_URL")
+	viper.BindEnv("TRUSTED_PROXIES")
+	viper.BindEnv("ROLE_EXPIRY_INTERVAL")
+	viper.BindEnv("GROUP_EXPIRY_INTERVAL")
+	viper.BindEnv("ACTIVITY_FLUSH_INTERVAL")
+	viper.BindEnv("SCHEDULED_CHANGES_INTERVAL")
+	viper.BindEnv("DYNAMIC_GROUP_INTERVAL")
+
+	viper.SetDefault("DB_INIT_CONCURRENCY", 4)
+	viper.SetDefault("PUBLIC_BASE_URL", "http://localhost:8081")
+	// Hours a verification link stays valid
+	viper.SetDefault("EMAIL_VERIFICATION_TTL", 48)
+	viper.SetDefault("INVITATION_TTL", 168)
+	// Minutes a reset link stays valid, and reset emails allowed per address per hour
+	viper.SetDefault("PASSWORD_RESET_TTL", 30)
+	viper.SetDefault("PASSWORD_RESET_LIMIT", 3)
+	viper.SetDefault("MAIL_DRIVER", "log")
+	viper.SetDefault("MAIL_FROM", "no-reply@localhost")
+	viper.SetDefault("MAIL_DIR", "mail")
+	viper.SetDefault("SMTP_PORT", "587")
+	viper.SetDefault("BLOB_DRIVER", "local")
+	viper.SetDefault("BLOB_DIR", "blobs")
+	viper.SetDefault("AVATAR_MAX_BYTES", 2<<20)
+	viper.SetDefault("EVENT_DRIVER", "log")
+	// Seconds between sweeps for expired role assignments and group
+	// memberships, 0 disables them
+	viper.SetDefault("ROLE_EXPIRY_INTERVAL", 60)
+	viper.SetDefault("GROUP_EXPIRY_INTERVAL", 60)
+	// Seconds between writes of last_login_at and last_seen_at, also the window
+	// in which repeated activity of a user is only written once
+	viper.SetDefault("ACTIVITY_FLUSH_INTERVAL", 60)
+	// Seconds between runs that apply due scheduled changes, 0 disables them
+	viper.SetDefault("SCHEDULED_CHANGES_INTERVAL", 30)
+	// Seconds between re-evaluations of every dynamic group, 0 disables them
+	viper.SetDefault("DYNAMIC_GROUP_INTERVAL", 60)
 
 	if err := viper.Unmarshal(env); err != nil {
 		log.Fatalf("Error unmarshalling config: %v", err)
@@ -0,0 +1,121 @@
+package config
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Schema upgrades run on every tenant while the migration lock is held. Each one
+// must be idempotent: it checks the current schema and does nothing when the
+// upgrade has already been applied.
+var (
+	// beforeAutoMigrate prepares existing data for a schema change AutoMigrate
+	// cannot perform on its own.
+	beforeAutoMigrate = []func(*gorm.DB) error{
+		convertUserStatusToNamedStates,
+		normalizeUserEmails,
+	}
+
+	// afterAutoMigrate backfills tables and columns created by AutoMigrate.
+	afterAutoMigrate = []func(*gorm.DB) error{
+		assignPrimaryRoles,
+	}
+)
+
+func columnDataType(db *gorm.DB, table, column string) (string, error) {
+	var dataType string
+	err := db.Raw(`SELECT data_type FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = ? AND column_name = ?`, table, column).
+		Scan(&dataType).Error
+	return dataType, err
+}
+
+// convertUserStatusToNamedStates turns the legacy integer users.status column
+// into the named status column. Integer statuses never had an agreed meaning,
+// so every existing user becomes active.
+func convertUserStatusToNamedStates(db *gorm.DB) error {
+	dataType, err := columnDataType(db, "users", "status")
+	if err != nil {
+		return err
+	}
+
+	switch dataType {
+	case "smallint", "integer", "bigint":
+		return db.Exec(`ALTER TABLE users ALTER COLUMN status TYPE varchar(20) USING 'active'`).Error
+	}
+	return nil
+}
+
+// normalizeUserEmails adds and backfills users.email_normalized, which replaces
+// the case-sensitive unique index on users.email. It refuses to run while two
+// users have emails that only differ in case, because AutoMigrate could not
+// create the new unique index; those users have to be merged or renamed first.
+func normalizeUserEmails(db *gorm.DB) error {
+	emailType, err := columnDataType(db, "users", "email")
+	if err != nil || emailType == "" {
+		return err
+	}
+	normalizedType, err := columnDataType(db, "users", "email_normalized")
+	if err != nil || normalizedType != "" {
+		return err
+	}
+
+	// Tenants older than soft deletion have no users.deleted_at yet; AutoMigrate
+	// only adds it after this upgrade
+	deletedType, err := columnDataType(db, "users", "deleted_at")
+	if err != nil {
+		return err
+	}
+	live := ""
+	if deletedType != "" {
+		live = "WHERE deleted_at IS NULL"
+	}
+
+	var collisions []string
+	if err := db.Raw(`SELECT lower(btrim(email)) FROM users ` + live + `
+		GROUP BY lower(btrim(email)) HAVING count(*) > 1 ORDER BY 1 LIMIT 20`).
+		Scan(&collisions).Error; err != nil {
+		return err
+	}
+	if len(collisions) > 0 {
+		return fmt.Errorf("users share emails that only differ in case, resolve them before upgrading: %s", strings.Join(collisions, ", "))
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`ALTER TABLE users ADD COLUMN email_normalized text`).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec(`UPDATE users SET email_normalized = lower(btrim(email))`).Error; err != nil {
+			return err
+		}
+		return tx.Exec(`DROP INDEX IF EXISTS idx_users_email_active`).Error
+	})
+}
+
+// assignPrimaryRoles gives every user an assignment for the role in
+// users.role_id, which was the only role a user could hold before user_roles
+// existed and stays their primary role.
+func assignPrimaryRoles(db *gorm.DB) error {
+	return db.Exec(`INSERT INTO user_roles (user_id, role_id, created_at)
+		SELECT id, role_id, now() FROM users WHERE role_id IS NOT NULL AND deleted_at IS NULL
+		ON CONFLICT DO NOTHING`).Error
+}
+
+// warnOwnerlessGroups logs the groups that have no owner. Groups created before
+// owners existed have none, and there is nobody to hand them to automatically,
+// so an administrator has to add an owner with a tenant token.
+func warnOwnerlessGroups(db *gorm.DB, dbName string) {
+	var names []string
+	if err := db.Raw(`SELECT name FROM groups WHERE deleted_at IS NULL
+		AND NOT EXISTS (SELECT 1 FROM group_owners WHERE group_owners.group_id = groups.id)
+		ORDER BY name`).Scan(&names).Error; err != nil {
+		log.Printf("Tenant %s: failed to check group owners: %v", dbName, err)
+		return
+	}
+	if len(names) > 0 {
+		log.Printf("Tenant %s has %d groups without an owner, add one with POST /groups/{uid}/owners: %s", dbName, len(names), strings.Join(names, ", "))
+	}
+}
@@ -2,23 +2,29 @@ package config
 
 import (
 	"fmt"
+	"hash/fnv"
 	"log"
+	"sort"
 	"sync"
+	"time"
 
+	models "github.com/google-run-code/Domain/Models"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
 type PostgresConfig struct {
-	env Env
-	dbs map[string]*gorm.DB
-	mu  sync.RWMutex
+	env     Env
+	dbs     map[string]*gorm.DB
+	tenants map[string]*models.Tenant
+	mu      sync.RWMutex
 }
 
 func NewPostgresConfig(env Env) *PostgresConfig {
 	return &PostgresConfig{
-		env: env,
-		dbs: make(map[string]*gorm.DB),
+		env:     env,
+		dbs:     make(map[string]*gorm.DB),
+		tenants: make(map[string]*models.Tenant),
 	}
 }
 
@@ -40,6 +46,214 @@ func (p *PostgresConfig) InitializeConnections(databaseNames []string) {
 	}
 }
 
+// Delays between attempts to initialize a tenant that failed. The delay doubles
+// after every failure up to the maximum.
+const (
+	tenantRetryBackoff    = 5 * time.Second
+	tenantRetryMaxBackoff = 5 * time.Minute
+)
+
+// RegisterTenants marks the named tenants as pending. It must be called before
+// InitializeTenants runs in the background, so requests that arrive in the
+// meantime are told the tenant is initializing instead of unknown.
+func (p *PostgresConfig) RegisterTenants(databaseNames []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, dbName := range databaseNames {
+		p.tenants[dbName] = &models.Tenant{Name: dbName, State: models.TenantPending}
+	}
+}
+
+// InitializeTenants connects to and migrates every tenant database, running at
+// most DB_INIT_CONCURRENCY tenants at a time. A tenant that fails is marked
+// unavailable instead of stopping the process, so the remaining tenants can
+// still serve traffic, and is retried with backoff until it succeeds.
+func (p *PostgresConfig) InitializeTenants(databaseNames []string, schema ...interface{}) {
+	limit := p.env.DB_INIT_CONCURRENCY
+	if limit <= 0 {
+		limit = 1
+	}
+
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+
+	for _, dbName := range databaseNames {
+		wg.Add(1)
+		go func(dbName string) {
+			defer wg.Done()
+
+			backoff := tenantRetryBackoff
+			for {
+				// The slot is released while waiting so a failing tenant does
+				// not hold up the others
+				sem <- struct{}{}
+				ready := p.initializeTenant(dbName, schema...)
+				<-sem
+				if ready {
+					return
+				}
+
+				time.Sleep(backoff)
+				backoff = min(backoff*2, tenantRetryMaxBackoff)
+			}
+		}(dbName)
+	}
+
+	wg.Wait()
+}
+
+func (p *PostgresConfig) initializeTenant(dbName string, schema ...interface{}) bool {
+	p.setTenantState(dbName, models.TenantInitializing, nil)
+
+	db, err := gorm.Open(postgres.Open(p.BuildDBURL(dbName)), &gorm.Config{})
+	if err == nil {
+		if err = migrateWithLock(db, dbName, schema...); err != nil {
+			// Every attempt opens a new pool, so a failed one must not be left
+			// holding connections
+			if sqlDB, dbErr := db.DB(); dbErr == nil {
+				sqlDB.Close()
+			}
+		}
+	}
+
+	if err != nil {
+		log.Printf("Tenant %s is unavailable: %v", dbName, err)
+		p.setTenantState(dbName, models.TenantUnavailable, err)
+		return false
+	}
+
+	warnOwnerlessGroups(db, dbName)
+
+	p.mu.Lock()
+	p.dbs[dbName] = db
+	p.mu.Unlock()
+
+	p.setTenantState(dbName, models.TenantReady, nil)
+	return true
+}
+
+// migrateWithLock runs the migration while holding a Postgres advisory lock on a
+// single pinned connection, so instances starting at the same time take turns
+// migrating a tenant instead of racing each other.
+func migrateWithLock(db *gorm.DB, dbName string, schema ...interface{}) error {
+	key := migrationLockKey(dbName)
+
+	return db.Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec("SELECT pg_advisory_lock(?)", key).Error; err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		defer conn.Exec("SELECT pg_advisory_unlock(?)", key)
+
+		for _, upgrade := range beforeAutoMigrate {
+			if err := upgrade(conn); err != nil {
+				return fmt.Errorf("failed to upgrade database schema: %w", err)
+			}
+		}
+
+		if err := conn.AutoMigrate(schema...); err != nil {
+			return fmt.Errorf("failed to migrate database schema: %w", err)
+		}
+
+		for _, upgrade := range afterAutoMigrate {
+			if err := upgrade(conn); err != nil {
+				return fmt.Errorf("failed to upgrade database schema: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func migrationLockKey(dbName string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte("migrate:" + dbName))
+	return int64(h.Sum64())
+}
+
+func (p *PostgresConfig) setTenantState(dbName string, state models.TenantState, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	tenant, exists := p.tenants[dbName]
+	if !exists {
+		tenant = &models.Tenant{Name: dbName}
+		p.tenants[dbName] = tenant
+	}
+
+	now := time.Now()
+	tenant.State = state
+	tenant.Error = ""
+
+	switch state {
+	case models.TenantInitializing:
+		tenant.StartedAt = &now
+	case models.TenantReady, models.TenantUnavailable:
+		tenant.FinishedAt = &now
+	}
+
+	if err != nil {
+		tenant.Error = err.Error()
+	}
+}
+
+// DisableTenants registers tenants that must not serve traffic. They are never
+// connected to or migrated.
+func (p *PostgresConfig) DisableTenants(databaseNames []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, dbName := range databaseNames {
+		p.tenants[dbName] = &models.Tenant{Name: dbName, State: models.TenantDisabled}
+	}
+}
+
+// ResolveTenant returns a snapshot of the named tenant, or false if the tenant
+// was never registered.
+func (p *PostgresConfig) ResolveTenant(name string) (*models.Tenant, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	tenant, exists := p.tenants[name]
+	if !exists {
+		return nil, false
+	}
+
+	resolved := *tenant
+	return &resolved, true
+}
+
+// StartupProgress returns a snapshot of every registered tenant's initialization state.
+func (p *PostgresConfig) StartupProgress() models.StartupProgress {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	progress := models.StartupProgress{
+		Total:   len(p.tenants),
+		Tenants: make([]models.Tenant, 0, len(p.tenants)),
+	}
+
+	for _, tenant := range p.tenants {
+		switch tenant.State {
+		case models.TenantReady:
+			progress.Ready++
+		case models.TenantUnavailable:
+			progress.Unavailable++
+		case models.TenantDisabled:
+			progress.Disabled++
+		default:
+			progress.Pending++
+		}
+		progress.Tenants = append(progress.Tenants, *tenant)
+	}
+
+	sort.Slice(progress.Tenants, func(i, j int) bool {
+		return progress.Tenants[i].Name < progress.Tenants[j].Name
+	})
+
+	progress.Done = progress.Total > 0 && progress.Pending == 0
+	return progress
+}
+
 func (p *PostgresConfig) BuildDBURL(databaseName string) string {
 	if !p.isValid() {
 		log.Fatalf("Missing one or more required environment variables: DB_USER, DB_PASS, DB_HOST, DB_PORT")